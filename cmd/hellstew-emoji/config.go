@@ -0,0 +1,164 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/BenLubar/hellstew/emoji"
+)
+
+// configFile is the format of the file given to the -config flag. Every field
+// is optional. For example:
+//
+//	{
+//		"locale": "de",
+//		"wordBoundary": true,
+//		"skipElements": ["kbd"],
+//		"jumboClass": "emoji-jumbo",
+//		"jumboMax": 3,
+//		"emoji": [
+//			{"emoji": "⁉️", "description": "backwards interrobang", "aliases": ["wrongterrobang"]}
+//		],
+//		"images": [
+//			{"url": "/emoji/shipit.png", "text": "🐿️", "description": "ship it!", "aliases": ["shipit"], "category": "GitHub"}
+//		],
+//		"emoticons": {":-)": ":smile:"},
+//		"defaultEmoticons": true,
+//		"annotations": [
+//			{"locale": "de", "name": ":shipit:", "description": "Eichhörnchen", "keywords": ["Eichhörnchen"]}
+//		]
+//	}
+type configFile struct {
+	Locale         string   `json:"locale"`
+	WordBoundary   bool     `json:"wordBoundary"`
+	SkipURLs       bool     `json:"skipURLs"`
+	SkipAutolinks  bool     `json:"skipAutolinks"`
+	SkipElements   []string `json:"skipElements"`
+	AllowElements  []string `json:"allowElements"`
+	SkipClasses    []string `json:"skipClasses"`
+	SkipEditable   bool     `json:"skipEditable"`
+	ReplaceForeign bool     `json:"replaceForeign"`
+	CaseSensitive  bool     `json:"caseSensitive"`
+	JumboClass     string   `json:"jumboClass"`
+	JumboMax       int      `json:"jumboMax"`
+	SpritePrefix   string   `json:"spritePrefix"`
+
+	Emoji []struct {
+		Emoji       string   `json:"emoji"`
+		Description string   `json:"description"`
+		Aliases     []string `json:"aliases"`
+		Category    string   `json:"category"`
+		Tags        []string `json:"tags"`
+	} `json:"emoji"`
+	Images []struct {
+		URL          string   `json:"url"`
+		Text         string   `json:"text"`
+		Srcset       []string `json:"srcset"`
+		Width        int      `json:"width"`
+		Height       int      `json:"height"`
+		Animated     bool     `json:"animated"`
+		StaticURL    string   `json:"staticURL"`
+		StaticSrcset []string `json:"staticSrcset"`
+		Description  string   `json:"description"`
+		Aliases      []string `json:"aliases"`
+		Category     string   `json:"category"`
+		Tags         []string `json:"tags"`
+	} `json:"images"`
+	Emoticons        map[string]string `json:"emoticons"`
+	DefaultEmoticons bool              `json:"defaultEmoticons"`
+	Annotations      []struct {
+		Locale      string   `json:"locale"`
+		Name        string   `json:"name"`
+		Description string   `json:"description"`
+		Keywords    []string `json:"keywords"`
+	} `json:"annotations"`
+}
+
+// loadConfig reads a configFile from path.
+func loadConfig(path string) (*emoji.Config, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var file configFile
+	dec := json.NewDecoder(f)
+	dec.DisallowUnknownFields()
+	if err = dec.Decode(&file); err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+
+	conf, err := file.config()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+	return conf, nil
+}
+
+// config builds an emoji.Config. The Add methods of emoji.Config panic on
+// invalid input, so those panics are returned as errors.
+func (file *configFile) config() (conf *emoji.Config, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			msg, ok := r.(string)
+			if !ok || !strings.HasPrefix(msg, "emoji: ") {
+				panic(r)
+			}
+			conf, err = nil, fmt.Errorf("%s", strings.TrimPrefix(msg, "emoji: "))
+		}
+	}()
+
+	conf = &emoji.Config{
+		Locale:         file.Locale,
+		WordBoundary:   file.WordBoundary,
+		SkipURLs:       file.SkipURLs,
+		SkipAutolinks:  file.SkipAutolinks,
+		SkipElements:   file.SkipElements,
+		AllowElements:  file.AllowElements,
+		SkipClasses:    file.SkipClasses,
+		SkipEditable:   file.SkipEditable,
+		ReplaceForeign: file.ReplaceForeign,
+		CaseSensitive:  file.CaseSensitive,
+		JumboClass:     file.JumboClass,
+		JumboMax:       file.JumboMax,
+		SpritePrefix:   file.SpritePrefix,
+	}
+
+	for _, e := range file.Emoji {
+		conf.AddEmoji(e.Emoji, e.Description, e.Aliases, e.Category, e.Tags)
+	}
+	for _, i := range file.Images {
+		conf.AddImageInfo(emoji.ImageInfo{
+			URL:          i.URL,
+			Text:         i.Text,
+			Srcset:       i.Srcset,
+			Width:        i.Width,
+			Height:       i.Height,
+			Animated:     i.Animated,
+			StaticURL:    i.StaticURL,
+			StaticSrcset: i.StaticSrcset,
+		}, i.Description, i.Aliases, i.Category, i.Tags)
+	}
+
+	emoticons := make([]string, 0, len(file.Emoticons))
+	for e := range file.Emoticons {
+		emoticons = append(emoticons, e)
+	}
+	sort.Strings(emoticons)
+	for _, e := range emoticons {
+		conf.AddEmoticon(e, file.Emoticons[e])
+	}
+	if file.DefaultEmoticons {
+		conf.AddDefaultEmoticons()
+	}
+
+	for _, a := range file.Annotations {
+		conf.AddAnnotation(a.Locale, a.Name, a.Description, a.Keywords)
+	}
+
+	return conf, nil
+}
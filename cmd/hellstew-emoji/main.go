@@ -0,0 +1,232 @@
+// Command hellstew-emoji replaces emoji shortcodes and searches for emoji.
+//
+// Usage:
+//
+//	hellstew-emoji [-config file.json] replace [-text] < input.html
+//	hellstew-emoji [-config file.json] text < input.txt
+//	hellstew-emoji [-config file.json] search [-max n] query
+//	hellstew-emoji [-config file.json] lookup name...
+//
+// The replace command reads HTML from standard input and writes it to
+// standard output with emoji and shortcodes replaced. With -text, the input is
+// treated as plain text and escaped.
+//
+// The text command reads plain text from standard input and writes it to
+// standard output with shortcodes replaced with Unicode emoji, or with the
+// text given for image emoji in the config file.
+//
+// The search and lookup commands write one emoji per line, as tab-separated
+// columns: the Unicode emoji or image URL, the shortcodes separated by spaces,
+// and the description.
+//
+// The -config flag loads custom emoji and options from a JSON file; see
+// configFile for its format.
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+
+	"github.com/BenLubar/hellstew/emoji"
+)
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+type command struct {
+	name  string
+	usage string
+	run   func(conf *emoji.Config, args []string, stdin io.Reader, stdout, stderr io.Writer) int
+}
+
+var commands = [...]command{
+	{"replace", "[-text] < input", runReplace},
+	{"text", "< input", runText},
+	{"search", "[-max n] query", runSearch},
+	{"lookup", "name...", runLookup},
+}
+
+// run runs the command line args and returns the exit status.
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("hellstew-emoji", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	configPath := flags.String("config", "", "load custom emoji and options from a JSON `file`")
+	flags.Usage = func() {
+		for _, c := range commands {
+			fmt.Fprintf(stderr, "usage: hellstew-emoji [-config file.json] %s %s\n", c.name, c.usage)
+		}
+		flags.PrintDefaults()
+	}
+
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if flags.NArg() == 0 {
+		flags.Usage()
+		return 2
+	}
+
+	conf := &emoji.Config{}
+	if *configPath != "" {
+		var err error
+		if conf, err = loadConfig(*configPath); err != nil {
+			fmt.Fprintln(stderr, "hellstew-emoji:", err)
+			return 1
+		}
+	}
+
+	for _, c := range commands {
+		if c.name == flags.Arg(0) {
+			return c.run(conf, flags.Args()[1:], stdin, stdout, stderr)
+		}
+	}
+
+	fmt.Fprintf(stderr, "hellstew-emoji: unknown command %q\n", flags.Arg(0))
+	flags.Usage()
+	return 2
+}
+
+func runReplace(conf *emoji.Config, args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("replace", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	text := flags.Bool("text", false, "treat the input as plain text rather than HTML")
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if flags.NArg() != 0 {
+		flags.Usage()
+		return 2
+	}
+
+	var nodes []*html.Node
+	if *text {
+		b, err := ioutil.ReadAll(stdin)
+		if err != nil {
+			fmt.Fprintln(stderr, "hellstew-emoji:", err)
+			return 1
+		}
+		nodes = []*html.Node{
+			{
+				Type: html.TextNode,
+				Data: string(b),
+			},
+		}
+	} else {
+		var err error
+		nodes, err = html.ParseFragment(stdin, &html.Node{
+			Type:     html.ElementNode,
+			Data:     "body",
+			DataAtom: atom.Body,
+		})
+		if err != nil {
+			fmt.Fprintln(stderr, "hellstew-emoji:", err)
+			return 1
+		}
+	}
+
+	w := bufio.NewWriter(stdout)
+	for _, n := range conf.Replace(nodes...) {
+		if err := html.Render(w, n); err != nil {
+			fmt.Fprintln(stderr, "hellstew-emoji:", err)
+			return 1
+		}
+	}
+	if err := w.Flush(); err != nil {
+		fmt.Fprintln(stderr, "hellstew-emoji:", err)
+		return 1
+	}
+
+	return 0
+}
+
+func runText(conf *emoji.Config, args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	if len(args) != 0 {
+		fmt.Fprintln(stderr, "usage: hellstew-emoji [-config file.json] text < input")
+		return 2
+	}
+
+	b, err := ioutil.ReadAll(stdin)
+	if err != nil {
+		fmt.Fprintln(stderr, "hellstew-emoji:", err)
+		return 1
+	}
+
+	if _, err = io.WriteString(stdout, conf.ReplaceText(string(b))); err != nil {
+		fmt.Fprintln(stderr, "hellstew-emoji:", err)
+		return 1
+	}
+
+	return 0
+}
+
+func runSearch(conf *emoji.Config, args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("search", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	max := flags.Int("max", 10, "the maximum number of results")
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if flags.NArg() != 1 {
+		flags.Usage()
+		return 2
+	}
+
+	w := bufio.NewWriter(stdout)
+	for _, result := range conf.Search(flags.Arg(0), *max) {
+		printResult(w, result)
+	}
+	if err := w.Flush(); err != nil {
+		fmt.Fprintln(stderr, "hellstew-emoji:", err)
+		return 1
+	}
+
+	return 0
+}
+
+func runLookup(conf *emoji.Config, args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		fmt.Fprintln(stderr, "usage: hellstew-emoji [-config file.json] lookup name...")
+		return 2
+	}
+
+	status := 0
+	w := bufio.NewWriter(stdout)
+	for _, name := range args {
+		result, ok := conf.Lookup(name)
+		if !ok {
+			fmt.Fprintf(stderr, "hellstew-emoji: not found: %s\n", name)
+			status = 1
+			continue
+		}
+		printResult(w, result)
+	}
+	if err := w.Flush(); err != nil {
+		fmt.Fprintln(stderr, "hellstew-emoji:", err)
+		return 1
+	}
+
+	return status
+}
+
+func printResult(w io.Writer, result emoji.SearchResult) {
+	display := result.Emoji()
+	if display == "" {
+		display = result.ImageURL()
+	}
+
+	shortcodes := make([]string, len(result.Aliases()))
+	for i, a := range result.Aliases() {
+		shortcodes[i] = ":" + a + ":"
+	}
+
+	fmt.Fprintf(w, "%s\t%s\t%s\n", display, strings.Join(shortcodes, " "), result.LocalDescription())
+}
@@ -0,0 +1,131 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+type runTest struct {
+	name   string
+	args   []string
+	stdin  string
+	stdout string
+	stderr string
+	status int
+}
+
+var runTests = [...]runTest{
+	{
+		name:   "ReplaceHTML",
+		args:   []string{"replace"},
+		stdin:  "<p>To the :popcorn: thread!</p><code>:popcorn:</code>",
+		stdout: `<p>To the <abbr class="emoji" title="popcorn">🍿</abbr> thread!</p><code>:popcorn:</code>`,
+	},
+	{
+		name:   "ReplaceText",
+		args:   []string{"replace", "-text"},
+		stdin:  "<b>:tada:</b>",
+		stdout: `&lt;b&gt;<abbr class="emoji" title="party popper">🎉</abbr>&lt;/b&gt;`,
+	},
+	{
+		name:   "ReplaceConfig",
+		args:   []string{"-config", "testdata/config.json", "replace", "-text"},
+		stdin:  "10:30:shipit: :shipit: :-)",
+		stdout: `10:30:shipit: <img src="https://assets-cdn.github.com/images/icons/emoji/shipit.png" alt=":shipit:" class="emoji" title="ship it!"/> <abbr class="emoji" title="smiling face with open mouth &amp; smiling eyes">😄</abbr>`,
+	},
+	{
+		name:   "Text",
+		args:   []string{"text"},
+		stdin:  "<b>:tada:</b>\n",
+		stdout: "<b>🎉</b>\n",
+	},
+	{
+		name:   "TextConfig",
+		args:   []string{"-config", "testdata/config.json", "text"},
+		stdin:  ":shipit: :tableflip: :-)",
+		stdout: ":shipit: (╯°□°)╯︵ ┻━┻ 😄",
+	},
+	{
+		name:   "ReplaceAnimated",
+		args:   []string{"-config", "testdata/config.json", "replace", "-text"},
+		stdin:  ":tableflip:",
+		stdout: `<picture class="emoji"><source srcset="/emoji/tableflip.png, /emoji/tableflip@2x.png 2x" media="(prefers-reduced-motion: reduce)"/><img src="/emoji/tableflip.gif" alt=":tableflip:" title="table flip" srcset="/emoji/tableflip@2x.gif 2x" width="20" height="20"/></picture>`,
+	},
+	{
+		name:   "ReplaceJumbo",
+		args:   []string{"-config", "testdata/display.json", "replace", "-text"},
+		stdin:  ":tada: :shipit:",
+		stdout: `<abbr class="emoji emoji-jumbo" title="party popper">🎉</abbr> <span class="emoji emoji-jumbo emoji-shipit" role="img" aria-label=":shipit:" title="ship it!"></span>`,
+	},
+	{
+		name:   "ReplaceJumboMax",
+		args:   []string{"-config", "testdata/display.json", "replace", "-text"},
+		stdin:  ":tada: :tada: :tada:",
+		stdout: `<abbr class="emoji" title="party popper">🎉</abbr> <abbr class="emoji" title="party popper">🎉</abbr> <abbr class="emoji" title="party popper">🎉</abbr>`,
+	},
+	{
+		name:   "Search",
+		args:   []string{"search", "-max", "2", "minidisc"},
+		stdout: "💽\t:minidisc:\tcomputer disk\n",
+	},
+	{
+		name:   "SearchConfig",
+		args:   []string{"-config", "testdata/config.json", "search", "-max", "1", "squirrel"},
+		stdout: "https://assets-cdn.github.com/images/icons/emoji/shipit.png\t:shipit: :squirrel:\tship it!\n",
+	},
+	{
+		name:   "Lookup",
+		args:   []string{"lookup", ":tada:", "🍿", ":nope:"},
+		stdout: "🎉\t:tada:\tparty popper\n🍿\t:popcorn:\tpopcorn\n",
+		stderr: "hellstew-emoji: not found: :nope:\n",
+		status: 1,
+	},
+	{
+		name:   "LookupConfig",
+		args:   []string{"-config", "testdata/config.json", "lookup", "⁉️"},
+		stdout: "⁉️\t:wrongterrobang:\tbackwards interrobang\n",
+	},
+	{
+		name:   "MissingConfig",
+		args:   []string{"-config", "testdata/missing.json", "search", "tada"},
+		stderr: "hellstew-emoji: open testdata/missing.json: no such file or directory\n",
+		status: 1,
+	},
+	{
+		name:   "InvalidConfig",
+		args:   []string{"-config", "testdata/duplicate.json", "search", "tada"},
+		stderr: "hellstew-emoji: testdata/duplicate.json: already defined in this Config: :shipit:\n",
+		status: 1,
+	},
+	{
+		name:   "UnknownCommand",
+		args:   []string{"frobnicate"},
+		stderr: "hellstew-emoji: unknown command \"frobnicate\"\n",
+		status: 2,
+	},
+	{
+		name:   "NoCommand",
+		status: 2,
+	},
+}
+
+func TestRun(t *testing.T) {
+	for _, tt := range runTests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+			status := run(tt.args, strings.NewReader(tt.stdin), &stdout, &stderr)
+
+			if tt.status != status {
+				t.Errorf("status: %d != %d", tt.status, status)
+			}
+			if tt.stdout != stdout.String() {
+				t.Errorf("stdout:\nexpected %q\nactual   %q", tt.stdout, stdout.String())
+			}
+			if tt.stderr != "" && !strings.HasPrefix(stderr.String(), tt.stderr) {
+				t.Errorf("stderr:\nexpected %q\nactual   %q", tt.stderr, stderr.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,167 @@
+// Package autocomplete provides an HTTP handler for emoji autocompletion.
+package autocomplete
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/BenLubar/hellstew/emoji"
+)
+
+// Result is the JSON representation of an emoji.SearchResult.
+type Result struct {
+	Emoji            string   `json:"emoji,omitempty"`
+	ImageURL         string   `json:"imageURL,omitempty"`
+	Aliases          []string `json:"aliases"`
+	Description      string   `json:"description"`
+	LocalDescription string   `json:"localDescription,omitempty"`
+	Keywords         []string `json:"keywords,omitempty"`
+	Score            int      `json:"score"`
+}
+
+// Handler responds to GET requests with a JSON array of Results for the
+// query in the q parameter. The optional max parameter limits the number of
+// results.
+//
+// The zero value of Handler searches the default emoji set.
+type Handler struct {
+	// Config is the emoji set to search. If Config is nil, the default set
+	// is used.
+	Config *emoji.Config
+
+	// DefaultMax is the number of results returned if max is not given. If
+	// DefaultMax is zero, 10 is used.
+	DefaultMax int
+
+	// Limit is the largest value accepted for max. Larger values are
+	// reduced to Limit. If Limit is zero, 100 is used.
+	Limit int
+
+	// MaxAge is how long clients and proxies may cache responses. If MaxAge
+	// is zero, one hour is used. If MaxAge is negative, responses are marked
+	// as not cacheable.
+	MaxAge time.Duration
+}
+
+// ServeHTTP implements http.Handler.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	max, ok := h.max(r.FormValue("max"))
+	if !ok {
+		http.Error(w, "invalid max parameter", http.StatusBadRequest)
+		return
+	}
+
+	var results []emoji.SearchResult
+	if h.Config == nil {
+		results = emoji.Search(r.FormValue("q"), max)
+	} else {
+		results = h.Config.Search(r.FormValue("q"), max)
+	}
+
+	response := make([]Result, len(results))
+	for i, result := range results {
+		response[i] = Result{
+			Emoji:       result.Emoji(),
+			ImageURL:    result.ImageURL(),
+			Aliases:     result.Aliases(),
+			Description: result.Description(),
+			Keywords:    result.Keywords(),
+			Score:       result.Score(),
+		}
+		if response[i].Aliases == nil {
+			response[i].Aliases = []string{}
+		}
+		if local := result.LocalDescription(); local != result.Description() {
+			response[i].LocalDescription = local
+		}
+	}
+
+	body, err := json.Marshal(response)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	body = append(body, '\n')
+
+	sum := sha256.Sum256(body)
+	etag := `"` + hex.EncodeToString(sum[:16]) + `"`
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.Header().Set("ETag", etag)
+	w.Header().Set("Cache-Control", h.cacheControl())
+
+	if noneMatch(r.Header["If-None-Match"], etag) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	w.Header().Set("Content-Length", strconv.Itoa(len(body)))
+	if r.Method == http.MethodHead {
+		return
+	}
+	_, _ = w.Write(body)
+}
+
+func (h *Handler) max(s string) (int, bool) {
+	limit := h.Limit
+	if limit <= 0 {
+		limit = 100
+	}
+
+	if s == "" {
+		max := h.DefaultMax
+		if max <= 0 {
+			max = 10
+		}
+		if max > limit {
+			max = limit
+		}
+		return max, true
+	}
+
+	max, err := strconv.Atoi(s)
+	if err != nil || max < 0 {
+		return 0, false
+	}
+	if max > limit {
+		max = limit
+	}
+	return max, true
+}
+
+// noneMatch reports whether the If-None-Match header values match etag. Each
+// value is a comma-separated list of entity tags, which are compared with the
+// weak comparison function, or "*".
+func noneMatch(values []string, etag string) bool {
+	for _, v := range values {
+		for _, tag := range strings.Split(v, ",") {
+			tag = strings.TrimSpace(tag)
+			if tag == "*" || strings.TrimPrefix(tag, "W/") == etag {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (h *Handler) cacheControl() string {
+	maxAge := h.MaxAge
+	if maxAge == 0 {
+		maxAge = time.Hour
+	}
+	if maxAge < 0 {
+		return "no-store"
+	}
+	return "public, max-age=" + strconv.Itoa(int(maxAge/time.Second))
+}
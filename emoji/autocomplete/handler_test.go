@@ -0,0 +1,150 @@
+package autocomplete_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/BenLubar/hellstew/emoji"
+	"github.com/BenLubar/hellstew/emoji/autocomplete"
+)
+
+func get(t *testing.T, h http.Handler, target string, header http.Header) *httptest.ResponseRecorder {
+	r := httptest.NewRequest("GET", target, nil)
+	for k, v := range header {
+		r.Header[k] = v
+	}
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+	return w
+}
+
+func decode(t *testing.T, w *httptest.ResponseRecorder) []autocomplete.Result {
+	if w.Code != http.StatusOK {
+		t.Fatalf("unexpected status %d: %s", w.Code, w.Body.String())
+	}
+	if expected, actual := "application/json; charset=utf-8", w.Header().Get("Content-Type"); expected != actual {
+		t.Errorf("Content-Type: %q != %q", expected, actual)
+	}
+
+	var results []autocomplete.Result
+	if err := json.Unmarshal(w.Body.Bytes(), &results); err != nil {
+		t.Fatal(err)
+	}
+	return results
+}
+
+func TestHandler(t *testing.T) {
+	t.Run("Search", func(t *testing.T) {
+		results := decode(t, get(t, &autocomplete.Handler{}, "/?q=minidisc&max=1", nil))
+
+		expected := []autocomplete.Result{
+			{
+				Emoji:       "💽",
+				Aliases:     []string{"minidisc"},
+				Description: "computer disk",
+				Score:       3500,
+			},
+		}
+		if !reflect.DeepEqual(expected, results) {
+			t.Errorf("%#v != %#v", expected, results)
+		}
+	})
+
+	t.Run("Config", func(t *testing.T) {
+		conf := &emoji.Config{}
+		conf.AddImage("http://thedailywtf.com/favicon.ico", "wtf", []string{"wtf"}, "The Daily WTF", nil)
+
+		results := decode(t, get(t, &autocomplete.Handler{Config: conf}, "/?q=wtf", nil))
+		if len(results) == 0 {
+			t.Fatal("no results")
+		}
+		if expected, actual := "http://thedailywtf.com/favicon.ico", results[0].ImageURL; expected != actual {
+			t.Errorf("ImageURL: %q != %q", expected, actual)
+		}
+		if results[0].Emoji != "" {
+			t.Errorf("unexpected Emoji %q", results[0].Emoji)
+		}
+	})
+
+	t.Run("Max", func(t *testing.T) {
+		h := &autocomplete.Handler{DefaultMax: 3, Limit: 5}
+
+		if results := decode(t, get(t, h, "/?q=cat", nil)); len(results) != 3 {
+			t.Errorf("default: unexpected len(results) == %d", len(results))
+		}
+		if results := decode(t, get(t, h, "/?q=cat&max=50", nil)); len(results) != 5 {
+			t.Errorf("limit: unexpected len(results) == %d", len(results))
+		}
+		if results := decode(t, get(t, h, "/?q=&max=5", nil)); len(results) != 0 {
+			t.Errorf("empty query: unexpected len(results) == %d", len(results))
+		}
+		if w := get(t, h, "/?q=cat&max=many", nil); w.Code != http.StatusBadRequest {
+			t.Errorf("invalid max: unexpected status %d", w.Code)
+		}
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		w := get(t, &autocomplete.Handler{}, "/?q=", nil)
+		if expected, actual := "[]\n", w.Body.String(); expected != actual {
+			t.Errorf("%q != %q", expected, actual)
+		}
+	})
+
+	t.Run("Cache", func(t *testing.T) {
+		h := &autocomplete.Handler{MaxAge: 5 * time.Minute}
+
+		w := get(t, h, "/?q=tada", nil)
+		if expected, actual := "public, max-age=300", w.Header().Get("Cache-Control"); expected != actual {
+			t.Errorf("Cache-Control: %q != %q", expected, actual)
+		}
+		etag := w.Header().Get("ETag")
+		if etag == "" {
+			t.Fatal("missing ETag")
+		}
+
+		w = get(t, h, "/?q=tada", http.Header{"If-None-Match": {etag}})
+		if w.Code != http.StatusNotModified {
+			t.Errorf("unexpected status %d", w.Code)
+		}
+		if w.Body.Len() != 0 {
+			t.Errorf("unexpected body %q", w.Body.String())
+		}
+
+		for _, match := range []string{
+			`"other", ` + etag,
+			"W/" + etag,
+			`"other",W/` + etag + `, "another"`,
+			"*",
+		} {
+			w = get(t, h, "/?q=tada", http.Header{"If-None-Match": {match}})
+			if w.Code != http.StatusNotModified {
+				t.Errorf("If-None-Match %q: unexpected status %d", match, w.Code)
+			}
+		}
+
+		w = get(t, h, "/?q=popcorn", http.Header{"If-None-Match": {etag}})
+		if w.Code != http.StatusOK {
+			t.Errorf("unexpected status %d", w.Code)
+		}
+
+		w = get(t, &autocomplete.Handler{MaxAge: -1}, "/?q=tada", nil)
+		if expected, actual := "no-store", w.Header().Get("Cache-Control"); expected != actual {
+			t.Errorf("Cache-Control: %q != %q", expected, actual)
+		}
+	})
+
+	t.Run("Method", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		(&autocomplete.Handler{}).ServeHTTP(w, httptest.NewRequest("POST", "/?q=tada", nil))
+		if w.Code != http.StatusMethodNotAllowed {
+			t.Errorf("unexpected status %d", w.Code)
+		}
+		if expected, actual := "GET, HEAD", w.Header().Get("Allow"); expected != actual {
+			t.Errorf("Allow: %q != %q", expected, actual)
+		}
+	})
+}
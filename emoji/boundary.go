@@ -0,0 +1,86 @@
+package emoji
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// urlPattern matches URLs and email addresses in plain text. The local part
+// of an email address may contain colons after its first character, so that
+// shortcodes in addresses such as user:tada:@example.com are left alone.
+var urlPattern = regexp.MustCompile(`(?i)\b(?:[a-z][a-z0-9+.\-]*://|www\.|mailto:)[^\s<>"]+|[a-z0-9._%+\-][a-z0-9._%+\-:]*@[a-z0-9\-]+(?:\.[a-z0-9\-]+)+`)
+
+// wordBoundary reports whether the match between start and end is not
+// immediately preceded or followed by a letter or digit.
+func wordBoundary(text string, start, end int) bool {
+	if start != 0 {
+		if r, _ := utf8.DecodeLastRuneInString(text[:start]); unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return false
+		}
+	}
+	if end != len(text) {
+		if r, _ := utf8.DecodeRuneInString(text[end:]); unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return false
+		}
+	}
+	return true
+}
+
+// overlapsAny reports whether span overlaps any of the sorted spans.
+func overlapsAny(spans [][]int, span [2]int) bool {
+	for _, s := range spans {
+		if s[0] >= span[1] {
+			break
+		}
+		if s[1] > span[0] {
+			return true
+		}
+	}
+	return false
+}
+
+// isAutolink reports whether node is a link whose text is the same as its
+// href, ignoring the scheme and any trailing slash.
+func isAutolink(node *html.Node) bool {
+	if node.Namespace != "" || node.DataAtom != atom.A {
+		return false
+	}
+
+	var href string
+	for _, a := range node.Attr {
+		if a.Namespace == "" && a.Key == "href" {
+			href = a.Val
+			break
+		}
+	}
+	if href == "" {
+		return false
+	}
+
+	text := strings.TrimSuffix(strings.TrimSpace(textContent(node)), "/")
+	href = strings.TrimSuffix(href, "/")
+	if text == href {
+		return true
+	}
+	if i := strings.Index(href, "://"); i != -1 && text == href[i+len("://"):] {
+		return true
+	}
+	return text == strings.TrimPrefix(href, "mailto:")
+}
+
+func textContent(node *html.Node) string {
+	if node.Type == html.TextNode {
+		return node.Data
+	}
+
+	var buf []byte
+	for child := node.FirstChild; child != nil; child = child.NextSibling {
+		buf = append(buf, textContent(child)...)
+	}
+	return string(buf)
+}
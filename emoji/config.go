@@ -1,22 +1,102 @@
 package emoji
 
-import "strings"
+import (
+	"strings"
+
+	"golang.org/x/net/html"
+)
 
 // Config is a custom emoji set that extends the default set.
 type Config struct {
-	state      *state
-	emoji      []*emoji
-	byName     map[string]*emoji
-	tags       []string
-	byTag      [][]*emoji
-	categories []string
-	byCategory [][]*emoji
-}
+	// Locale is the CLDR locale (such as de, ja, or pt-BR) used for emoji
+	// descriptions in tooltips and for matching keywords in Search. If
+	// Locale is empty or there is no annotation for an emoji in that locale,
+	// the English description is used.
+	Locale string
+
+	// WordBoundary requires a shortcode to be preceded and followed by a
+	// character that is not a letter or digit, so text like 10:30:ok: is
+	// left alone.
+	WordBoundary bool
+
+	// SkipURLs leaves emoji, shortcodes, and emoticons inside URLs and email
+	// addresses in text unchanged.
+	SkipURLs bool
+
+	// SkipAutolinks leaves the text of links unchanged if it is the same as
+	// the link's href, with or without the scheme.
+	SkipAutolinks bool
+
+	// SkipElements lists the names of additional HTML elements, such as kbd,
+	// samp, or textarea, whose contents are left unchanged. The contents of
+	// script, style, pre, and code elements are always left unchanged unless
+	// they are listed in AllowElements.
+	SkipElements []string
+
+	// AllowElements lists the names of HTML elements, such as pre, whose
+	// contents are replaced even though they would otherwise be skipped.
+	AllowElements []string
+
+	// SkipClasses lists class names. The contents of elements with any of
+	// these classes are left unchanged.
+	SkipClasses []string
+
+	// SkipEditable leaves the contents of contenteditable elements unchanged.
+	SkipEditable bool
+
+	// ReplaceForeign replaces emoji in SVG text elements and MathML token
+	// elements. HTML cannot be used inside these elements, so shortcodes are
+	// replaced with plain Unicode emoji and image emoji are left unchanged.
+	// Other SVG and MathML content is always left unchanged.
+	ReplaceForeign bool
+
+	// CaseSensitive matches shortcodes exactly as they were added, so
+	// :PogChamp: and :pogchamp: can be different emoji and :TADA: is not
+	// :tada:. By default, shortcodes are matched regardless of case,
+	// diacritics, and punctuation, and an exact match is preferred if there
+	// is one. CaseSensitive cannot be changed after emoji have been added to
+	// the Config.
+	CaseSensitive bool
 
-var defaultConfig = &Config{
-	state: startState,
+	// UnknownShortcode, if it is not nil, is called by Replace with text
+	// that looks like a shortcode, such as :partyparrot:, but is not
+	// defined in this Config or the default set. The shortcode is replaced
+	// with the returned nodes, or left as text if UnknownShortcode returns
+	// nil. The nodes must not already have a parent.
+	UnknownShortcode func(name string) []*html.Node
+
+	// JumboClass, if it is not empty, is added to the class of the emoji
+	// Replace outputs when the nodes passed to Replace, or a block element
+	// such as a paragraph or list item, contain only emoji and whitespace,
+	// so clients can display short emoji-only messages at a larger size.
+	JumboClass string
+
+	// JumboMax is the largest number of emoji that JumboClass is added to.
+	// If JumboMax is zero, there is no limit.
+	JumboMax int
+
+	// SpritePrefix, if it is not empty, makes Replace output image emoji
+	// that are not animated as span elements with the class SpritePrefix
+	// followed by the first alias without whitespace, such as
+	// emoji-shipit, for use with a sprite sheet made by the sprite package.
+	// Images whose aliases all contain whitespace are output as usual.
+	SpritePrefix string
+
+	names         *matcher
+	annotations   map[string]map[*emoji]*annotation
+	emoji         []*emoji
+	byName        map[string]*emoji
+	byFold        map[string]*emoji
+	emoticons     map[string]*emoji
+	emoticonNames *matcher
+	tags          []string
+	byTag         [][]*emoji
+	categories    []string
+	byCategory    [][]*emoji
 }
 
+var defaultConfig = &Config{}
+
 func (conf *Config) overrides(e *emoji) bool {
 	/*
 		// We don't currently have any images in the default set.
@@ -51,13 +131,52 @@ func (conf *Config) AddEmoji(unicodeEmoji, description string, aliases []string,
 
 // AddImage adds an image as a pseudo-emoji. At least one alias is required.
 func (conf *Config) AddImage(imageURL, description string, aliases []string, category string, tags []string) {
+	conf.AddImageInfo(ImageInfo{URL: imageURL}, description, aliases, category, tags)
+}
+
+// ImageInfo describes an image pseudo-emoji.
+type ImageInfo struct {
+	// URL is the URL of the image.
+	URL string
+
+	// Text is used in place of the image in plain text, such as a Unicode
+	// stand-in or ASCII art. If Text is empty, the shortcode is used.
+	Text string
+
+	// Srcset lists versions of the image for high-density screens, such as
+	// "/emoji/shipit@2x.png 2x", for the srcset attribute.
+	Srcset []string
+
+	// Width and Height are the dimensions of the image in CSS pixels. If
+	// they are not zero, they are output as attributes so that the page
+	// does not move when the image loads.
+	Width, Height int
+
+	// Animated is set if the image is animated. If StaticURL is also set,
+	// users who prefer reduced motion are shown StaticURL instead, using a
+	// picture element with the emoji class.
+	Animated bool
+
+	// StaticURL is the URL of a still version of an animated image.
+	StaticURL string
+
+	// StaticSrcset is like Srcset for StaticURL.
+	StaticSrcset []string
+}
+
+// AddImageInfo is like AddImage, but it accepts more information about the
+// image.
+func (conf *Config) AddImageInfo(info ImageInfo, description string, aliases []string, category string, tags []string) {
 	if len(aliases) == 0 {
 		panic("emoji: image needs at least one alias")
 	}
 	conf.validateAliases(aliases)
 
+	info.Srcset = append([]string(nil), info.Srcset...)
+	info.StaticSrcset = append([]string(nil), info.StaticSrcset...)
+
 	e := &emoji{
-		imageURL:    imageURL,
+		image:       &info,
 		description: description,
 		aliases:     aliases,
 	}
@@ -65,6 +184,17 @@ func (conf *Config) AddImage(imageURL, description string, aliases []string, cat
 	conf.addEmoji(e, aliases, category, tags)
 }
 
+// Images returns the image emoji in the Config, in the order they were added.
+func (conf *Config) Images() []SearchResult {
+	var results []SearchResult
+	for _, e := range conf.emoji {
+		if e.image != nil {
+			results = append(results, SearchResult{emoji: e, annotation: conf.annotation(e)})
+		}
+	}
+	return results
+}
+
 func (conf *Config) validateAliases(aliases []string) {
 	for _, a := range aliases {
 		if a == "" {
@@ -95,11 +225,34 @@ func (conf *Config) addEmoji(e *emoji, aliases []string, category string, tags [
 
 func (conf *Config) addName(name string, e *emoji) {
 	conf.byName[name] = e
-	if conf.state == nil {
-		conf.state = startState.add(strings.ToLower(name))
-	} else {
-		conf.state = conf.state.add(strings.ToLower(name))
+	key := conf.key(name)
+	if key != name {
+		if conf.byFold == nil {
+			conf.byFold = make(map[string]*emoji)
+		}
+		// The first name with a folded form is the canonical one.
+		if _, ok := conf.byFold[key]; !ok {
+			conf.byFold[key] = e
+		}
 	}
+	m := conf.nameMatcher()
+	if conf.names == nil {
+		m = m.extend()
+	}
+	conf.names = m.add(key)
+}
+
+// get returns the emoji with the exact name from this Config or the default
+// set, or panics if there is no such emoji.
+func (conf *Config) get(name string) *emoji {
+	e, ok := conf.byName[name]
+	if !ok {
+		e, ok = defaults().byName[name]
+	}
+	if !ok {
+		panic("emoji: not defined: " + name)
+	}
+	return e
 }
 
 func addBy(by *[][]*emoji, names *[]string, e *emoji, name string) {
@@ -112,3 +265,19 @@ func addBy(by *[][]*emoji, names *[]string, e *emoji, name string) {
 	*names = append(*names, name)
 	*by = append(*by, []*emoji{e})
 }
+
+// nameMatcher returns the matcher for the names in this Config and the default
+// set. The names added to the Config extend the shared matcher for the
+// default set.
+func (conf *Config) nameMatcher() *matcher {
+	if conf.names == nil {
+		if conf.CaseSensitive {
+			return exactStartMatcher()
+		}
+		return startMatcher()
+	}
+	if conf.names.exact != conf.CaseSensitive {
+		panic("emoji: CaseSensitive changed after emoji were added")
+	}
+	return conf.names
+}
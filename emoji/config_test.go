@@ -1,8 +1,11 @@
 package emoji_test
 
 import (
+	"fmt"
 	"testing"
 
+	"golang.org/x/net/html"
+
 	"github.com/BenLubar/hellstew/emoji"
 )
 
@@ -70,3 +73,28 @@ func expectPanic(t *testing.T, expected interface{}) {
 		t.Errorf("Got panic: %v", r)
 	}
 }
+
+// BenchmarkAddImage measures the memory used by a Config with 1000 custom
+// emoji, including the state machine built the first time it is used.
+func BenchmarkAddImage(b *testing.B) {
+	aliases := make([][]string, 1000)
+	for i := range aliases {
+		aliases[i] = []string{fmt.Sprintf("community_emoji_%d", i)}
+	}
+	text := &html.Node{
+		Type: html.TextNode,
+		Data: ":community_emoji_1: :tada:",
+	}
+
+	// Build the state machine for the default set outside the loop.
+	emoji.Replace(text)
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		var conf emoji.Config
+		for _, a := range aliases {
+			conf.AddImage("/emoji/"+a[0]+".png", a[0], a, "Community", nil)
+		}
+		conf.Replace(text)
+	}
+}
@@ -0,0 +1,221 @@
+package emoji
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// embeddedContent lists the HTML elements that are visible content even if
+// they contain no text.
+var embeddedContent = map[atom.Atom]bool{
+	atom.Audio:  true,
+	atom.Canvas: true,
+	atom.Embed:  true,
+	atom.Iframe: true,
+	atom.Img:    true,
+	atom.Object: true,
+	atom.Video:  true,
+}
+
+// blockElement lists the HTML elements whose contents are checked separately
+// for Config.JumboClass.
+var blockElement = map[atom.Atom]bool{
+	atom.Article:    true,
+	atom.Blockquote: true,
+	atom.Dd:         true,
+	atom.Div:        true,
+	atom.Dt:         true,
+	atom.Figcaption: true,
+	atom.H1:         true,
+	atom.H2:         true,
+	atom.H3:         true,
+	atom.H4:         true,
+	atom.H5:         true,
+	atom.H6:         true,
+	atom.Li:         true,
+	atom.P:          true,
+	atom.Section:    true,
+	atom.Td:         true,
+	atom.Th:         true,
+}
+
+// Count returns the number of Unicode emoji and shortcodes in text.
+func Count(text string) int {
+	return defaultConfig.Count(text)
+}
+
+// Count returns the number of Unicode emoji, shortcodes, and emoticons in text
+// that Replace would replace.
+func (conf *Config) Count(text string) int {
+	return len(conf.findEmoji(text))
+}
+
+// Len returns the length of text in code points, with each Unicode emoji and
+// shortcode counted as one, no matter how many code points it contains.
+func Len(text string) int {
+	return defaultConfig.Len(text)
+}
+
+// Len returns the length of text in code points, with each Unicode emoji,
+// shortcode, and emoticon that Replace would replace counted as one, no
+// matter how many code points it contains.
+func (conf *Config) Len(text string) int {
+	n := 0
+	last := 0
+	for _, match := range conf.findEmoji(text) {
+		n += utf8.RuneCountInString(text[last:match.start]) + 1
+		last = match.end
+	}
+	return n + utf8.RuneCountInString(text[last:])
+}
+
+// EmojiOnly reports whether text contains at least one Unicode emoji or
+// shortcode and nothing else but whitespace.
+func EmojiOnly(text string) bool {
+	return defaultConfig.EmojiOnly(text)
+}
+
+// EmojiOnly reports whether text contains at least one Unicode emoji,
+// shortcode, or emoticon that Replace would replace and nothing else but
+// whitespace.
+func (conf *Config) EmojiOnly(text string) bool {
+	n, ok := conf.countOnly(text)
+	return ok && n != 0
+}
+
+// EmojiOnlyNodes reports whether the nodes contain at least one emoji and
+// nothing else but whitespace. The nodes may be the input or the output of
+// Replace.
+func EmojiOnlyNodes(nodes ...*html.Node) bool {
+	return defaultConfig.EmojiOnlyNodes(nodes...)
+}
+
+// EmojiOnlyNodes reports whether the nodes contain at least one emoji and
+// nothing else but whitespace. The nodes may be the input or the output of
+// Replace; elements with the emoji class count as one emoji. Images, videos,
+// and other embedded content, and elements that Replace skips that contain
+// text, are not emoji.
+func (conf *Config) EmojiOnlyNodes(nodes ...*html.Node) bool {
+	n, ok := conf.countOnlyNodes(nodes, nil)
+	return ok && n != 0
+}
+
+// countOnly returns the number of emoji in text and whether text contains
+// nothing else but whitespace.
+func (conf *Config) countOnly(text string) (int, bool) {
+	matches := conf.findEmoji(text)
+	last := 0
+	for _, match := range matches {
+		if !isSpace(text[last:match.start]) {
+			return 0, false
+		}
+		last = match.end
+	}
+	if !isSpace(text[last:]) {
+		return 0, false
+	}
+	return len(matches), true
+}
+
+// countOnlyNodes is like countOnly for a list of nodes and their
+// descendants. If blocks is nil, it stops at the first node that is not emoji
+// or whitespace. Otherwise, every node is visited, and each block element
+// whose emoji should be given the JumboClass is added to blocks, so that
+// Replace does not count the emoji in each block again.
+func (conf *Config) countOnlyNodes(nodes []*html.Node, blocks map[*html.Node]bool) (int, bool) {
+	total, only := 0, true
+	for _, node := range nodes {
+		var n int
+		var ok bool
+
+		switch node.Type {
+		case html.TextNode:
+			n, ok = conf.countOnly(node.Data)
+		case html.ElementNode:
+			n, ok = conf.countOnlyElement(node, blocks)
+		case html.DocumentNode:
+			n, ok = conf.countOnlyChildren(node, blocks)
+		default:
+			ok = true
+		}
+
+		if !ok {
+			if blocks == nil {
+				return 0, false
+			}
+			only = false
+		}
+		total += n
+	}
+	if !only {
+		return 0, false
+	}
+	return total, true
+}
+
+func (conf *Config) countOnlyElement(node *html.Node, blocks map[*html.Node]bool) (int, bool) {
+	if hasClass(node, "emoji") {
+		return 1, true
+	}
+	if node.Namespace != "" || embeddedContent[node.DataAtom] {
+		return 0, false
+	}
+	if conf.skip(node) {
+		return 0, isSpace(textContent(node))
+	}
+
+	n, ok := conf.countOnlyChildren(node, blocks)
+	if blocks != nil && blockElement[node.DataAtom] && conf.jumboCount(n, ok) {
+		blocks[node] = true
+	}
+	return n, ok
+}
+
+func (conf *Config) countOnlyChildren(node *html.Node, blocks map[*html.Node]bool) (int, bool) {
+	var children []*html.Node
+	for child := node.FirstChild; child != nil; child = child.NextSibling {
+		children = append(children, child)
+	}
+	return conf.countOnlyNodes(children, blocks)
+}
+
+func isSpace(s string) bool {
+	return strings.IndexFunc(s, func(r rune) bool {
+		return !unicode.IsSpace(r)
+	}) == -1
+}
+
+// Jumbo reports whether Replace would give the emoji in nodes the JumboClass:
+// JumboClass is set, and the nodes contain only emoji and whitespace, and no
+// more than JumboMax emoji.
+func (conf *Config) Jumbo(nodes ...*html.Node) bool {
+	return conf.jumbo(nodes)
+}
+
+// jumbo reports whether the emoji in nodes should be given the JumboClass.
+func (conf *Config) jumbo(nodes []*html.Node) bool {
+	if conf.JumboClass == "" {
+		return false
+	}
+	return conf.jumboCount(conf.countOnlyNodes(nodes, nil))
+}
+
+// jumboBlocks is like jumbo, but it also returns the block elements in nodes
+// whose emoji should be given the JumboClass, found in a single pass.
+func (conf *Config) jumboBlocks(nodes []*html.Node) (bool, map[*html.Node]bool) {
+	if conf.JumboClass == "" {
+		return false, nil
+	}
+	blocks := make(map[*html.Node]bool)
+	return conf.jumboCount(conf.countOnlyNodes(nodes, blocks)), blocks
+}
+
+// jumboCount reports whether n emoji should be given the JumboClass, if ok
+// reports that there is nothing else but whitespace.
+func (conf *Config) jumboCount(n int, ok bool) bool {
+	return ok && n != 0 && (conf.JumboMax <= 0 || n <= conf.JumboMax)
+}
@@ -0,0 +1,85 @@
+package emoji_test
+
+import (
+	"strings"
+	"testing"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+
+	"github.com/BenLubar/hellstew/emoji"
+)
+
+func TestCount(t *testing.T) {
+	for _, tt := range []struct {
+		text      string
+		count     int
+		length    int
+		emojiOnly bool
+	}{
+		{"", 0, 0, false},
+		{"   ", 0, 3, false},
+		{"hello", 0, 5, false},
+		{"🎉", 1, 1, true},
+		{" 🎉 :tada:\n", 2, 5, true},
+		{"👨‍👩‍👧", 1, 1, true},
+		{"🇯🇵🇺🇸", 2, 2, true},
+		{"I ❤️ Go", 1, 6, false},
+		{"café :coffee:", 1, 6, false},
+		{":not_an_emoji:", 0, 14, false},
+	} {
+		if count := emoji.Count(tt.text); count != tt.count {
+			t.Errorf("Count(%q) = %d, expected %d", tt.text, count, tt.count)
+		}
+		if length := emoji.Len(tt.text); length != tt.length {
+			t.Errorf("Len(%q) = %d, expected %d", tt.text, length, tt.length)
+		}
+		if emojiOnly := emoji.EmojiOnly(tt.text); emojiOnly != tt.emojiOnly {
+			t.Errorf("EmojiOnly(%q) = %v, expected %v", tt.text, emojiOnly, tt.emojiOnly)
+		}
+	}
+
+	t.Run("Emoticon", func(t *testing.T) {
+		if !emoticonConfig.EmojiOnly(":) <3") {
+			t.Error("emoticons should count as emoji")
+		}
+		if emoji.EmojiOnly(":) <3") {
+			t.Error("emoticons should not count as emoji without AddDefaultEmoticons")
+		}
+	})
+}
+
+func TestEmojiOnlyNodes(t *testing.T) {
+	for _, tt := range []struct {
+		html      string
+		emojiOnly bool
+	}{
+		{``, false},
+		{`<p> </p>`, false},
+		{`<p>🎉</p>`, true},
+		{`<p><b>🎉</b> <i>:tada:</i><br/></p><!-- comment -->`, true},
+		{`<p><abbr class="emoji" title="party popper">🎉</abbr></p>`, true},
+		{`<img src="/images/shipit.png" alt=":shipit:" class="emoji"/>`, true},
+		{`<img src="/photo.jpg"/> 🎉`, false},
+		{`<p>🎉 and</p>`, false},
+		{`<code>🎉</code>`, false},
+		{`🎉 <code> </code>`, true},
+		{`<svg></svg>🎉`, false},
+	} {
+		nodes, err := html.ParseFragment(strings.NewReader(tt.html), &html.Node{
+			Type:     html.ElementNode,
+			Data:     "div",
+			DataAtom: atom.Div,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if emojiOnly := emoji.EmojiOnlyNodes(nodes...); emojiOnly != tt.emojiOnly {
+			t.Errorf("EmojiOnlyNodes(%q) = %v, expected %v", tt.html, emojiOnly, tt.emojiOnly)
+		}
+		if emojiOnly := emoji.EmojiOnlyNodes(emoji.Replace(nodes...)...); emojiOnly != tt.emojiOnly {
+			t.Errorf("EmojiOnlyNodes(Replace(%q)) = %v, expected %v", tt.html, emojiOnly, tt.emojiOnly)
+		}
+	}
+}
@@ -0,0 +1,165 @@
+package emoji
+
+import "sync"
+
+type emoji struct {
+	emoji       string
+	image       *ImageInfo
+	description string
+	aliases     []string
+}
+
+// defaultSet is the default emoji set, decoded from emojiData.
+type defaultSet struct {
+	allEmoji   []emoji
+	byName     map[string]*emoji
+	byFold     map[string]*emoji
+	categories []string
+	byCategory [][]*emoji
+	tags       []string
+	byTag      [][]*emoji
+}
+
+var (
+	defaultsOnce sync.Once
+	defaultsData *defaultSet
+
+	startStateOnce sync.Once
+	startStateData *state
+)
+
+// defaults returns the default emoji set. It is decoded the first time it is
+// needed rather than at init, so programs that import this package but rarely
+// use it start quickly.
+func defaults() *defaultSet {
+	defaultsOnce.Do(func() {
+		defaultsData = decodeData(emojiData)
+	})
+	return defaultsData
+}
+
+// startState returns the state machine that matches the names in the default
+// set. It is built the first time it is needed.
+func startState() *state {
+	startStateOnce.Do(func() {
+		var root state
+		for name := range defaults().byName {
+			root.addInPlace(fold(name))
+		}
+		startStateData = &root
+	})
+	return startStateData
+}
+
+// decodeData decodes the default emoji set. The data consists of uvarints and
+// strings, where a string is a uvarint length followed by that many bytes:
+//
+//	number of emoji
+//	for each emoji:
+//		emoji, description
+//		number of aliases, aliases
+//		number of variants, variants
+//	number of categories
+//	for each category:
+//		name
+//		number of emoji, differences between consecutive emoji indices
+//	tags, in the same format as categories
+//
+// Variants are other Unicode forms of the emoji, such as minimally-qualified
+// sequences. Decoded strings share memory with data.
+func decodeData(data string) *defaultSet {
+	d := &dataDecoder{data: data}
+	set := &defaultSet{
+		allEmoji: make([]emoji, d.uvarint()),
+	}
+
+	set.byName = make(map[string]*emoji, 2*len(set.allEmoji))
+	for i := range set.allEmoji {
+		e := &set.allEmoji[i]
+		e.emoji = d.string()
+		e.description = d.string()
+		e.aliases = d.strings()
+		set.byName[e.emoji] = e
+		for n := d.uvarint(); n > 0; n-- {
+			set.byName[d.string()] = e
+		}
+		for _, a := range e.aliases {
+			set.byName[":"+a+":"] = e
+		}
+	}
+
+	set.categories, set.byCategory = d.sets(set.allEmoji)
+	set.tags, set.byTag = d.sets(set.allEmoji)
+
+	if d.data != "" {
+		panic("emoji: invalid emoji data")
+	}
+
+	set.byFold = make(map[string]*emoji)
+	for name, e := range set.byName {
+		if key := fold(name); key != name {
+			set.byFold[key] = e
+		}
+	}
+
+	return set
+}
+
+type dataDecoder struct {
+	data string
+}
+
+func (d *dataDecoder) uvarint() int {
+	var x uint64
+	var s uint
+	for i := 0; i < len(d.data); i++ {
+		b := d.data[i]
+		if b < 0x80 {
+			d.data = d.data[i+1:]
+			return int(x | uint64(b)<<s)
+		}
+		x |= uint64(b&0x7f) << s
+		s += 7
+	}
+	panic("emoji: invalid emoji data")
+}
+
+func (d *dataDecoder) string() string {
+	n := d.uvarint()
+	if n > len(d.data) {
+		panic("emoji: invalid emoji data")
+	}
+	s := d.data[:n]
+	d.data = d.data[n:]
+	return s
+}
+
+func (d *dataDecoder) strings() []string {
+	n := d.uvarint()
+	if n == 0 {
+		return nil
+	}
+	list := make([]string, n)
+	for i := range list {
+		list[i] = d.string()
+	}
+	return list
+}
+
+func (d *dataDecoder) sets(allEmoji []emoji) ([]string, [][]*emoji) {
+	names := make([]string, d.uvarint())
+	by := make([][]*emoji, len(names))
+	for i := range names {
+		names[i] = d.string()
+		by[i] = make([]*emoji, d.uvarint())
+		idx := 0
+		for j := range by[i] {
+			idx += d.uvarint()
+			if idx >= len(allEmoji) {
+				panic("emoji: invalid emoji data")
+			}
+			by[i][j] = &allEmoji[idx]
+		}
+	}
+	return names, by
+}
@@ -0,0 +1,21 @@
+package emoji_test
+
+import (
+	"testing"
+
+	"github.com/BenLubar/hellstew/emoji"
+)
+
+func BenchmarkDecodeData(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		emoji.DecodeData()
+	}
+}
+
+func BenchmarkBuildStartState(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		emoji.BuildStartState()
+	}
+}
@@ -3,15509 +3,1733 @@
 
 package emoji
 
-type emoji struct {
-	emoji       string
-	imageURL    string
-	description string
-	aliases     []string
-}
-
-var allEmoji = [...]emoji{
-	{
-		emoji:       "😀",
-		description: "grinning face",
-		aliases: []string{
-			"grinning",
-		},
-	},
-	{
-		emoji:       "😬",
-		description: "grimacing face",
-		aliases: []string{
-			"grimacing",
-		},
-	},
-	{
-		emoji:       "😁",
-		description: "grinning face with smiling eyes",
-		aliases: []string{
-			"grin",
-		},
-	},
-	{
-		emoji:       "😂",
-		description: "face with tears of joy",
-		aliases: []string{
-			"joy",
-		},
-	},
-	{
-		emoji:       "😃",
-		description: "smiling face with open mouth",
-		aliases: []string{
-			"smiley",
-		},
-	},
-	{
-		emoji:       "😄",
-		description: "smiling face with open mouth & smiling eyes",
-		aliases: []string{
-			"smile",
-		},
-	},
-	{
-		emoji:       "😅",
-		description: "smiling face with open mouth & cold sweat",
-		aliases: []string{
-			"sweat_smile",
-		},
-	},
-	{
-		emoji:       "😆",
-		description: "smiling face with open mouth & closed eyes",
-		aliases: []string{
-			"laughing",
-			"satisfied",
-		},
-	},
-	{
-		emoji:       "😇",
-		description: "smiling face with halo",
-		aliases: []string{
-			"innocent",
-		},
-	},
-	{
-		emoji:       "😉",
-		description: "winking face",
-		aliases: []string{
-			"wink",
-		},
-	},
-	{
-		emoji:       "😊",
-		description: "smiling face with smiling eyes",
-		aliases: []string{
-			"blush",
-		},
-	},
-	{
-		emoji:       "🙂",
-		description: "slightly smiling face",
-		aliases: []string{
-			"slightly_smiling_face",
-		},
-	},
-	{
-		emoji:       "🙃",
-		description: "upside-down face",
-		aliases: []string{
-			"upside_down_face",
-		},
-	},
-	{
-		emoji:       "☺️",
-		description: "smiling face",
-		aliases: []string{
-			"relaxed",
-		},
-	},
-	{
-		emoji:       "😋",
-		description: "face savouring delicious food",
-		aliases: []string{
-			"yum",
-		},
-	},
-	{
-		emoji:       "😌",
-		description: "relieved face",
-		aliases: []string{
-			"relieved",
-		},
-	},
-	{
-		emoji:       "😍",
-		description: "smiling face with heart-eyes",
-		aliases: []string{
-			"heart_eyes",
-		},
-	},
-	{
-		emoji:       "😘",
-		description: "face blowing a kiss",
-		aliases: []string{
-			"kissing_heart",
-		},
-	},
-	{
-		emoji:       "😗",
-		description: "kissing face",
-		aliases: []string{
-			"kissing",
-		},
-	},
-	{
-		emoji:       "😙",
-		description: "kissing face with smiling eyes",
-		aliases: []string{
-			"kissing_smiling_eyes",
-		},
-	},
-	{
-		emoji:       "😚",
-		description: "kissing face with closed eyes",
-		aliases: []string{
-			"kissing_closed_eyes",
-		},
-	},
-	{
-		emoji:       "😜",
-		description: "face with stuck-out tongue & winking eye",
-		aliases: []string{
-			"stuck_out_tongue_winking_eye",
-		},
-	},
-	{
-		emoji:       "😝",
-		description: "face with stuck-out tongue & closed eyes",
-		aliases: []string{
-			"stuck_out_tongue_closed_eyes",
-		},
-	},
-	{
-		emoji:       "😛",
-		description: "face with stuck-out tongue",
-		aliases: []string{
-			"stuck_out_tongue",
-		},
-	},
-	{
-		emoji:       "🤑",
-		description: "money-mouth face",
-		aliases: []string{
-			"money_mouth_face",
-		},
-	},
-	{
-		emoji:       "🤓",
-		description: "nerd face",
-		aliases: []string{
-			"nerd_face",
-		},
-	},
-	{
-		emoji:       "😎",
-		description: "smiling face with sunglasses",
-		aliases: []string{
-			"sunglasses",
-		},
-	},
-	{
-		emoji:       "🤗",
-		description: "hugging face",
-		aliases: []string{
-			"hugs",
-		},
-	},
-	{
-		emoji:       "😏",
-		description: "smirking face",
-		aliases: []string{
-			"smirk",
-		},
-	},
-	{
-		emoji:       "😶",
-		description: "face without mouth",
-		aliases: []string{
-			"no_mouth",
-		},
-	},
-	{
-		emoji:       "😐",
-		description: "neutral face",
-		aliases: []string{
-			"neutral_face",
-		},
-	},
-	{
-		emoji:       "😑",
-		description: "expressionless face",
-		aliases: []string{
-			"expressionless",
-		},
-	},
-	{
-		emoji:       "😒",
-		description: "unamused face",
-		aliases: []string{
-			"unamused",
-		},
-	},
-	{
-		emoji:       "🙄",
-		description: "face with rolling eyes",
-		aliases: []string{
-			"roll_eyes",
-		},
-	},
-	{
-		emoji:       "🤔",
-		description: "thinking face",
-		aliases: []string{
-			"thinking",
-		},
-	},
-	{
-		emoji:       "😳",
-		description: "flushed face",
-		aliases: []string{
-			"flushed",
-		},
-	},
-	{
-		emoji:       "😞",
-		description: "disappointed face",
-		aliases: []string{
-			"disappointed",
-		},
-	},
-	{
-		emoji:       "😟",
-		description: "worried face",
-		aliases: []string{
-			"worried",
-		},
-	},
-	{
-		emoji:       "😠",
-		description: "angry face",
-		aliases: []string{
-			"angry",
-		},
-	},
-	{
-		emoji:       "😡",
-		description: "pouting face",
-		aliases: []string{
-			"rage",
-			"pout",
-		},
-	},
-	{
-		emoji:       "😔",
-		description: "pensive face",
-		aliases: []string{
-			"pensive",
-		},
-	},
-	{
-		emoji:       "😕",
-		description: "confused face",
-		aliases: []string{
-			"confused",
-		},
-	},
-	{
-		emoji:       "🙁",
-		description: "slightly frowning face",
-		aliases: []string{
-			"slightly_frowning_face",
-		},
-	},
-	{
-		emoji:       "☹️",
-		description: "frowning face",
-		aliases: []string{
-			"frowning_face",
-		},
-	},
-	{
-		emoji:       "😣",
-		description: "persevering face",
-		aliases: []string{
-			"persevere",
-		},
-	},
-	{
-		emoji:       "😖",
-		description: "confounded face",
-		aliases: []string{
-			"confounded",
-		},
-	},
-	{
-		emoji:       "😫",
-		description: "tired face",
-		aliases: []string{
-			"tired_face",
-		},
-	},
-	{
-		emoji:       "😩",
-		description: "weary face",
-		aliases: []string{
-			"weary",
-		},
-	},
-	{
-		emoji:       "😤",
-		description: "face with steam from nose",
-		aliases: []string{
-			"triumph",
-		},
-	},
-	{
-		emoji:       "😮",
-		description: "face with open mouth",
-		aliases: []string{
-			"open_mouth",
-		},
-	},
-	{
-		emoji:       "😱",
-		description: "face screaming in fear",
-		aliases: []string{
-			"scream",
-		},
-	},
-	{
-		emoji:       "😨",
-		description: "fearful face",
-		aliases: []string{
-			"fearful",
-		},
-	},
-	{
-		emoji:       "😰",
-		description: "face with open mouth & cold sweat",
-		aliases: []string{
-			"cold_sweat",
-		},
-	},
-	{
-		emoji:       "😯",
-		description: "hushed face",
-		aliases: []string{
-			"hushed",
-		},
-	},
-	{
-		emoji:       "😦",
-		description: "frowning face with open mouth",
-		aliases: []string{
-			"frowning",
-		},
-	},
-	{
-		emoji:       "😧",
-		description: "anguished face",
-		aliases: []string{
-			"anguished",
-		},
-	},
-	{
-		emoji:       "😢",
-		description: "crying face",
-		aliases: []string{
-			"cry",
-		},
-	},
-	{
-		emoji:       "😥",
-		description: "disappointed but relieved face",
-		aliases: []string{
-			"disappointed_relieved",
-		},
-	},
-	{
-		emoji:       "😪",
-		description: "sleepy face",
-		aliases: []string{
-			"sleepy",
-		},
-	},
-	{
-		emoji:       "😓",
-		description: "face with cold sweat",
-		aliases: []string{
-			"sweat",
-		},
-	},
-	{
-		emoji:       "😭",
-		description: "loudly crying face",
-		aliases: []string{
-			"sob",
-		},
-	},
-	{
-		emoji:       "😵",
-		description: "dizzy face",
-		aliases: []string{
-			"dizzy_face",
-		},
-	},
-	{
-		emoji:       "😲",
-		description: "astonished face",
-		aliases: []string{
-			"astonished",
-		},
-	},
-	{
-		emoji:       "🤐",
-		description: "zipper-mouth face",
-		aliases: []string{
-			"zipper_mouth_face",
-		},
-	},
-	{
-		emoji:       "😷",
-		description: "face with medical mask",
-		aliases: []string{
-			"mask",
-		},
-	},
-	{
-		emoji:       "🤒",
-		description: "face with thermometer",
-		aliases: []string{
-			"face_with_thermometer",
-		},
-	},
-	{
-		emoji:       "🤕",
-		description: "face with head-bandage",
-		aliases: []string{
-			"face_with_head_bandage",
-		},
-	},
-	{
-		emoji:       "😴",
-		description: "sleeping face",
-		aliases: []string{
-			"sleeping",
-		},
-	},
-	{
-		emoji:       "💤",
-		description: "zzz",
-		aliases: []string{
-			"zzz",
-		},
-	},
-	{
-		emoji:       "💩",
-		description: "pile of poo",
-		aliases: []string{
-			"hankey",
-			"poop",
-			"shit",
-		},
-	},
-	{
-		emoji:       "😈",
-		description: "smiling face with horns",
-		aliases: []string{
-			"smiling_imp",
-		},
-	},
-	{
-		emoji:       "👿",
-		description: "angry face with horns",
-		aliases: []string{
-			"imp",
-		},
-	},
-	{
-		emoji:       "👹",
-		description: "ogre",
-		aliases: []string{
-			"japanese_ogre",
-		},
-	},
-	{
-		emoji:       "👺",
-		description: "goblin",
-		aliases: []string{
-			"japanese_goblin",
-		},
-	},
-	{
-		emoji:       "👻",
-		description: "ghost",
-		aliases: []string{
-			"ghost",
-		},
-	},
-	{
-		emoji:       "💀",
-		description: "skull",
-		aliases: []string{
-			"skull",
-		},
-	},
-	{
-		emoji:       "☠️",
-		description: "skull and crossbones",
-		aliases: []string{
-			"skull_and_crossbones",
-		},
-	},
-	{
-		emoji:       "👽",
-		description: "alien",
-		aliases: []string{
-			"alien",
-		},
-	},
-	{
-		emoji:       "👾",
-		description: "alien monster",
-		aliases: []string{
-			"space_invader",
-		},
-	},
-	{
-		emoji:       "🤖",
-		description: "robot face",
-		aliases: []string{
-			"robot",
-		},
-	},
-	{
-		emoji:       "😺",
-		description: "smiling cat face with open mouth",
-		aliases: []string{
-			"smiley_cat",
-		},
-	},
-	{
-		emoji:       "😸",
-		description: "grinning cat face with smiling eyes",
-		aliases: []string{
-			"smile_cat",
-		},
-	},
-	{
-		emoji:       "😹",
-		description: "cat face with tears of joy",
-		aliases: []string{
-			"joy_cat",
-		},
-	},
-	{
-		emoji:       "😻",
-		description: "smiling cat face with heart-eyes",
-		aliases: []string{
-			"heart_eyes_cat",
-		},
-	},
-	{
-		emoji:       "😼",
-		description: "cat face with wry smile",
-		aliases: []string{
-			"smirk_cat",
-		},
-	},
-	{
-		emoji:       "😽",
-		description: "kissing cat face with closed eyes",
-		aliases: []string{
-			"kissing_cat",
-		},
-	},
-	{
-		emoji:       "🙀",
-		description: "weary cat face",
-		aliases: []string{
-			"scream_cat",
-		},
-	},
-	{
-		emoji:       "😿",
-		description: "crying cat face",
-		aliases: []string{
-			"crying_cat_face",
-		},
-	},
-	{
-		emoji:       "😾",
-		description: "pouting cat face",
-		aliases: []string{
-			"pouting_cat",
-		},
-	},
-	{
-		emoji:       "🙌",
-		description: "raising hands",
-		aliases: []string{
-			"raised_hands",
-		},
-	},
-	{
-		emoji:       "👏",
-		description: "clapping hands",
-		aliases: []string{
-			"clap",
-		},
-	},
-	{
-		emoji:       "👍",
-		description: "thumbs up",
-		aliases: []string{
-			"+1",
-			"thumbsup",
-		},
-	},
-	{
-		emoji:       "👎",
-		description: "thumbs down",
-		aliases: []string{
-			"-1",
-			"thumbsdown",
-		},
-	},
-	{
-		emoji:       "👊",
-		description: "oncoming fist",
-		aliases: []string{
-			"facepunch",
-			"punch",
-		},
-	},
-	{
-		emoji:       "✊",
-		description: "raised fist",
-		aliases: []string{
-			"fist",
-		},
-	},
-	{
-		emoji:       "👋",
-		description: "waving hand",
-		aliases: []string{
-			"wave",
-		},
-	},
-	{
-		emoji:       "👈",
-		description: "backhand index pointing left",
-		aliases: []string{
-			"point_left",
-		},
-	},
-	{
-		emoji:       "👉",
-		description: "backhand index pointing right",
-		aliases: []string{
-			"point_right",
-		},
-	},
-	{
-		emoji:       "👆",
-		description: "backhand index pointing up",
-		aliases: []string{
-			"point_up_2",
-		},
-	},
-	{
-		emoji:       "👇",
-		description: "backhand index pointing down",
-		aliases: []string{
-			"point_down",
-		},
-	},
-	{
-		emoji:       "👌",
-		description: "OK hand",
-		aliases: []string{
-			"ok_hand",
-		},
-	},
-	{
-		emoji:       "☝️",
-		description: "index pointing up",
-		aliases: []string{
-			"point_up",
-		},
-	},
-	{
-		emoji:       "✌️",
-		description: "victory hand",
-		aliases: []string{
-			"v",
-		},
-	},
-	{
-		emoji:       "✋",
-		description: "raised hand",
-		aliases: []string{
-			"hand",
-			"raised_hand",
-		},
-	},
-	{
-		emoji:       "🖐",
-		description: "raised hand with fingers splayed",
-		aliases: []string{
-			"raised_hand_with_fingers_splayed",
-		},
-	},
-	{
-		emoji:       "👐",
-		description: "open hands",
-		aliases: []string{
-			"open_hands",
-		},
-	},
-	{
-		emoji:       "💪",
-		description: "flexed biceps",
-		aliases: []string{
-			"muscle",
-		},
-	},
-	{
-		emoji:       "🙏",
-		description: "folded hands",
-		aliases: []string{
-			"pray",
-		},
-	},
-	{
-		emoji:       "🖖",
-		description: "vulcan salute",
-		aliases: []string{
-			"vulcan_salute",
-		},
-	},
-	{
-		emoji:       "🤘",
-		description: "sign of the horns",
-		aliases: []string{
-			"metal",
-		},
-	},
-	{
-		emoji:       "🖕",
-		description: "middle finger",
-		aliases: []string{
-			"middle_finger",
-			"fu",
-		},
-	},
-	{
-		emoji:       "✍️",
-		description: "writing hand",
-		aliases: []string{
-			"writing_hand",
-		},
-	},
-	{
-		emoji:       "💅",
-		description: "nail polish",
-		aliases: []string{
-			"nail_care",
-		},
-	},
-	{
-		emoji:       "👄",
-		description: "mouth",
-		aliases: []string{
-			"lips",
-		},
-	},
-	{
-		emoji:       "👅",
-		description: "tongue",
-		aliases: []string{
-			"tongue",
-		},
-	},
-	{
-		emoji:       "👂",
-		description: "ear",
-		aliases: []string{
-			"ear",
-		},
-	},
-	{
-		emoji:       "👃",
-		description: "nose",
-		aliases: []string{
-			"nose",
-		},
-	},
-	{
-		emoji:       "👁",
-		description: "eye",
-		aliases: []string{
-			"eye",
-		},
-	},
-	{
-		emoji:       "👀",
-		description: "eyes",
-		aliases: []string{
-			"eyes",
-		},
-	},
-	{
-		emoji:       "🗣",
-		description: "speaking head",
-		aliases: []string{
-			"speaking_head",
-		},
-	},
-	{
-		emoji:       "👤",
-		description: "bust in silhouette",
-		aliases: []string{
-			"bust_in_silhouette",
-		},
-	},
-	{
-		emoji:       "👥",
-		description: "busts in silhouette",
-		aliases: []string{
-			"busts_in_silhouette",
-		},
-	},
-	{
-		emoji:       "👶",
-		description: "baby",
-		aliases: []string{
-			"baby",
-		},
-	},
-	{
-		emoji:       "👦",
-		description: "boy",
-		aliases: []string{
-			"boy",
-		},
-	},
-	{
-		emoji:       "👧",
-		description: "girl",
-		aliases: []string{
-			"girl",
-		},
-	},
-	{
-		emoji:       "👨",
-		description: "man",
-		aliases: []string{
-			"man",
-		},
-	},
-	{
-		emoji:       "👩",
-		description: "woman",
-		aliases: []string{
-			"woman",
-		},
-	},
-	{
-		emoji:       "👱\u200d♀️",
-		description: "blond-haired woman",
-		aliases: []string{
-			"blonde_woman",
-		},
-	},
-	{
-		emoji:       "👱",
-		description: "blond-haired person",
-		aliases: []string{
-			"blonde_man",
-			"person_with_blond_hair",
-		},
-	},
-	{
-		emoji:       "👴",
-		description: "old man",
-		aliases: []string{
-			"older_man",
-		},
-	},
-	{
-		emoji:       "👵",
-		description: "old woman",
-		aliases: []string{
-			"older_woman",
-		},
-	},
-	{
-		emoji:       "👲",
-		description: "man with Chinese cap",
-		aliases: []string{
-			"man_with_gua_pi_mao",
-		},
-	},
-	{
-		emoji:       "👳\u200d♀️",
-		description: "woman wearing turban",
-		aliases: []string{
-			"woman_with_turban",
-		},
-	},
-	{
-		emoji:       "👳",
-		description: "person wearing turban",
-		aliases: []string{
-			"man_with_turban",
-		},
-	},
-	{
-		emoji:       "👮\u200d♀️",
-		description: "woman police officer",
-		aliases: []string{
-			"policewoman",
-		},
-	},
-	{
-		emoji:       "👮",
-		description: "police officer",
-		aliases: []string{
-			"policeman",
-			"cop",
-		},
-	},
-	{
-		emoji:       "👷\u200d♀️",
-		description: "woman construction worker",
-		aliases: []string{
-			"construction_worker_woman",
-		},
-	},
-	{
-		emoji:       "👷",
-		description: "construction worker",
-		aliases: []string{
-			"construction_worker_man",
-			"construction_worker",
-		},
-	},
-	{
-		emoji:       "💂\u200d♀️",
-		description: "woman guard",
-		aliases: []string{
-			"guardswoman",
-		},
-	},
-	{
-		emoji:       "💂",
-		description: "guard",
-		aliases: []string{
-			"guardsman",
-		},
-	},
-	{
-		emoji:       "🕵️\u200d♀️",
-		description: "woman detective",
-		aliases: []string{
-			"female_detective",
-		},
-	},
-	{
-		emoji:       "🕵️",
-		description: "detective",
-		aliases: []string{
-			"male_detective",
-			"detective",
-		},
-	},
-	{
-		emoji:       "🎅",
-		description: "Santa Claus",
-		aliases: []string{
-			"santa",
-		},
-	},
-	{
-		emoji:       "👸",
-		description: "princess",
-		aliases: []string{
-			"princess",
-		},
-	},
-	{
-		emoji:       "👰",
-		description: "bride with veil",
-		aliases: []string{
-			"bride_with_veil",
-		},
-	},
-	{
-		emoji:       "👼",
-		description: "baby angel",
-		aliases: []string{
-			"angel",
-		},
-	},
-	{
-		emoji:       "🙇\u200d♀️",
-		description: "woman bowing",
-		aliases: []string{
-			"bowing_woman",
-		},
-	},
-	{
-		emoji:       "🙇",
-		description: "person bowing",
-		aliases: []string{
-			"bowing_man",
-			"bow",
-		},
-	},
-	{
-		emoji:       "💁",
-		description: "person tipping hand",
-		aliases: []string{
-			"tipping_hand_woman",
-			"information_desk_person",
-		},
-	},
-	{
-		emoji:       "💁\u200d♂️",
-		description: "man tipping hand",
-		aliases: []string{
-			"tipping_hand_man",
-		},
-	},
-	{
-		emoji:       "🙅",
-		description: "person gesturing NO",
-		aliases: []string{
-			"no_good_woman",
-			"no_good",
-			"ng_woman",
-		},
-	},
-	{
-		emoji:       "🙅\u200d♂️",
-		description: "man gesturing NO",
-		aliases: []string{
-			"no_good_man",
-			"ng_man",
-		},
-	},
-	{
-		emoji:       "🙆",
-		description: "person gesturing OK",
-		aliases: []string{
-			"ok_woman",
-		},
-	},
-	{
-		emoji:       "🙆\u200d♂️",
-		description: "man gesturing OK",
-		aliases: []string{
-			"ok_man",
-		},
-	},
-	{
-		emoji:       "🙋",
-		description: "person raising hand",
-		aliases: []string{
-			"raising_hand_woman",
-			"raising_hand",
-		},
-	},
-	{
-		emoji:       "🙋\u200d♂️",
-		description: "man raising hand",
-		aliases: []string{
-			"raising_hand_man",
-		},
-	},
-	{
-		emoji:       "🙎",
-		description: "person pouting",
-		aliases: []string{
-			"pouting_woman",
-			"person_with_pouting_face",
-		},
-	},
-	{
-		emoji:       "🙎\u200d♂️",
-		description: "man pouting",
-		aliases: []string{
-			"pouting_man",
-		},
-	},
-	{
-		emoji:       "🙍",
-		description: "person frowning",
-		aliases: []string{
-			"frowning_woman",
-			"person_frowning",
-		},
-	},
-	{
-		emoji:       "🙍\u200d♂️",
-		description: "man frowning",
-		aliases: []string{
-			"frowning_man",
-		},
-	},
-	{
-		emoji:       "💇",
-		description: "person getting haircut",
-		aliases: []string{
-			"haircut_woman",
-			"haircut",
-		},
-	},
-	{
-		emoji:       "💇\u200d♂️",
-		description: "man getting haircut",
-		aliases: []string{
-			"haircut_man",
-		},
-	},
-	{
-		emoji:       "💆",
-		description: "person getting massage",
-		aliases: []string{
-			"massage_woman",
-			"massage",
-		},
-	},
-	{
-		emoji:       "💆\u200d♂️",
-		description: "man getting massage",
-		aliases: []string{
-			"massage_man",
-		},
-	},
-	{
-		emoji:       "💃",
-		description: "woman dancing",
-		aliases: []string{
-			"dancer",
-		},
-	},
-	{
-		emoji:       "👯",
-		description: "people with bunny ears partying",
-		aliases: []string{
-			"dancing_women",
-			"dancers",
-		},
-	},
-	{
-		emoji:       "👯\u200d♂️",
-		description: "men with bunny ears partying",
-		aliases: []string{
-			"dancing_men",
-		},
-	},
-	{
-		emoji:       "🚶\u200d♀️",
-		description: "woman walking",
-		aliases: []string{
-			"walking_woman",
-		},
-	},
-	{
-		emoji:       "🚶",
-		description: "person walking",
-		aliases: []string{
-			"walking_man",
-			"walking",
-		},
-	},
-	{
-		emoji:       "🏃\u200d♀️",
-		description: "woman running",
-		aliases: []string{
-			"running_woman",
-		},
-	},
-	{
-		emoji:       "🏃",
-		description: "person running",
-		aliases: []string{
-			"running_man",
-			"runner",
-			"running",
-		},
-	},
-	{
-		emoji:       "👫",
-		description: "man and woman holding hands",
-		aliases: []string{
-			"couple",
-		},
-	},
-	{
-		emoji:       "👭",
-		description: "two women holding hands",
-		aliases: []string{
-			"two_women_holding_hands",
-		},
-	},
-	{
-		emoji:       "👬",
-		description: "two men holding hands",
-		aliases: []string{
-			"two_men_holding_hands",
-		},
-	},
-	{
-		emoji:       "💑",
-		description: "couple with heart",
-		aliases: []string{
-			"couple_with_heart_woman_man",
-			"couple_with_heart",
-		},
-	},
-	{
-		emoji:       "👩\u200d❤️\u200d👩",
-		description: "couple with heart: woman, woman",
-		aliases: []string{
-			"couple_with_heart_woman_woman",
-		},
-	},
-	{
-		emoji:       "👨\u200d❤️\u200d👨",
-		description: "couple with heart: man, man",
-		aliases: []string{
-			"couple_with_heart_man_man",
-		},
-	},
-	{
-		emoji:       "💏",
-		description: "kiss",
-		aliases: []string{
-			"couplekiss_man_woman",
-		},
-	},
-	{
-		emoji:       "👩\u200d❤️\u200d💋\u200d👩",
-		description: "kiss: woman, woman",
-		aliases: []string{
-			"couplekiss_woman_woman",
-		},
-	},
-	{
-		emoji:       "👨\u200d❤️\u200d💋\u200d👨",
-		description: "kiss: man, man",
-		aliases: []string{
-			"couplekiss_man_man",
-		},
-	},
-	{
-		emoji:       "👪",
-		description: "family",
-		aliases: []string{
-			"family_man_woman_boy",
-			"family",
-		},
-	},
-	{
-		emoji:       "👨\u200d👩\u200d👧",
-		description: "family: man, woman, girl",
-		aliases: []string{
-			"family_man_woman_girl",
-		},
-	},
-	{
-		emoji:       "👨\u200d👩\u200d👧\u200d👦",
-		description: "family: man, woman, girl, boy",
-		aliases: []string{
-			"family_man_woman_girl_boy",
-		},
-	},
-	{
-		emoji:       "👨\u200d👩\u200d👦\u200d👦",
-		description: "family: man, woman, boy, boy",
-		aliases: []string{
-			"family_man_woman_boy_boy",
-		},
-	},
-	{
-		emoji:       "👨\u200d👩\u200d👧\u200d👧",
-		description: "family: man, woman, girl, girl",
-		aliases: []string{
-			"family_man_woman_girl_girl",
-		},
-	},
-	{
-		emoji:       "👩\u200d👩\u200d👦",
-		description: "family: woman, woman, boy",
-		aliases: []string{
-			"family_woman_woman_boy",
-		},
-	},
-	{
-		emoji:       "👩\u200d👩\u200d👧",
-		description: "family: woman, woman, girl",
-		aliases: []string{
-			"family_woman_woman_girl",
-		},
-	},
-	{
-		emoji:       "👩\u200d👩\u200d👧\u200d👦",
-		description: "family: woman, woman, girl, boy",
-		aliases: []string{
-			"family_woman_woman_girl_boy",
-		},
-	},
-	{
-		emoji:       "👩\u200d👩\u200d👦\u200d👦",
-		description: "family: woman, woman, boy, boy",
-		aliases: []string{
-			"family_woman_woman_boy_boy",
-		},
-	},
-	{
-		emoji:       "👩\u200d👩\u200d👧\u200d👧",
-		description: "family: woman, woman, girl, girl",
-		aliases: []string{
-			"family_woman_woman_girl_girl",
-		},
-	},
-	{
-		emoji:       "👨\u200d👨\u200d👦",
-		description: "family: man, man, boy",
-		aliases: []string{
-			"family_man_man_boy",
-		},
-	},
-	{
-		emoji:       "👨\u200d👨\u200d👧",
-		description: "family: man, man, girl",
-		aliases: []string{
-			"family_man_man_girl",
-		},
-	},
-	{
-		emoji:       "👨\u200d👨\u200d👧\u200d👦",
-		description: "family: man, man, girl, boy",
-		aliases: []string{
-			"family_man_man_girl_boy",
-		},
-	},
-	{
-		emoji:       "👨\u200d👨\u200d👦\u200d👦",
-		description: "family: man, man, boy, boy",
-		aliases: []string{
-			"family_man_man_boy_boy",
-		},
-	},
-	{
-		emoji:       "👨\u200d👨\u200d👧\u200d👧",
-		description: "family: man, man, girl, girl",
-		aliases: []string{
-			"family_man_man_girl_girl",
-		},
-	},
-	{
-		emoji:       "👩\u200d👦",
-		description: "family: woman, boy",
-		aliases: []string{
-			"family_woman_boy",
-		},
-	},
-	{
-		emoji:       "👩\u200d👧",
-		description: "family: woman, girl",
-		aliases: []string{
-			"family_woman_girl",
-		},
-	},
-	{
-		emoji:       "👩\u200d👧\u200d👦",
-		description: "family: woman, girl, boy",
-		aliases: []string{
-			"family_woman_girl_boy",
-		},
-	},
-	{
-		emoji:       "👩\u200d👦\u200d👦",
-		description: "family: woman, boy, boy",
-		aliases: []string{
-			"family_woman_boy_boy",
-		},
-	},
-	{
-		emoji:       "👩\u200d👧\u200d👧",
-		description: "family: woman, girl, girl",
-		aliases: []string{
-			"family_woman_girl_girl",
-		},
-	},
-	{
-		emoji:       "👨\u200d👦",
-		description: "family: man, boy",
-		aliases: []string{
-			"family_man_boy",
-		},
-	},
-	{
-		emoji:       "👨\u200d👧",
-		description: "family: man, girl",
-		aliases: []string{
-			"family_man_girl",
-		},
-	},
-	{
-		emoji:       "👨\u200d👧\u200d👦",
-		description: "family: man, girl, boy",
-		aliases: []string{
-			"family_man_girl_boy",
-		},
-	},
-	{
-		emoji:       "👨\u200d👦\u200d👦",
-		description: "family: man, boy, boy",
-		aliases: []string{
-			"family_man_boy_boy",
-		},
-	},
-	{
-		emoji:       "👨\u200d👧\u200d👧",
-		description: "family: man, girl, girl",
-		aliases: []string{
-			"family_man_girl_girl",
-		},
-	},
-	{
-		emoji:       "👚",
-		description: "woman’s clothes",
-		aliases: []string{
-			"womans_clothes",
-		},
-	},
-	{
-		emoji:       "👕",
-		description: "t-shirt",
-		aliases: []string{
-			"shirt",
-			"tshirt",
-		},
-	},
-	{
-		emoji:       "👖",
-		description: "jeans",
-		aliases: []string{
-			"jeans",
-		},
-	},
-	{
-		emoji:       "👔",
-		description: "necktie",
-		aliases: []string{
-			"necktie",
-		},
-	},
-	{
-		emoji:       "👗",
-		description: "dress",
-		aliases: []string{
-			"dress",
-		},
-	},
-	{
-		emoji:       "👙",
-		description: "bikini",
-		aliases: []string{
-			"bikini",
-		},
-	},
-	{
-		emoji:       "👘",
-		description: "kimono",
-		aliases: []string{
-			"kimono",
-		},
-	},
-	{
-		emoji:       "💄",
-		description: "lipstick",
-		aliases: []string{
-			"lipstick",
-		},
-	},
-	{
-		emoji:       "💋",
-		description: "kiss mark",
-		aliases: []string{
-			"kiss",
-		},
-	},
-	{
-		emoji:       "👣",
-		description: "footprints",
-		aliases: []string{
-			"footprints",
-		},
-	},
-	{
-		emoji:       "👠",
-		description: "high-heeled shoe",
-		aliases: []string{
-			"high_heel",
-		},
-	},
-	{
-		emoji:       "👡",
-		description: "woman’s sandal",
-		aliases: []string{
-			"sandal",
-		},
-	},
-	{
-		emoji:       "👢",
-		description: "woman’s boot",
-		aliases: []string{
-			"boot",
-		},
-	},
-	{
-		emoji:       "👞",
-		description: "man’s shoe",
-		aliases: []string{
-			"mans_shoe",
-			"shoe",
-		},
-	},
-	{
-		emoji:       "👟",
-		description: "running shoe",
-		aliases: []string{
-			"athletic_shoe",
-		},
-	},
-	{
-		emoji:       "👒",
-		description: "woman’s hat",
-		aliases: []string{
-			"womans_hat",
-		},
-	},
-	{
-		emoji:       "🎩",
-		description: "top hat",
-		aliases: []string{
-			"tophat",
-		},
-	},
-	{
-		emoji:       "🎓",
-		description: "graduation cap",
-		aliases: []string{
-			"mortar_board",
-		},
-	},
-	{
-		emoji:       "👑",
-		description: "crown",
-		aliases: []string{
-			"crown",
-		},
-	},
-	{
-		emoji:       "⛑",
-		description: "rescue worker’s helmet",
-		aliases: []string{
-			"rescue_worker_helmet",
-		},
-	},
-	{
-		emoji:       "🎒",
-		description: "school backpack",
-		aliases: []string{
-			"school_satchel",
-		},
-	},
-	{
-		emoji:       "👝",
-		description: "clutch bag",
-		aliases: []string{
-			"pouch",
-		},
-	},
-	{
-		emoji:       "👛",
-		description: "purse",
-		aliases: []string{
-			"purse",
-		},
-	},
-	{
-		emoji:       "👜",
-		description: "handbag",
-		aliases: []string{
-			"handbag",
-		},
-	},
-	{
-		emoji:       "💼",
-		description: "briefcase",
-		aliases: []string{
-			"briefcase",
-		},
-	},
-	{
-		emoji:       "👓",
-		description: "glasses",
-		aliases: []string{
-			"eyeglasses",
-		},
-	},
-	{
-		emoji:       "🕶",
-		description: "sunglasses",
-		aliases: []string{
-			"dark_sunglasses",
-		},
-	},
-	{
-		emoji:       "💍",
-		description: "ring",
-		aliases: []string{
-			"ring",
-		},
-	},
-	{
-		emoji:       "🌂",
-		description: "closed umbrella",
-		aliases: []string{
-			"closed_umbrella",
-		},
-	},
-	{
-		emoji:       "🐶",
-		description: "dog face",
-		aliases: []string{
-			"dog",
-		},
-	},
-	{
-		emoji:       "🐱",
-		description: "cat face",
-		aliases: []string{
-			"cat",
-		},
-	},
-	{
-		emoji:       "🐭",
-		description: "mouse face",
-		aliases: []string{
-			"mouse",
-		},
-	},
-	{
-		emoji:       "🐹",
-		description: "hamster face",
-		aliases: []string{
-			"hamster",
-		},
-	},
-	{
-		emoji:       "🐰",
-		description: "rabbit face",
-		aliases: []string{
-			"rabbit",
-		},
-	},
-	{
-		emoji:       "🐻",
-		description: "bear face",
-		aliases: []string{
-			"bear",
-		},
-	},
-	{
-		emoji:       "🐼",
-		description: "panda face",
-		aliases: []string{
-			"panda_face",
-		},
-	},
-	{
-		emoji:       "🐨",
-		description: "koala",
-		aliases: []string{
-			"koala",
-		},
-	},
-	{
-		emoji:       "🐯",
-		description: "tiger face",
-		aliases: []string{
-			"tiger",
-		},
-	},
-	{
-		emoji:       "🦁",
-		description: "lion face",
-		aliases: []string{
-			"lion",
-		},
-	},
-	{
-		emoji:       "🐮",
-		description: "cow face",
-		aliases: []string{
-			"cow",
-		},
-	},
-	{
-		emoji:       "🐷",
-		description: "pig face",
-		aliases: []string{
-			"pig",
-		},
-	},
-	{
-		emoji:       "🐽",
-		description: "pig nose",
-		aliases: []string{
-			"pig_nose",
-		},
-	},
-	{
-		emoji:       "🐸",
-		description: "frog face",
-		aliases: []string{
-			"frog",
-		},
-	},
-	{
-		emoji:       "🐙",
-		description: "octopus",
-		aliases: []string{
-			"octopus",
-		},
-	},
-	{
-		emoji:       "🐵",
-		description: "monkey face",
-		aliases: []string{
-			"monkey_face",
-		},
-	},
-	{
-		emoji:       "🙈",
-		description: "see-no-evil monkey",
-		aliases: []string{
-			"see_no_evil",
-		},
-	},
-	{
-		emoji:       "🙉",
-		description: "hear-no-evil monkey",
-		aliases: []string{
-			"hear_no_evil",
-		},
-	},
-	{
-		emoji:       "🙊",
-		description: "speak-no-evil monkey",
-		aliases: []string{
-			"speak_no_evil",
-		},
-	},
-	{
-		emoji:       "🐒",
-		description: "monkey",
-		aliases: []string{
-			"monkey",
-		},
-	},
-	{
-		emoji:       "🐔",
-		description: "chicken",
-		aliases: []string{
-			"chicken",
-		},
-	},
-	{
-		emoji:       "🐧",
-		description: "penguin",
-		aliases: []string{
-			"penguin",
-		},
-	},
-	{
-		emoji:       "🐦",
-		description: "bird",
-		aliases: []string{
-			"bird",
-		},
-	},
-	{
-		emoji:       "🐤",
-		description: "baby chick",
-		aliases: []string{
-			"baby_chick",
-		},
-	},
-	{
-		emoji:       "🐣",
-		description: "hatching chick",
-		aliases: []string{
-			"hatching_chick",
-		},
-	},
-	{
-		emoji:       "🐥",
-		description: "front-facing baby chick",
-		aliases: []string{
-			"hatched_chick",
-		},
-	},
-	{
-		emoji:       "🐺",
-		description: "wolf face",
-		aliases: []string{
-			"wolf",
-		},
-	},
-	{
-		emoji:       "🐗",
-		description: "boar",
-		aliases: []string{
-			"boar",
-		},
-	},
-	{
-		emoji:       "🐴",
-		description: "horse face",
-		aliases: []string{
-			"horse",
-		},
-	},
-	{
-		emoji:       "🦄",
-		description: "unicorn face",
-		aliases: []string{
-			"unicorn",
-		},
-	},
-	{
-		emoji:       "🐝",
-		description: "honeybee",
-		aliases: []string{
-			"bee",
-			"honeybee",
-		},
-	},
-	{
-		emoji:       "🐛",
-		description: "bug",
-		aliases: []string{
-			"bug",
-		},
-	},
-	{
-		emoji:       "🐌",
-		description: "snail",
-		aliases: []string{
-			"snail",
-		},
-	},
-	{
-		emoji:       "🐞",
-		description: "lady beetle",
-		aliases: []string{
-			"beetle",
-		},
-	},
-	{
-		emoji:       "🐜",
-		description: "ant",
-		aliases: []string{
-			"ant",
-		},
-	},
-	{
-		emoji:       "🕷",
-		description: "spider",
-		aliases: []string{
-			"spider",
-		},
-	},
-	{
-		emoji:       "🦂",
-		description: "scorpion",
-		aliases: []string{
-			"scorpion",
-		},
-	},
-	{
-		emoji:       "🦀",
-		description: "crab",
-		aliases: []string{
-			"crab",
-		},
-	},
-	{
-		emoji:       "🐍",
-		description: "snake",
-		aliases: []string{
-			"snake",
-		},
-	},
-	{
-		emoji:       "🐢",
-		description: "turtle",
-		aliases: []string{
-			"turtle",
-		},
-	},
-	{
-		emoji:       "🐠",
-		description: "tropical fish",
-		aliases: []string{
-			"tropical_fish",
-		},
-	},
-	{
-		emoji:       "🐟",
-		description: "fish",
-		aliases: []string{
-			"fish",
-		},
-	},
-	{
-		emoji:       "🐡",
-		description: "blowfish",
-		aliases: []string{
-			"blowfish",
-		},
-	},
-	{
-		emoji:       "🐬",
-		description: "dolphin",
-		aliases: []string{
-			"dolphin",
-			"flipper",
-		},
-	},
-	{
-		emoji:       "🐳",
-		description: "spouting whale",
-		aliases: []string{
-			"whale",
-		},
-	},
-	{
-		emoji:       "🐋",
-		description: "whale",
-		aliases: []string{
-			"whale2",
-		},
-	},
-	{
-		emoji:       "🐊",
-		description: "crocodile",
-		aliases: []string{
-			"crocodile",
-		},
-	},
-	{
-		emoji:       "🐆",
-		description: "leopard",
-		aliases: []string{
-			"leopard",
-		},
-	},
-	{
-		emoji:       "🐅",
-		description: "tiger",
-		aliases: []string{
-			"tiger2",
-		},
-	},
-	{
-		emoji:       "🐃",
-		description: "water buffalo",
-		aliases: []string{
-			"water_buffalo",
-		},
-	},
-	{
-		emoji:       "🐂",
-		description: "ox",
-		aliases: []string{
-			"ox",
-		},
-	},
-	{
-		emoji:       "🐄",
-		description: "cow",
-		aliases: []string{
-			"cow2",
-		},
-	},
-	{
-		emoji:       "🐪",
-		description: "camel",
-		aliases: []string{
-			"dromedary_camel",
-		},
-	},
-	{
-		emoji:       "🐫",
-		description: "two-hump camel",
-		aliases: []string{
-			"camel",
-		},
-	},
-	{
-		emoji:       "🐘",
-		description: "elephant",
-		aliases: []string{
-			"elephant",
-		},
-	},
-	{
-		emoji:       "🐐",
-		description: "goat",
-		aliases: []string{
-			"goat",
-		},
-	},
-	{
-		emoji:       "🐏",
-		description: "ram",
-		aliases: []string{
-			"ram",
-		},
-	},
-	{
-		emoji:       "🐑",
-		description: "sheep",
-		aliases: []string{
-			"sheep",
-		},
-	},
-	{
-		emoji:       "🐎",
-		description: "horse",
-		aliases: []string{
-			"racehorse",
-		},
-	},
-	{
-		emoji:       "🐖",
-		description: "pig",
-		aliases: []string{
-			"pig2",
-		},
-	},
-	{
-		emoji:       "🐀",
-		description: "rat",
-		aliases: []string{
-			"rat",
-		},
-	},
-	{
-		emoji:       "🐁",
-		description: "mouse",
-		aliases: []string{
-			"mouse2",
-		},
-	},
-	{
-		emoji:       "🐓",
-		description: "rooster",
-		aliases: []string{
-			"rooster",
-		},
-	},
-	{
-		emoji:       "🦃",
-		description: "turkey",
-		aliases: []string{
-			"turkey",
-		},
-	},
-	{
-		emoji:       "🕊",
-		description: "dove",
-		aliases: []string{
-			"dove",
-		},
-	},
-	{
-		emoji:       "🐕",
-		description: "dog",
-		aliases: []string{
-			"dog2",
-		},
-	},
-	{
-		emoji:       "🐩",
-		description: "poodle",
-		aliases: []string{
-			"poodle",
-		},
-	},
-	{
-		emoji:       "🐈",
-		description: "cat",
-		aliases: []string{
-			"cat2",
-		},
-	},
-	{
-		emoji:       "🐇",
-		description: "rabbit",
-		aliases: []string{
-			"rabbit2",
-		},
-	},
-	{
-		emoji:       "🐿",
-		description: "chipmunk",
-		aliases: []string{
-			"chipmunk",
-		},
-	},
-	{
-		emoji:       "🐾",
-		description: "paw prints",
-		aliases: []string{
-			"feet",
-			"paw_prints",
-		},
-	},
-	{
-		emoji:       "🐉",
-		description: "dragon",
-		aliases: []string{
-			"dragon",
-		},
-	},
-	{
-		emoji:       "🐲",
-		description: "dragon face",
-		aliases: []string{
-			"dragon_face",
-		},
-	},
-	{
-		emoji:       "🌵",
-		description: "cactus",
-		aliases: []string{
-			"cactus",
-		},
-	},
-	{
-		emoji:       "🎄",
-		description: "Christmas tree",
-		aliases: []string{
-			"christmas_tree",
-		},
-	},
-	{
-		emoji:       "🌲",
-		description: "evergreen tree",
-		aliases: []string{
-			"evergreen_tree",
-		},
-	},
-	{
-		emoji:       "🌳",
-		description: "deciduous tree",
-		aliases: []string{
-			"deciduous_tree",
-		},
-	},
-	{
-		emoji:       "🌴",
-		description: "palm tree",
-		aliases: []string{
-			"palm_tree",
-		},
-	},
-	{
-		emoji:       "🌱",
-		description: "seedling",
-		aliases: []string{
-			"seedling",
-		},
-	},
-	{
-		emoji:       "🌿",
-		description: "herb",
-		aliases: []string{
-			"herb",
-		},
-	},
-	{
-		emoji:       "☘",
-		description: "shamrock",
-		aliases: []string{
-			"shamrock",
-		},
-	},
-	{
-		emoji:       "🍀",
-		description: "four leaf clover",
-		aliases: []string{
-			"four_leaf_clover",
-		},
-	},
-	{
-		emoji:       "🎍",
-		description: "pine decoration",
-		aliases: []string{
-			"bamboo",
-		},
-	},
-	{
-		emoji:       "🎋",
-		description: "tanabata tree",
-		aliases: []string{
-			"tanabata_tree",
-		},
-	},
-	{
-		emoji:       "🍃",
-		description: "leaf fluttering in wind",
-		aliases: []string{
-			"leaves",
-		},
-	},
-	{
-		emoji:       "🍂",
-		description: "fallen leaf",
-		aliases: []string{
-			"fallen_leaf",
-		},
-	},
-	{
-		emoji:       "🍁",
-		description: "maple leaf",
-		aliases: []string{
-			"maple_leaf",
-		},
-	},
-	{
-		emoji:       "🌾",
-		description: "sheaf of rice",
-		aliases: []string{
-			"ear_of_rice",
-		},
-	},
-	{
-		emoji:       "🌺",
-		description: "hibiscus",
-		aliases: []string{
-			"hibiscus",
-		},
-	},
-	{
-		emoji:       "🌻",
-		description: "sunflower",
-		aliases: []string{
-			"sunflower",
-		},
-	},
-	{
-		emoji:       "🌹",
-		description: "rose",
-		aliases: []string{
-			"rose",
-		},
-	},
-	{
-		emoji:       "🌷",
-		description: "tulip",
-		aliases: []string{
-			"tulip",
-		},
-	},
-	{
-		emoji:       "🌼",
-		description: "blossom",
-		aliases: []string{
-			"blossom",
-		},
-	},
-	{
-		emoji:       "🌸",
-		description: "cherry blossom",
-		aliases: []string{
-			"cherry_blossom",
-		},
-	},
-	{
-		emoji:       "💐",
-		description: "bouquet",
-		aliases: []string{
-			"bouquet",
-		},
-	},
-	{
-		emoji:       "🍄",
-		description: "mushroom",
-		aliases: []string{
-			"mushroom",
-		},
-	},
-	{
-		emoji:       "🌰",
-		description: "chestnut",
-		aliases: []string{
-			"chestnut",
-		},
-	},
-	{
-		emoji:       "🎃",
-		description: "jack-o-lantern",
-		aliases: []string{
-			"jack_o_lantern",
-		},
-	},
-	{
-		emoji:       "🐚",
-		description: "spiral shell",
-		aliases: []string{
-			"shell",
-		},
-	},
-	{
-		emoji:       "🕸",
-		description: "spider web",
-		aliases: []string{
-			"spider_web",
-		},
-	},
-	{
-		emoji:       "🌎",
-		description: "globe showing Americas",
-		aliases: []string{
-			"earth_americas",
-		},
-	},
-	{
-		emoji:       "🌍",
-		description: "globe showing Europe-Africa",
-		aliases: []string{
-			"earth_africa",
-		},
-	},
-	{
-		emoji:       "🌏",
-		description: "globe showing Asia-Australia",
-		aliases: []string{
-			"earth_asia",
-		},
-	},
-	{
-		emoji:       "🌕",
-		description: "full moon",
-		aliases: []string{
-			"full_moon",
-		},
-	},
-	{
-		emoji:       "🌖",
-		description: "waning gibbous moon",
-		aliases: []string{
-			"waning_gibbous_moon",
-		},
-	},
-	{
-		emoji:       "🌗",
-		description: "last quarter moon",
-		aliases: []string{
-			"last_quarter_moon",
-		},
-	},
-	{
-		emoji:       "🌘",
-		description: "waning crescent moon",
-		aliases: []string{
-			"waning_crescent_moon",
-		},
-	},
-	{
-		emoji:       "🌑",
-		description: "new moon",
-		aliases: []string{
-			"new_moon",
-		},
-	},
-	{
-		emoji:       "🌒",
-		description: "waxing crescent moon",
-		aliases: []string{
-			"waxing_crescent_moon",
-		},
-	},
-	{
-		emoji:       "🌓",
-		description: "first quarter moon",
-		aliases: []string{
-			"first_quarter_moon",
-		},
-	},
-	{
-		emoji:       "🌔",
-		description: "waxing gibbous moon",
-		aliases: []string{
-			"moon",
-			"waxing_gibbous_moon",
-		},
-	},
-	{
-		emoji:       "🌚",
-		description: "new moon face",
-		aliases: []string{
-			"new_moon_with_face",
-		},
-	},
-	{
-		emoji:       "🌝",
-		description: "full moon with face",
-		aliases: []string{
-			"full_moon_with_face",
-		},
-	},
-	{
-		emoji:       "🌛",
-		description: "first quarter moon with face",
-		aliases: []string{
-			"first_quarter_moon_with_face",
-		},
-	},
-	{
-		emoji:       "🌜",
-		description: "last quarter moon with face",
-		aliases: []string{
-			"last_quarter_moon_with_face",
-		},
-	},
-	{
-		emoji:       "🌞",
-		description: "sun with face",
-		aliases: []string{
-			"sun_with_face",
-		},
-	},
-	{
-		emoji:       "🌙",
-		description: "crescent moon",
-		aliases: []string{
-			"crescent_moon",
-		},
-	},
-	{
-		emoji:       "⭐️",
-		description: "white medium star",
-		aliases: []string{
-			"star",
-		},
-	},
-	{
-		emoji:       "🌟",
-		description: "glowing star",
-		aliases: []string{
-			"star2",
-		},
-	},
-	{
-		emoji:       "💫",
-		description: "dizzy",
-		aliases: []string{
-			"dizzy",
-		},
-	},
-	{
-		emoji:       "✨",
-		description: "sparkles",
-		aliases: []string{
-			"sparkles",
-		},
-	},
-	{
-		emoji:       "☄️",
-		description: "comet",
-		aliases: []string{
-			"comet",
-		},
-	},
-	{
-		emoji:       "☀️",
-		description: "sun",
-		aliases: []string{
-			"sunny",
-		},
-	},
-	{
-		emoji:       "🌤",
-		description: "sun behind small cloud",
-		aliases: []string{
-			"sun_behind_small_cloud",
-		},
-	},
-	{
-		emoji:       "⛅️",
-		description: "sun behind cloud",
-		aliases: []string{
-			"partly_sunny",
-		},
-	},
-	{
-		emoji:       "🌥",
-		description: "sun behind large cloud",
-		aliases: []string{
-			"sun_behind_large_cloud",
-		},
-	},
-	{
-		emoji:       "🌦",
-		description: "sun behind rain cloud",
-		aliases: []string{
-			"sun_behind_rain_cloud",
-		},
-	},
-	{
-		emoji:       "☁️",
-		description: "cloud",
-		aliases: []string{
-			"cloud",
-		},
-	},
-	{
-		emoji:       "🌧",
-		description: "cloud with rain",
-		aliases: []string{
-			"cloud_with_rain",
-		},
-	},
-	{
-		emoji:       "⛈",
-		description: "cloud with lightning and rain",
-		aliases: []string{
-			"cloud_with_lightning_and_rain",
-		},
-	},
-	{
-		emoji:       "🌩",
-		description: "cloud with lightning",
-		aliases: []string{
-			"cloud_with_lightning",
-		},
-	},
-	{
-		emoji:       "⚡️",
-		description: "high voltage",
-		aliases: []string{
-			"zap",
-		},
-	},
-	{
-		emoji:       "🔥",
-		description: "fire",
-		aliases: []string{
-			"fire",
-		},
-	},
-	{
-		emoji:       "💥",
-		description: "collision",
-		aliases: []string{
-			"boom",
-			"collision",
-		},
-	},
-	{
-		emoji:       "❄️",
-		description: "snowflake",
-		aliases: []string{
-			"snowflake",
-		},
-	},
-	{
-		emoji:       "🌨",
-		description: "cloud with snow",
-		aliases: []string{
-			"cloud_with_snow",
-		},
-	},
-	{
-		emoji:       "☃️",
-		description: "snowman",
-		aliases: []string{
-			"snowman_with_snow",
-		},
-	},
-	{
-		emoji:       "⛄️",
-		description: "snowman without snow",
-		aliases: []string{
-			"snowman",
-		},
-	},
-	{
-		emoji:       "🌬",
-		description: "wind face",
-		aliases: []string{
-			"wind_face",
-		},
-	},
-	{
-		emoji:       "💨",
-		description: "dashing away",
-		aliases: []string{
-			"dash",
-		},
-	},
-	{
-		emoji:       "🌪",
-		description: "tornado",
-		aliases: []string{
-			"tornado",
-		},
-	},
-	{
-		emoji:       "🌫",
-		description: "fog",
-		aliases: []string{
-			"fog",
-		},
-	},
-	{
-		emoji:       "☂️",
-		description: "umbrella",
-		aliases: []string{
-			"open_umbrella",
-		},
-	},
-	{
-		emoji:       "☔️",
-		description: "umbrella with rain drops",
-		aliases: []string{
-			"umbrella",
-		},
-	},
-	{
-		emoji:       "💧",
-		description: "droplet",
-		aliases: []string{
-			"droplet",
-		},
-	},
-	{
-		emoji:       "💦",
-		description: "sweat droplets",
-		aliases: []string{
-			"sweat_drops",
-		},
-	},
-	{
-		emoji:       "🌊",
-		description: "water wave",
-		aliases: []string{
-			"ocean",
-		},
-	},
-	{
-		emoji:       "🍏",
-		description: "green apple",
-		aliases: []string{
-			"green_apple",
-		},
-	},
-	{
-		emoji:       "🍎",
-		description: "red apple",
-		aliases: []string{
-			"apple",
-		},
-	},
-	{
-		emoji:       "🍐",
-		description: "pear",
-		aliases: []string{
-			"pear",
-		},
-	},
-	{
-		emoji:       "🍊",
-		description: "tangerine",
-		aliases: []string{
-			"tangerine",
-			"orange",
-			"mandarin",
-		},
-	},
-	{
-		emoji:       "🍋",
-		description: "lemon",
-		aliases: []string{
-			"lemon",
-		},
-	},
-	{
-		emoji:       "🍌",
-		description: "banana",
-		aliases: []string{
-			"banana",
-		},
-	},
-	{
-		emoji:       "🍉",
-		description: "watermelon",
-		aliases: []string{
-			"watermelon",
-		},
-	},
-	{
-		emoji:       "🍇",
-		description: "grapes",
-		aliases: []string{
-			"grapes",
-		},
-	},
-	{
-		emoji:       "🍓",
-		description: "strawberry",
-		aliases: []string{
-			"strawberry",
-		},
-	},
-	{
-		emoji:       "🍈",
-		description: "melon",
-		aliases: []string{
-			"melon",
-		},
-	},
-	{
-		emoji:       "🍒",
-		description: "cherries",
-		aliases: []string{
-			"cherries",
-		},
-	},
-	{
-		emoji:       "🍑",
-		description: "peach",
-		aliases: []string{
-			"peach",
-		},
-	},
-	{
-		emoji:       "🍍",
-		description: "pineapple",
-		aliases: []string{
-			"pineapple",
-		},
-	},
-	{
-		emoji:       "🍅",
-		description: "tomato",
-		aliases: []string{
-			"tomato",
-		},
-	},
-	{
-		emoji:       "🍆",
-		description: "eggplant",
-		aliases: []string{
-			"eggplant",
-		},
-	},
-	{
-		emoji:       "🌶",
-		description: "hot pepper",
-		aliases: []string{
-			"hot_pepper",
-		},
-	},
-	{
-		emoji:       "🌽",
-		description: "ear of corn",
-		aliases: []string{
-			"corn",
-		},
-	},
-	{
-		emoji:       "🍠",
-		description: "roasted sweet potato",
-		aliases: []string{
-			"sweet_potato",
-		},
-	},
-	{
-		emoji:       "🍯",
-		description: "honey pot",
-		aliases: []string{
-			"honey_pot",
-		},
-	},
-	{
-		emoji:       "🍞",
-		description: "bread",
-		aliases: []string{
-			"bread",
-		},
-	},
-	{
-		emoji:       "🧀",
-		description: "cheese wedge",
-		aliases: []string{
-			"cheese",
-		},
-	},
-	{
-		emoji:       "🍗",
-		description: "poultry leg",
-		aliases: []string{
-			"poultry_leg",
-		},
-	},
-	{
-		emoji:       "🍖",
-		description: "meat on bone",
-		aliases: []string{
-			"meat_on_bone",
-		},
-	},
-	{
-		emoji:       "🍤",
-		description: "fried shrimp",
-		aliases: []string{
-			"fried_shrimp",
-		},
-	},
-	{
-		emoji:       "🍳",
-		description: "cooking",
-		aliases: []string{
-			"egg",
-		},
-	},
-	{
-		emoji:       "🍔",
-		description: "hamburger",
-		aliases: []string{
-			"hamburger",
-		},
-	},
-	{
-		emoji:       "🍟",
-		description: "french fries",
-		aliases: []string{
-			"fries",
-		},
-	},
-	{
-		emoji:       "🌭",
-		description: "hot dog",
-		aliases: []string{
-			"hotdog",
-		},
-	},
-	{
-		emoji:       "🍕",
-		description: "pizza",
-		aliases: []string{
-			"pizza",
-		},
-	},
-	{
-		emoji:       "🍝",
-		description: "spaghetti",
-		aliases: []string{
-			"spaghetti",
-		},
-	},
-	{
-		emoji:       "🌮",
-		description: "taco",
-		aliases: []string{
-			"taco",
-		},
-	},
-	{
-		emoji:       "🌯",
-		description: "burrito",
-		aliases: []string{
-			"burrito",
-		},
-	},
-	{
-		emoji:       "🍜",
-		description: "steaming bowl",
-		aliases: []string{
-			"ramen",
-		},
-	},
-	{
-		emoji:       "🍲",
-		description: "pot of food",
-		aliases: []string{
-			"stew",
-		},
-	},
-	{
-		emoji:       "🍥",
-		description: "fish cake with swirl",
-		aliases: []string{
-			"fish_cake",
-		},
-	},
-	{
-		emoji:       "🍣",
-		description: "sushi",
-		aliases: []string{
-			"sushi",
-		},
-	},
-	{
-		emoji:       "🍱",
-		description: "bento box",
-		aliases: []string{
-			"bento",
-		},
-	},
-	{
-		emoji:       "🍛",
-		description: "curry rice",
-		aliases: []string{
-			"curry",
-		},
-	},
-	{
-		emoji:       "🍙",
-		description: "rice ball",
-		aliases: []string{
-			"rice_ball",
-		},
-	},
-	{
-		emoji:       "🍚",
-		description: "cooked rice",
-		aliases: []string{
-			"rice",
-		},
-	},
-	{
-		emoji:       "🍘",
-		description: "rice cracker",
-		aliases: []string{
-			"rice_cracker",
-		},
-	},
-	{
-		emoji:       "🍢",
-		description: "oden",
-		aliases: []string{
-			"oden",
-		},
-	},
-	{
-		emoji:       "🍡",
-		description: "dango",
-		aliases: []string{
-			"dango",
-		},
-	},
-	{
-		emoji:       "🍧",
-		description: "shaved ice",
-		aliases: []string{
-			"shaved_ice",
-		},
-	},
-	{
-		emoji:       "🍨",
-		description: "ice cream",
-		aliases: []string{
-			"ice_cream",
-		},
-	},
-	{
-		emoji:       "🍦",
-		description: "soft ice cream",
-		aliases: []string{
-			"icecream",
-		},
-	},
-	{
-		emoji:       "🍰",
-		description: "shortcake",
-		aliases: []string{
-			"cake",
-		},
-	},
-	{
-		emoji:       "🎂",
-		description: "birthday cake",
-		aliases: []string{
-			"birthday",
-		},
-	},
-	{
-		emoji:       "🍮",
-		description: "custard",
-		aliases: []string{
-			"custard",
-		},
-	},
-	{
-		emoji:       "🍬",
-		description: "candy",
-		aliases: []string{
-			"candy",
-		},
-	},
-	{
-		emoji:       "🍭",
-		description: "lollipop",
-		aliases: []string{
-			"lollipop",
-		},
-	},
-	{
-		emoji:       "🍫",
-		description: "chocolate bar",
-		aliases: []string{
-			"chocolate_bar",
-		},
-	},
-	{
-		emoji:       "🍿",
-		description: "popcorn",
-		aliases: []string{
-			"popcorn",
-		},
-	},
-	{
-		emoji:       "🍩",
-		description: "doughnut",
-		aliases: []string{
-			"doughnut",
-		},
-	},
-	{
-		emoji:       "🍪",
-		description: "cookie",
-		aliases: []string{
-			"cookie",
-		},
-	},
-	{
-		emoji:       "🍺",
-		description: "beer mug",
-		aliases: []string{
-			"beer",
-		},
-	},
-	{
-		emoji:       "🍻",
-		description: "clinking beer mugs",
-		aliases: []string{
-			"beers",
-		},
-	},
-	{
-		emoji:       "🍷",
-		description: "wine glass",
-		aliases: []string{
-			"wine_glass",
-		},
-	},
-	{
-		emoji:       "🍸",
-		description: "cocktail glass",
-		aliases: []string{
-			"cocktail",
-		},
-	},
-	{
-		emoji:       "🍹",
-		description: "tropical drink",
-		aliases: []string{
-			"tropical_drink",
-		},
-	},
-	{
-		emoji:       "🍾",
-		description: "bottle with popping cork",
-		aliases: []string{
-			"champagne",
-		},
-	},
-	{
-		emoji:       "🍶",
-		description: "sake",
-		aliases: []string{
-			"sake",
-		},
-	},
-	{
-		emoji:       "🍵",
-		description: "teacup without handle",
-		aliases: []string{
-			"tea",
-		},
-	},
-	{
-		emoji:       "☕️",
-		description: "hot beverage",
-		aliases: []string{
-			"coffee",
-		},
-	},
-	{
-		emoji:       "🍼",
-		description: "baby bottle",
-		aliases: []string{
-			"baby_bottle",
-		},
-	},
-	{
-		emoji:       "🍴",
-		description: "fork and knife",
-		aliases: []string{
-			"fork_and_knife",
-		},
-	},
-	{
-		emoji:       "🍽",
-		description: "fork and knife with plate",
-		aliases: []string{
-			"plate_with_cutlery",
-		},
-	},
-	{
-		emoji:       "⚽️",
-		description: "soccer ball",
-		aliases: []string{
-			"soccer",
-		},
-	},
-	{
-		emoji:       "🏀",
-		description: "basketball",
-		aliases: []string{
-			"basketball",
-		},
-	},
-	{
-		emoji:       "🏈",
-		description: "american football",
-		aliases: []string{
-			"football",
-		},
-	},
-	{
-		emoji:       "⚾️",
-		description: "baseball",
-		aliases: []string{
-			"baseball",
-		},
-	},
-	{
-		emoji:       "🎾",
-		description: "tennis",
-		aliases: []string{
-			"tennis",
-		},
-	},
-	{
-		emoji:       "🏐",
-		description: "volleyball",
-		aliases: []string{
-			"volleyball",
-		},
-	},
-	{
-		emoji:       "🏉",
-		description: "rugby football",
-		aliases: []string{
-			"rugby_football",
-		},
-	},
-	{
-		emoji:       "🎱",
-		description: "pool 8 ball",
-		aliases: []string{
-			"8ball",
-		},
-	},
-	{
-		emoji:       "🏓",
-		description: "ping pong",
-		aliases: []string{
-			"ping_pong",
-		},
-	},
-	{
-		emoji:       "🏸",
-		description: "badminton",
-		aliases: []string{
-			"badminton",
-		},
-	},
-	{
-		emoji:       "🏒",
-		description: "ice hockey",
-		aliases: []string{
-			"ice_hockey",
-		},
-	},
-	{
-		emoji:       "🏑",
-		description: "field hockey",
-		aliases: []string{
-			"field_hockey",
-		},
-	},
-	{
-		emoji:       "🏏",
-		description: "cricket",
-		aliases: []string{
-			"cricket",
-		},
-	},
-	{
-		emoji:       "🏹",
-		description: "bow and arrow",
-		aliases: []string{
-			"bow_and_arrow",
-		},
-	},
-	{
-		emoji:       "⛳️",
-		description: "flag in hole",
-		aliases: []string{
-			"golf",
-		},
-	},
-	{
-		emoji:       "🎣",
-		description: "fishing pole",
-		aliases: []string{
-			"fishing_pole_and_fish",
-		},
-	},
-	{
-		emoji:       "⛸",
-		description: "ice skate",
-		aliases: []string{
-			"ice_skate",
-		},
-	},
-	{
-		emoji:       "🎿",
-		description: "skis",
-		aliases: []string{
-			"ski",
-		},
-	},
-	{
-		emoji:       "⛷",
-		description: "skier",
-		aliases: []string{
-			"skier",
-		},
-	},
-	{
-		emoji:       "🏂",
-		description: "snowboarder",
-		aliases: []string{
-			"snowboarder",
-		},
-	},
-	{
-		emoji:       "🏋️\u200d♀️",
-		description: "woman lifting weights",
-		aliases: []string{
-			"weight_lifting_woman",
-		},
-	},
-	{
-		emoji:       "🏋️",
-		description: "person lifting weights",
-		aliases: []string{
-			"weight_lifting_man",
-		},
-	},
-	{
-		emoji:       "⛹️\u200d♀️",
-		description: "woman bouncing ball",
-		aliases: []string{
-			"basketball_woman",
-		},
-	},
-	{
-		emoji:       "⛹️",
-		description: "person bouncing ball",
-		aliases: []string{
-			"basketball_man",
-		},
-	},
-	{
-		emoji:       "🏌️\u200d♀️",
-		description: "woman golfing",
-		aliases: []string{
-			"golfing_woman",
-		},
-	},
-	{
-		emoji:       "🏌️",
-		description: "person golfing",
-		aliases: []string{
-			"golfing_man",
-		},
-	},
-	{
-		emoji:       "🏄\u200d♀️",
-		description: "woman surfing",
-		aliases: []string{
-			"surfing_woman",
-		},
-	},
-	{
-		emoji:       "🏄",
-		description: "person surfing",
-		aliases: []string{
-			"surfing_man",
-			"surfer",
-		},
-	},
-	{
-		emoji:       "🏊\u200d♀️",
-		description: "woman swimming",
-		aliases: []string{
-			"swimming_woman",
-		},
-	},
-	{
-		emoji:       "🏊",
-		description: "person swimming",
-		aliases: []string{
-			"swimming_man",
-			"swimmer",
-		},
-	},
-	{
-		emoji:       "🚣\u200d♀️",
-		description: "woman rowing boat",
-		aliases: []string{
-			"rowing_woman",
-		},
-	},
-	{
-		emoji:       "🚣",
-		description: "person rowing boat",
-		aliases: []string{
-			"rowing_man",
-			"rowboat",
-		},
-	},
-	{
-		emoji:       "🏇",
-		description: "horse racing",
-		aliases: []string{
-			"horse_racing",
-		},
-	},
-	{
-		emoji:       "🚴\u200d♀️",
-		description: "woman biking",
-		aliases: []string{
-			"biking_woman",
-		},
-	},
-	{
-		emoji:       "🚴",
-		description: "person biking",
-		aliases: []string{
-			"biking_man",
-			"bicyclist",
-		},
-	},
-	{
-		emoji:       "🚵\u200d♀️",
-		description: "woman mountain biking",
-		aliases: []string{
-			"mountain_biking_woman",
-		},
-	},
-	{
-		emoji:       "🚵",
-		description: "person mountain biking",
-		aliases: []string{
-			"mountain_biking_man",
-			"mountain_bicyclist",
-		},
-	},
-	{
-		emoji:       "🛀",
-		description: "person taking bath",
-		aliases: []string{
-			"bath",
-		},
-	},
-	{
-		emoji:       "🕴",
-		description: "man in business suit levitating",
-		aliases: []string{
-			"business_suit_levitating",
-		},
-	},
-	{
-		emoji:       "🎗",
-		description: "reminder ribbon",
-		aliases: []string{
-			"reminder_ribbon",
-		},
-	},
-	{
-		emoji:       "🎽",
-		description: "running shirt",
-		aliases: []string{
-			"running_shirt_with_sash",
-		},
-	},
-	{
-		emoji:       "🏅",
-		description: "sports medal",
-		aliases: []string{
-			"medal_sports",
-		},
-	},
-	{
-		emoji:       "🎖",
-		description: "military medal",
-		aliases: []string{
-			"medal_military",
-		},
-	},
-	{
-		emoji:       "🏆",
-		description: "trophy",
-		aliases: []string{
-			"trophy",
-		},
-	},
-	{
-		emoji:       "🏵",
-		description: "rosette",
-		aliases: []string{
-			"rosette",
-		},
-	},
-	{
-		emoji:       "🎯",
-		description: "direct hit",
-		aliases: []string{
-			"dart",
-		},
-	},
-	{
-		emoji:       "🎫",
-		description: "ticket",
-		aliases: []string{
-			"ticket",
-		},
-	},
-	{
-		emoji:       "🎟",
-		description: "admission tickets",
-		aliases: []string{
-			"tickets",
-		},
-	},
-	{
-		emoji:       "🎭",
-		description: "performing arts",
-		aliases: []string{
-			"performing_arts",
-		},
-	},
-	{
-		emoji:       "🎨",
-		description: "artist palette",
-		aliases: []string{
-			"art",
-		},
-	},
-	{
-		emoji:       "🎪",
-		description: "circus tent",
-		aliases: []string{
-			"circus_tent",
-		},
-	},
-	{
-		emoji:       "🎬",
-		description: "clapper board",
-		aliases: []string{
-			"clapper",
-		},
-	},
-	{
-		emoji:       "🎤",
-		description: "microphone",
-		aliases: []string{
-			"microphone",
-		},
-	},
-	{
-		emoji:       "🎧",
-		description: "headphone",
-		aliases: []string{
-			"headphones",
-		},
-	},
-	{
-		emoji:       "🎼",
-		description: "musical score",
-		aliases: []string{
-			"musical_score",
-		},
-	},
-	{
-		emoji:       "🎹",
-		description: "musical keyboard",
-		aliases: []string{
-			"musical_keyboard",
-		},
-	},
-	{
-		emoji:       "🎷",
-		description: "saxophone",
-		aliases: []string{
-			"saxophone",
-		},
-	},
-	{
-		emoji:       "🎺",
-		description: "trumpet",
-		aliases: []string{
-			"trumpet",
-		},
-	},
-	{
-		emoji:       "🎸",
-		description: "guitar",
-		aliases: []string{
-			"guitar",
-		},
-	},
-	{
-		emoji:       "🎻",
-		description: "violin",
-		aliases: []string{
-			"violin",
-		},
-	},
-	{
-		emoji:       "🎮",
-		description: "video game",
-		aliases: []string{
-			"video_game",
-		},
-	},
-	{
-		emoji:       "🎰",
-		description: "slot machine",
-		aliases: []string{
-			"slot_machine",
-		},
-	},
-	{
-		emoji:       "🎲",
-		description: "game die",
-		aliases: []string{
-			"game_die",
-		},
-	},
-	{
-		emoji:       "🎳",
-		description: "bowling",
-		aliases: []string{
-			"bowling",
-		},
-	},
-	{
-		emoji:       "🚗",
-		description: "automobile",
-		aliases: []string{
-			"car",
-			"red_car",
-		},
-	},
-	{
-		emoji:       "🚕",
-		description: "taxi",
-		aliases: []string{
-			"taxi",
-		},
-	},
-	{
-		emoji:       "🚙",
-		description: "sport utility vehicle",
-		aliases: []string{
-			"blue_car",
-		},
-	},
-	{
-		emoji:       "🚌",
-		description: "bus",
-		aliases: []string{
-			"bus",
-		},
-	},
-	{
-		emoji:       "🚎",
-		description: "trolleybus",
-		aliases: []string{
-			"trolleybus",
-		},
-	},
-	{
-		emoji:       "🏎",
-		description: "racing car",
-		aliases: []string{
-			"racing_car",
-		},
-	},
-	{
-		emoji:       "🚓",
-		description: "police car",
-		aliases: []string{
-			"police_car",
-		},
-	},
-	{
-		emoji:       "🚑",
-		description: "ambulance",
-		aliases: []string{
-			"ambulance",
-		},
-	},
-	{
-		emoji:       "🚒",
-		description: "fire engine",
-		aliases: []string{
-			"fire_engine",
-		},
-	},
-	{
-		emoji:       "🚐",
-		description: "minibus",
-		aliases: []string{
-			"minibus",
-		},
-	},
-	{
-		emoji:       "🚚",
-		description: "delivery truck",
-		aliases: []string{
-			"truck",
-		},
-	},
-	{
-		emoji:       "🚛",
-		description: "articulated lorry",
-		aliases: []string{
-			"articulated_lorry",
-		},
-	},
-	{
-		emoji:       "🚜",
-		description: "tractor",
-		aliases: []string{
-			"tractor",
-		},
-	},
-	{
-		emoji:       "🏍",
-		description: "motorcycle",
-		aliases: []string{
-			"motorcycle",
-		},
-	},
-	{
-		emoji:       "🚲",
-		description: "bicycle",
-		aliases: []string{
-			"bike",
-		},
-	},
-	{
-		emoji:       "🚨",
-		description: "police car light",
-		aliases: []string{
-			"rotating_light",
-		},
-	},
-	{
-		emoji:       "🚔",
-		description: "oncoming police car",
-		aliases: []string{
-			"oncoming_police_car",
-		},
-	},
-	{
-		emoji:       "🚍",
-		description: "oncoming bus",
-		aliases: []string{
-			"oncoming_bus",
-		},
-	},
-	{
-		emoji:       "🚘",
-		description: "oncoming automobile",
-		aliases: []string{
-			"oncoming_automobile",
-		},
-	},
-	{
-		emoji:       "🚖",
-		description: "oncoming taxi",
-		aliases: []string{
-			"oncoming_taxi",
-		},
-	},
-	{
-		emoji:       "🚡",
-		description: "aerial tramway",
-		aliases: []string{
-			"aerial_tramway",
-		},
-	},
-	{
-		emoji:       "🚠",
-		description: "mountain cableway",
-		aliases: []string{
-			"mountain_cableway",
-		},
-	},
-	{
-		emoji:       "🚟",
-		description: "suspension railway",
-		aliases: []string{
-			"suspension_railway",
-		},
-	},
-	{
-		emoji:       "🚃",
-		description: "railway car",
-		aliases: []string{
-			"railway_car",
-		},
-	},
-	{
-		emoji:       "🚋",
-		description: "tram car",
-		aliases: []string{
-			"train",
-		},
-	},
-	{
-		emoji:       "🚝",
-		description: "monorail",
-		aliases: []string{
-			"monorail",
-		},
-	},
-	{
-		emoji:       "🚄",
-		description: "high-speed train",
-		aliases: []string{
-			"bullettrain_side",
-		},
-	},
-	{
-		emoji:       "🚅",
-		description: "high-speed train with bullet nose",
-		aliases: []string{
-			"bullettrain_front",
-		},
-	},
-	{
-		emoji:       "🚈",
-		description: "light rail",
-		aliases: []string{
-			"light_rail",
-		},
-	},
-	{
-		emoji:       "🚞",
-		description: "mountain railway",
-		aliases: []string{
-			"mountain_railway",
-		},
-	},
-	{
-		emoji:       "🚂",
-		description: "locomotive",
-		aliases: []string{
-			"steam_locomotive",
-		},
-	},
-	{
-		emoji:       "🚆",
-		description: "train",
-		aliases: []string{
-			"train2",
-		},
-	},
-	{
-		emoji:       "🚇",
-		description: "metro",
-		aliases: []string{
-			"metro",
-		},
-	},
-	{
-		emoji:       "🚊",
-		description: "tram",
-		aliases: []string{
-			"tram",
-		},
-	},
-	{
-		emoji:       "🚉",
-		description: "station",
-		aliases: []string{
-			"station",
-		},
-	},
-	{
-		emoji:       "🚁",
-		description: "helicopter",
-		aliases: []string{
-			"helicopter",
-		},
-	},
-	{
-		emoji:       "🛩",
-		description: "small airplane",
-		aliases: []string{
-			"small_airplane",
-		},
-	},
-	{
-		emoji:       "✈️",
-		description: "airplane",
-		aliases: []string{
-			"airplane",
-		},
-	},
-	{
-		emoji:       "🛫",
-		description: "airplane departure",
-		aliases: []string{
-			"flight_departure",
-		},
-	},
-	{
-		emoji:       "🛬",
-		description: "airplane arrival",
-		aliases: []string{
-			"flight_arrival",
-		},
-	},
-	{
-		emoji:       "⛵️",
-		description: "sailboat",
-		aliases: []string{
-			"boat",
-			"sailboat",
-		},
-	},
-	{
-		emoji:       "🛥",
-		description: "motor boat",
-		aliases: []string{
-			"motor_boat",
-		},
-	},
-	{
-		emoji:       "🚤",
-		description: "speedboat",
-		aliases: []string{
-			"speedboat",
-		},
-	},
-	{
-		emoji:       "⛴",
-		description: "ferry",
-		aliases: []string{
-			"ferry",
-		},
-	},
-	{
-		emoji:       "🛳",
-		description: "passenger ship",
-		aliases: []string{
-			"passenger_ship",
-		},
-	},
-	{
-		emoji:       "🚀",
-		description: "rocket",
-		aliases: []string{
-			"rocket",
-		},
-	},
-	{
-		emoji:       "🛰",
-		description: "satellite",
-		aliases: []string{
-			"artificial_satellite",
-		},
-	},
-	{
-		emoji:       "💺",
-		description: "seat",
-		aliases: []string{
-			"seat",
-		},
-	},
-	{
-		emoji:       "⚓️",
-		description: "anchor",
-		aliases: []string{
-			"anchor",
-		},
-	},
-	{
-		emoji:       "🚧",
-		description: "construction",
-		aliases: []string{
-			"construction",
-		},
-	},
-	{
-		emoji:       "⛽️",
-		description: "fuel pump",
-		aliases: []string{
-			"fuelpump",
-		},
-	},
-	{
-		emoji:       "🚏",
-		description: "bus stop",
-		aliases: []string{
-			"busstop",
-		},
-	},
-	{
-		emoji:       "🚦",
-		description: "vertical traffic light",
-		aliases: []string{
-			"vertical_traffic_light",
-		},
-	},
-	{
-		emoji:       "🚥",
-		description: "horizontal traffic light",
-		aliases: []string{
-			"traffic_light",
-		},
-	},
-	{
-		emoji:       "🗺",
-		description: "world map",
-		aliases: []string{
-			"world_map",
-		},
-	},
-	{
-		emoji:       "🚢",
-		description: "ship",
-		aliases: []string{
-			"ship",
-		},
-	},
-	{
-		emoji:       "🎡",
-		description: "ferris wheel",
-		aliases: []string{
-			"ferris_wheel",
-		},
-	},
-	{
-		emoji:       "🎢",
-		description: "roller coaster",
-		aliases: []string{
-			"roller_coaster",
-		},
-	},
-	{
-		emoji:       "🎠",
-		description: "carousel horse",
-		aliases: []string{
-			"carousel_horse",
-		},
-	},
-	{
-		emoji:       "🏗",
-		description: "building construction",
-		aliases: []string{
-			"building_construction",
-		},
-	},
-	{
-		emoji:       "🌁",
-		description: "foggy",
-		aliases: []string{
-			"foggy",
-		},
-	},
-	{
-		emoji:       "🗼",
-		description: "Tokyo tower",
-		aliases: []string{
-			"tokyo_tower",
-		},
-	},
-	{
-		emoji:       "🏭",
-		description: "factory",
-		aliases: []string{
-			"factory",
-		},
-	},
-	{
-		emoji:       "⛲️",
-		description: "fountain",
-		aliases: []string{
-			"fountain",
-		},
-	},
-	{
-		emoji:       "🎑",
-		description: "moon viewing ceremony",
-		aliases: []string{
-			"rice_scene",
-		},
-	},
-	{
-		emoji:       "⛰",
-		description: "mountain",
-		aliases: []string{
-			"mountain",
-		},
-	},
-	{
-		emoji:       "🏔",
-		description: "snow-capped mountain",
-		aliases: []string{
-			"mountain_snow",
-		},
-	},
-	{
-		emoji:       "🗻",
-		description: "mount fuji",
-		aliases: []string{
-			"mount_fuji",
-		},
-	},
-	{
-		emoji:       "🌋",
-		description: "volcano",
-		aliases: []string{
-			"volcano",
-		},
-	},
-	{
-		emoji:       "🗾",
-		description: "map of Japan",
-		aliases: []string{
-			"japan",
-		},
-	},
-	{
-		emoji:       "🏕",
-		description: "camping",
-		aliases: []string{
-			"camping",
-		},
-	},
-	{
-		emoji:       "⛺️",
-		description: "tent",
-		aliases: []string{
-			"tent",
-		},
-	},
-	{
-		emoji:       "🏞",
-		description: "national park",
-		aliases: []string{
-			"national_park",
-		},
-	},
-	{
-		emoji:       "🛣",
-		description: "motorway",
-		aliases: []string{
-			"motorway",
-		},
-	},
-	{
-		emoji:       "🛤",
-		description: "railway track",
-		aliases: []string{
-			"railway_track",
-		},
-	},
-	{
-		emoji:       "🌅",
-		description: "sunrise",
-		aliases: []string{
-			"sunrise",
-		},
-	},
-	{
-		emoji:       "🌄",
-		description: "sunrise over mountains",
-		aliases: []string{
-			"sunrise_over_mountains",
-		},
-	},
-	{
-		emoji:       "🏜",
-		description: "desert",
-		aliases: []string{
-			"desert",
-		},
-	},
-	{
-		emoji:       "🏖",
-		description: "beach with umbrella",
-		aliases: []string{
-			"beach_umbrella",
-		},
-	},
-	{
-		emoji:       "🏝",
-		description: "desert island",
-		aliases: []string{
-			"desert_island",
-		},
-	},
-	{
-		emoji:       "🌇",
-		description: "sunset",
-		aliases: []string{
-			"city_sunrise",
-		},
-	},
-	{
-		emoji:       "🌆",
-		description: "cityscape at dusk",
-		aliases: []string{
-			"city_sunset",
-		},
-	},
-	{
-		emoji:       "🏙",
-		description: "cityscape",
-		aliases: []string{
-			"cityscape",
-		},
-	},
-	{
-		emoji:       "🌃",
-		description: "night with stars",
-		aliases: []string{
-			"night_with_stars",
-		},
-	},
-	{
-		emoji:       "🌉",
-		description: "bridge at night",
-		aliases: []string{
-			"bridge_at_night",
-		},
-	},
-	{
-		emoji:       "🌌",
-		description: "milky way",
-		aliases: []string{
-			"milky_way",
-		},
-	},
-	{
-		emoji:       "🌠",
-		description: "shooting star",
-		aliases: []string{
-			"stars",
-		},
-	},
-	{
-		emoji:       "🎇",
-		description: "sparkler",
-		aliases: []string{
-			"sparkler",
-		},
-	},
-	{
-		emoji:       "🎆",
-		description: "fireworks",
-		aliases: []string{
-			"fireworks",
-		},
-	},
-	{
-		emoji:       "🌈",
-		description: "rainbow",
-		aliases: []string{
-			"rainbow",
-		},
-	},
-	{
-		emoji:       "🏘",
-		description: "house",
-		aliases: []string{
-			"houses",
-		},
-	},
-	{
-		emoji:       "🏰",
-		description: "castle",
-		aliases: []string{
-			"european_castle",
-		},
-	},
-	{
-		emoji:       "🏯",
-		description: "Japanese castle",
-		aliases: []string{
-			"japanese_castle",
-		},
-	},
-	{
-		emoji:       "🏟",
-		description: "stadium",
-		aliases: []string{
-			"stadium",
-		},
-	},
-	{
-		emoji:       "🗽",
-		description: "Statue of Liberty",
-		aliases: []string{
-			"statue_of_liberty",
-		},
-	},
-	{
-		emoji:       "🏠",
-		description: "house",
-		aliases: []string{
-			"house",
-		},
-	},
-	{
-		emoji:       "🏡",
-		description: "house with garden",
-		aliases: []string{
-			"house_with_garden",
-		},
-	},
-	{
-		emoji:       "🏚",
-		description: "derelict house",
-		aliases: []string{
-			"derelict_house",
-		},
-	},
-	{
-		emoji:       "🏢",
-		description: "office building",
-		aliases: []string{
-			"office",
-		},
-	},
-	{
-		emoji:       "🏬",
-		description: "department store",
-		aliases: []string{
-			"department_store",
-		},
-	},
-	{
-		emoji:       "🏣",
-		description: "Japanese post office",
-		aliases: []string{
-			"post_office",
-		},
-	},
-	{
-		emoji:       "🏤",
-		description: "post office",
-		aliases: []string{
-			"european_post_office",
-		},
-	},
-	{
-		emoji:       "🏥",
-		description: "hospital",
-		aliases: []string{
-			"hospital",
-		},
-	},
-	{
-		emoji:       "🏦",
-		description: "bank",
-		aliases: []string{
-			"bank",
-		},
-	},
-	{
-		emoji:       "🏨",
-		description: "hotel",
-		aliases: []string{
-			"hotel",
-		},
-	},
-	{
-		emoji:       "🏪",
-		description: "convenience store",
-		aliases: []string{
-			"convenience_store",
-		},
-	},
-	{
-		emoji:       "🏫",
-		description: "school",
-		aliases: []string{
-			"school",
-		},
-	},
-	{
-		emoji:       "🏩",
-		description: "love hotel",
-		aliases: []string{
-			"love_hotel",
-		},
-	},
-	{
-		emoji:       "💒",
-		description: "wedding",
-		aliases: []string{
-			"wedding",
-		},
-	},
-	{
-		emoji:       "🏛",
-		description: "classical building",
-		aliases: []string{
-			"classical_building",
-		},
-	},
-	{
-		emoji:       "⛪️",
-		description: "church",
-		aliases: []string{
-			"church",
-		},
-	},
-	{
-		emoji:       "🕌",
-		description: "mosque",
-		aliases: []string{
-			"mosque",
-		},
-	},
-	{
-		emoji:       "🕍",
-		description: "synagogue",
-		aliases: []string{
-			"synagogue",
-		},
-	},
-	{
-		emoji:       "🕋",
-		description: "kaaba",
-		aliases: []string{
-			"kaaba",
-		},
-	},
-	{
-		emoji:       "⛩",
-		description: "shinto shrine",
-		aliases: []string{
-			"shinto_shrine",
-		},
-	},
-	{
-		emoji:       "⌚️",
-		description: "watch",
-		aliases: []string{
-			"watch",
-		},
-	},
-	{
-		emoji:       "📱",
-		description: "mobile phone",
-		aliases: []string{
-			"iphone",
-		},
-	},
-	{
-		emoji:       "📲",
-		description: "mobile phone with arrow",
-		aliases: []string{
-			"calling",
-		},
-	},
-	{
-		emoji:       "💻",
-		description: "laptop computer",
-		aliases: []string{
-			"computer",
-		},
-	},
-	{
-		emoji:       "⌨️",
-		description: "keyboard",
-		aliases: []string{
-			"keyboard",
-		},
-	},
-	{
-		emoji:       "🖥",
-		description: "desktop computer",
-		aliases: []string{
-			"desktop_computer",
-		},
-	},
-	{
-		emoji:       "🖨",
-		description: "printer",
-		aliases: []string{
-			"printer",
-		},
-	},
-	{
-		emoji:       "🖱",
-		description: "computer mouse",
-		aliases: []string{
-			"computer_mouse",
-		},
-	},
-	{
-		emoji:       "🖲",
-		description: "trackball",
-		aliases: []string{
-			"trackball",
-		},
-	},
-	{
-		emoji:       "🕹",
-		description: "joystick",
-		aliases: []string{
-			"joystick",
-		},
-	},
-	{
-		emoji:       "🗜",
-		description: "clamp",
-		aliases: []string{
-			"clamp",
-		},
-	},
-	{
-		emoji:       "💽",
-		description: "computer disk",
-		aliases: []string{
-			"minidisc",
-		},
-	},
-	{
-		emoji:       "💾",
-		description: "floppy disk",
-		aliases: []string{
-			"floppy_disk",
-		},
-	},
-	{
-		emoji:       "💿",
-		description: "optical disk",
-		aliases: []string{
-			"cd",
-		},
-	},
-	{
-		emoji:       "📀",
-		description: "dvd",
-		aliases: []string{
-			"dvd",
-		},
-	},
-	{
-		emoji:       "📼",
-		description: "videocassette",
-		aliases: []string{
-			"vhs",
-		},
-	},
-	{
-		emoji:       "📷",
-		description: "camera",
-		aliases: []string{
-			"camera",
-		},
-	},
-	{
-		emoji:       "📸",
-		description: "camera with flash",
-		aliases: []string{
-			"camera_flash",
-		},
-	},
-	{
-		emoji:       "📹",
-		description: "video camera",
-		aliases: []string{
-			"video_camera",
-		},
-	},
-	{
-		emoji:       "🎥",
-		description: "movie camera",
-		aliases: []string{
-			"movie_camera",
-		},
-	},
-	{
-		emoji:       "📽",
-		description: "film projector",
-		aliases: []string{
-			"film_projector",
-		},
-	},
-	{
-		emoji:       "🎞",
-		description: "film frames",
-		aliases: []string{
-			"film_strip",
-		},
-	},
-	{
-		emoji:       "📞",
-		description: "telephone receiver",
-		aliases: []string{
-			"telephone_receiver",
-		},
-	},
-	{
-		emoji:       "☎️",
-		description: "telephone",
-		aliases: []string{
-			"phone",
-			"telephone",
-		},
-	},
-	{
-		emoji:       "📟",
-		description: "pager",
-		aliases: []string{
-			"pager",
-		},
-	},
-	{
-		emoji:       "📠",
-		description: "fax machine",
-		aliases: []string{
-			"fax",
-		},
-	},
-	{
-		emoji:       "📺",
-		description: "television",
-		aliases: []string{
-			"tv",
-		},
-	},
-	{
-		emoji:       "📻",
-		description: "radio",
-		aliases: []string{
-			"radio",
-		},
-	},
-	{
-		emoji:       "🎙",
-		description: "studio microphone",
-		aliases: []string{
-			"studio_microphone",
-		},
-	},
-	{
-		emoji:       "🎚",
-		description: "level slider",
-		aliases: []string{
-			"level_slider",
-		},
-	},
-	{
-		emoji:       "🎛",
-		description: "control knobs",
-		aliases: []string{
-			"control_knobs",
-		},
-	},
-	{
-		emoji:       "⏱",
-		description: "stopwatch",
-		aliases: []string{
-			"stopwatch",
-		},
-	},
-	{
-		emoji:       "⏲",
-		description: "timer clock",
-		aliases: []string{
-			"timer_clock",
-		},
-	},
-	{
-		emoji:       "⏰",
-		description: "alarm clock",
-		aliases: []string{
-			"alarm_clock",
-		},
-	},
-	{
-		emoji:       "🕰",
-		description: "mantelpiece clock",
-		aliases: []string{
-			"mantelpiece_clock",
-		},
-	},
-	{
-		emoji:       "⏳",
-		description: "hourglass with flowing sand",
-		aliases: []string{
-			"hourglass_flowing_sand",
-		},
-	},
-	{
-		emoji:       "⌛️",
-		description: "hourglass",
-		aliases: []string{
-			"hourglass",
-		},
-	},
-	{
-		emoji:       "📡",
-		description: "satellite antenna",
-		aliases: []string{
-			"satellite",
-		},
-	},
-	{
-		emoji:       "🔋",
-		description: "battery",
-		aliases: []string{
-			"battery",
-		},
-	},
-	{
-		emoji:       "🔌",
-		description: "electric plug",
-		aliases: []string{
-			"electric_plug",
-		},
-	},
-	{
-		emoji:       "💡",
-		description: "light bulb",
-		aliases: []string{
-			"bulb",
-		},
-	},
-	{
-		emoji:       "🔦",
-		description: "flashlight",
-		aliases: []string{
-			"flashlight",
-		},
-	},
-	{
-		emoji:       "🕯",
-		description: "candle",
-		aliases: []string{
-			"candle",
-		},
-	},
-	{
-		emoji:       "🗑",
-		description: "wastebasket",
-		aliases: []string{
-			"wastebasket",
-		},
-	},
-	{
-		emoji:       "🛢",
-		description: "oil drum",
-		aliases: []string{
-			"oil_drum",
-		},
-	},
-	{
-		emoji:       "💸",
-		description: "money with wings",
-		aliases: []string{
-			"money_with_wings",
-		},
-	},
-	{
-		emoji:       "💵",
-		description: "dollar banknote",
-		aliases: []string{
-			"dollar",
-		},
-	},
-	{
-		emoji:       "💴",
-		description: "yen banknote",
-		aliases: []string{
-			"yen",
-		},
-	},
-	{
-		emoji:       "💶",
-		description: "euro banknote",
-		aliases: []string{
-			"euro",
-		},
-	},
-	{
-		emoji:       "💷",
-		description: "pound banknote",
-		aliases: []string{
-			"pound",
-		},
-	},
-	{
-		emoji:       "💰",
-		description: "money bag",
-		aliases: []string{
-			"moneybag",
-		},
-	},
-	{
-		emoji:       "💳",
-		description: "credit card",
-		aliases: []string{
-			"credit_card",
-		},
-	},
-	{
-		emoji:       "💎",
-		description: "gem stone",
-		aliases: []string{
-			"gem",
-		},
-	},
-	{
-		emoji:       "⚖",
-		description: "balance scale",
-		aliases: []string{
-			"balance_scale",
-		},
-	},
-	{
-		emoji:       "🔧",
-		description: "wrench",
-		aliases: []string{
-			"wrench",
-		},
-	},
-	{
-		emoji:       "🔨",
-		description: "hammer",
-		aliases: []string{
-			"hammer",
-		},
-	},
-	{
-		emoji:       "⚒",
-		description: "hammer and pick",
-		aliases: []string{
-			"hammer_and_pick",
-		},
-	},
-	{
-		emoji:       "🛠",
-		description: "hammer and wrench",
-		aliases: []string{
-			"hammer_and_wrench",
-		},
-	},
-	{
-		emoji:       "⛏",
-		description: "pick",
-		aliases: []string{
-			"pick",
-		},
-	},
-	{
-		emoji:       "🔩",
-		description: "nut and bolt",
-		aliases: []string{
-			"nut_and_bolt",
-		},
-	},
-	{
-		emoji:       "⚙",
-		description: "gear",
-		aliases: []string{
-			"gear",
-		},
-	},
-	{
-		emoji:       "⛓",
-		description: "chains",
-		aliases: []string{
-			"chains",
-		},
-	},
-	{
-		emoji:       "🔫",
-		description: "pistol",
-		aliases: []string{
-			"gun",
-		},
-	},
-	{
-		emoji:       "💣",
-		description: "bomb",
-		aliases: []string{
-			"bomb",
-		},
-	},
-	{
-		emoji:       "🔪",
-		description: "kitchen knife",
-		aliases: []string{
-			"hocho",
-			"knife",
-		},
-	},
-	{
-		emoji:       "🗡",
-		description: "dagger",
-		aliases: []string{
-			"dagger",
-		},
-	},
-	{
-		emoji:       "⚔",
-		description: "crossed swords",
-		aliases: []string{
-			"crossed_swords",
-		},
-	},
-	{
-		emoji:       "🛡",
-		description: "shield",
-		aliases: []string{
-			"shield",
-		},
-	},
-	{
-		emoji:       "🚬",
-		description: "cigarette",
-		aliases: []string{
-			"smoking",
-		},
-	},
-	{
-		emoji:       "⚰",
-		description: "coffin",
-		aliases: []string{
-			"coffin",
-		},
-	},
-	{
-		emoji:       "⚱",
-		description: "funeral urn",
-		aliases: []string{
-			"funeral_urn",
-		},
-	},
-	{
-		emoji:       "🏺",
-		description: "amphora",
-		aliases: []string{
-			"amphora",
-		},
-	},
-	{
-		emoji:       "🔮",
-		description: "crystal ball",
-		aliases: []string{
-			"crystal_ball",
-		},
-	},
-	{
-		emoji:       "📿",
-		description: "prayer beads",
-		aliases: []string{
-			"prayer_beads",
-		},
-	},
-	{
-		emoji:       "💈",
-		description: "barber pole",
-		aliases: []string{
-			"barber",
-		},
-	},
-	{
-		emoji:       "⚗",
-		description: "alembic",
-		aliases: []string{
-			"alembic",
-		},
-	},
-	{
-		emoji:       "🔭",
-		description: "telescope",
-		aliases: []string{
-			"telescope",
-		},
-	},
-	{
-		emoji:       "🔬",
-		description: "microscope",
-		aliases: []string{
-			"microscope",
-		},
-	},
-	{
-		emoji:       "🕳",
-		description: "hole",
-		aliases: []string{
-			"hole",
-		},
-	},
-	{
-		emoji:       "💊",
-		description: "pill",
-		aliases: []string{
-			"pill",
-		},
-	},
-	{
-		emoji:       "💉",
-		description: "syringe",
-		aliases: []string{
-			"syringe",
-		},
-	},
-	{
-		emoji:       "🌡",
-		description: "thermometer",
-		aliases: []string{
-			"thermometer",
-		},
-	},
-	{
-		emoji:       "🚽",
-		description: "toilet",
-		aliases: []string{
-			"toilet",
-		},
-	},
-	{
-		emoji:       "🚿",
-		description: "shower",
-		aliases: []string{
-			"shower",
-		},
-	},
-	{
-		emoji:       "🛁",
-		description: "bathtub",
-		aliases: []string{
-			"bathtub",
-		},
-	},
-	{
-		emoji:       "🛎",
-		description: "bellhop bell",
-		aliases: []string{
-			"bellhop_bell",
-		},
-	},
-	{
-		emoji:       "🔑",
-		description: "key",
-		aliases: []string{
-			"key",
-		},
-	},
-	{
-		emoji:       "🗝",
-		description: "old key",
-		aliases: []string{
-			"old_key",
-		},
-	},
-	{
-		emoji:       "🚪",
-		description: "door",
-		aliases: []string{
-			"door",
-		},
-	},
-	{
-		emoji:       "🛋",
-		description: "couch and lamp",
-		aliases: []string{
-			"couch_and_lamp",
-		},
-	},
-	{
-		emoji:       "🛌",
-		description: "person in bed",
-		aliases: []string{
-			"sleeping_bed",
-		},
-	},
-	{
-		emoji:       "🛏",
-		description: "bed",
-		aliases: []string{
-			"bed",
-		},
-	},
-	{
-		emoji:       "🖼",
-		description: "framed picture",
-		aliases: []string{
-			"framed_picture",
-		},
-	},
-	{
-		emoji:       "⛱",
-		description: "umbrella on ground",
-		aliases: []string{
-			"parasol_on_ground",
-		},
-	},
-	{
-		emoji:       "🗿",
-		description: "moai",
-		aliases: []string{
-			"moyai",
-		},
-	},
-	{
-		emoji:       "🛍",
-		description: "shopping bags",
-		aliases: []string{
-			"shopping",
-		},
-	},
-	{
-		emoji:       "🎁",
-		description: "wrapped gift",
-		aliases: []string{
-			"gift",
-		},
-	},
-	{
-		emoji:       "🎈",
-		description: "balloon",
-		aliases: []string{
-			"balloon",
-		},
-	},
-	{
-		emoji:       "🎏",
-		description: "carp streamer",
-		aliases: []string{
-			"flags",
-		},
-	},
-	{
-		emoji:       "🎀",
-		description: "ribbon",
-		aliases: []string{
-			"ribbon",
-		},
-	},
-	{
-		emoji:       "🎊",
-		description: "confetti ball",
-		aliases: []string{
-			"confetti_ball",
-		},
-	},
-	{
-		emoji:       "🎉",
-		description: "party popper",
-		aliases: []string{
-			"tada",
-		},
-	},
-	{
-		emoji:       "🎐",
-		description: "wind chime",
-		aliases: []string{
-			"wind_chime",
-		},
-	},
-	{
-		emoji:       "🏮",
-		description: "red paper lantern",
-		aliases: []string{
-			"izakaya_lantern",
-			"lantern",
-		},
-	},
-	{
-		emoji:       "🎎",
-		description: "Japanese dolls",
-		aliases: []string{
-			"dolls",
-		},
-	},
-	{
-		emoji:       "✉️",
-		description: "envelope",
-		aliases: []string{
-			"email",
-			"envelope",
-		},
-	},
-	{
-		emoji:       "📩",
-		description: "envelope with arrow",
-		aliases: []string{
-			"envelope_with_arrow",
-		},
-	},
-	{
-		emoji:       "📨",
-		description: "incoming envelope",
-		aliases: []string{
-			"incoming_envelope",
-		},
-	},
-	{
-		emoji:       "📧",
-		description: "e-mail",
-		aliases: []string{
-			"e-mail",
-		},
-	},
-	{
-		emoji:       "💌",
-		description: "love letter",
-		aliases: []string{
-			"love_letter",
-		},
-	},
-	{
-		emoji:       "📥",
-		description: "inbox tray",
-		aliases: []string{
-			"inbox_tray",
-		},
-	},
-	{
-		emoji:       "📤",
-		description: "outbox tray",
-		aliases: []string{
-			"outbox_tray",
-		},
-	},
-	{
-		emoji:       "📦",
-		description: "package",
-		aliases: []string{
-			"package",
-		},
-	},
-	{
-		emoji:       "🏷",
-		description: "label",
-		aliases: []string{
-			"label",
-		},
-	},
-	{
-		emoji:       "🔖",
-		description: "bookmark",
-		aliases: []string{
-			"bookmark",
-		},
-	},
-	{
-		emoji:       "📪",
-		description: "closed mailbox with lowered flag",
-		aliases: []string{
-			"mailbox_closed",
-		},
-	},
-	{
-		emoji:       "📫",
-		description: "closed mailbox with raised flag",
-		aliases: []string{
-			"mailbox",
-		},
-	},
-	{
-		emoji:       "📬",
-		description: "open mailbox with raised flag",
-		aliases: []string{
-			"mailbox_with_mail",
-		},
-	},
-	{
-		emoji:       "📭",
-		description: "open mailbox with lowered flag",
-		aliases: []string{
-			"mailbox_with_no_mail",
-		},
-	},
-	{
-		emoji:       "📮",
-		description: "postbox",
-		aliases: []string{
-			"postbox",
-		},
-	},
-	{
-		emoji:       "📯",
-		description: "postal horn",
-		aliases: []string{
-			"postal_horn",
-		},
-	},
-	{
-		emoji:       "📜",
-		description: "scroll",
-		aliases: []string{
-			"scroll",
-		},
-	},
-	{
-		emoji:       "📃",
-		description: "page with curl",
-		aliases: []string{
-			"page_with_curl",
-		},
-	},
-	{
-		emoji:       "📄",
-		description: "page facing up",
-		aliases: []string{
-			"page_facing_up",
-		},
-	},
-	{
-		emoji:       "📑",
-		description: "bookmark tabs",
-		aliases: []string{
-			"bookmark_tabs",
-		},
-	},
-	{
-		emoji:       "📊",
-		description: "bar chart",
-		aliases: []string{
-			"bar_chart",
-		},
-	},
-	{
-		emoji:       "📈",
-		description: "chart increasing",
-		aliases: []string{
-			"chart_with_upwards_trend",
-		},
-	},
-	{
-		emoji:       "📉",
-		description: "chart decreasing",
-		aliases: []string{
-			"chart_with_downwards_trend",
-		},
-	},
-	{
-		emoji:       "🗒",
-		description: "spiral notepad",
-		aliases: []string{
-			"spiral_notepad",
-		},
-	},
-	{
-		emoji:       "🗓",
-		description: "spiral calendar",
-		aliases: []string{
-			"spiral_calendar",
-		},
-	},
-	{
-		emoji:       "📆",
-		description: "tear-off calendar",
-		aliases: []string{
-			"calendar",
-		},
-	},
-	{
-		emoji:       "📅",
-		description: "calendar",
-		aliases: []string{
-			"date",
-		},
-	},
-	{
-		emoji:       "📇",
-		description: "card index",
-		aliases: []string{
-			"card_index",
-		},
-	},
-	{
-		emoji:       "🗃",
-		description: "card file box",
-		aliases: []string{
-			"card_file_box",
-		},
-	},
-	{
-		emoji:       "🗳",
-		description: "ballot box with ballot",
-		aliases: []string{
-			"ballot_box",
-		},
-	},
-	{
-		emoji:       "🗄",
-		description: "file cabinet",
-		aliases: []string{
-			"file_cabinet",
-		},
-	},
-	{
-		emoji:       "📋",
-		description: "clipboard",
-		aliases: []string{
-			"clipboard",
-		},
-	},
-	{
-		emoji:       "📁",
-		description: "file folder",
-		aliases: []string{
-			"file_folder",
-		},
-	},
-	{
-		emoji:       "📂",
-		description: "open file folder",
-		aliases: []string{
-			"open_file_folder",
-		},
-	},
-	{
-		emoji:       "🗂",
-		description: "card index dividers",
-		aliases: []string{
-			"card_index_dividers",
-		},
-	},
-	{
-		emoji:       "🗞",
-		description: "rolled-up newspaper",
-		aliases: []string{
-			"newspaper_roll",
-		},
-	},
-	{
-		emoji:       "📰",
-		description: "newspaper",
-		aliases: []string{
-			"newspaper",
-		},
-	},
-	{
-		emoji:       "📓",
-		description: "notebook",
-		aliases: []string{
-			"notebook",
-		},
-	},
-	{
-		emoji:       "📔",
-		description: "notebook with decorative cover",
-		aliases: []string{
-			"notebook_with_decorative_cover",
-		},
-	},
-	{
-		emoji:       "📒",
-		description: "ledger",
-		aliases: []string{
-			"ledger",
-		},
-	},
-	{
-		emoji:       "📕",
-		description: "closed book",
-		aliases: []string{
-			"closed_book",
-		},
-	},
-	{
-		emoji:       "📗",
-		description: "green book",
-		aliases: []string{
-			"green_book",
-		},
-	},
-	{
-		emoji:       "📘",
-		description: "blue book",
-		aliases: []string{
-			"blue_book",
-		},
-	},
-	{
-		emoji:       "📙",
-		description: "orange book",
-		aliases: []string{
-			"orange_book",
-		},
-	},
-	{
-		emoji:       "📚",
-		description: "books",
-		aliases: []string{
-			"books",
-		},
-	},
-	{
-		emoji:       "📖",
-		description: "open book",
-		aliases: []string{
-			"book",
-			"open_book",
-		},
-	},
-	{
-		emoji:       "🔗",
-		description: "link",
-		aliases: []string{
-			"link",
-		},
-	},
-	{
-		emoji:       "📎",
-		description: "paperclip",
-		aliases: []string{
-			"paperclip",
-		},
-	},
-	{
-		emoji:       "🖇",
-		description: "linked paperclips",
-		aliases: []string{
-			"paperclips",
-		},
-	},
-	{
-		emoji:       "📐",
-		description: "triangular ruler",
-		aliases: []string{
-			"triangular_ruler",
-		},
-	},
-	{
-		emoji:       "📏",
-		description: "straight ruler",
-		aliases: []string{
-			"straight_ruler",
-		},
-	},
-	{
-		emoji:       "✂️",
-		description: "scissors",
-		aliases: []string{
-			"scissors",
-		},
-	},
-	{
-		emoji:       "📌",
-		description: "pushpin",
-		aliases: []string{
-			"pushpin",
-		},
-	},
-	{
-		emoji:       "📍",
-		description: "round pushpin",
-		aliases: []string{
-			"round_pushpin",
-		},
-	},
-	{
-		emoji:       "🚩",
-		description: "triangular flag",
-		aliases: []string{
-			"triangular_flag_on_post",
-		},
-	},
-	{
-		emoji:       "🎌",
-		description: "crossed flags",
-		aliases: []string{
-			"crossed_flags",
-		},
-	},
-	{
-		emoji:       "🏳️",
-		description: "white flag",
-		aliases: []string{
-			"white_flag",
-		},
-	},
-	{
-		emoji:       "🏴",
-		description: "black flag",
-		aliases: []string{
-			"black_flag",
-		},
-	},
-	{
-		emoji:       "🏁",
-		description: "chequered flag",
-		aliases: []string{
-			"checkered_flag",
-		},
-	},
-	{
-		emoji:       "🏳️\u200d🌈",
-		description: "rainbow flag",
-		aliases: []string{
-			"rainbow_flag",
-		},
-	},
-	{
-		emoji:       "🖌",
-		description: "paintbrush",
-		aliases: []string{
-			"paintbrush",
-		},
-	},
-	{
-		emoji:       "🖍",
-		description: "crayon",
-		aliases: []string{
-			"crayon",
-		},
-	},
-	{
-		emoji:       "🖊",
-		description: "pen",
-		aliases: []string{
-			"pen",
-		},
-	},
-	{
-		emoji:       "🖋",
-		description: "fountain pen",
-		aliases: []string{
-			"fountain_pen",
-		},
-	},
-	{
-		emoji:       "✒️",
-		description: "black nib",
-		aliases: []string{
-			"black_nib",
-		},
-	},
-	{
-		emoji:       "📝",
-		description: "memo",
-		aliases: []string{
-			"memo",
-			"pencil",
-		},
-	},
-	{
-		emoji:       "✏️",
-		description: "pencil",
-		aliases: []string{
-			"pencil2",
-		},
-	},
-	{
-		emoji:       "🔏",
-		description: "locked with pen",
-		aliases: []string{
-			"lock_with_ink_pen",
-		},
-	},
-	{
-		emoji:       "🔐",
-		description: "locked with key",
-		aliases: []string{
-			"closed_lock_with_key",
-		},
-	},
-	{
-		emoji:       "🔒",
-		description: "locked",
-		aliases: []string{
-			"lock",
-		},
-	},
-	{
-		emoji:       "🔓",
-		description: "unlocked",
-		aliases: []string{
-			"unlock",
-		},
-	},
-	{
-		emoji:       "🔍",
-		description: "left-pointing magnifying glass",
-		aliases: []string{
-			"mag",
-		},
-	},
-	{
-		emoji:       "🔎",
-		description: "right-pointing magnifying glass",
-		aliases: []string{
-			"mag_right",
-		},
-	},
-	{
-		emoji:       "❤️",
-		description: "red heart",
-		aliases: []string{
-			"heart",
-		},
-	},
-	{
-		emoji:       "💛",
-		description: "yellow heart",
-		aliases: []string{
-			"yellow_heart",
-		},
-	},
-	{
-		emoji:       "💚",
-		description: "green heart",
-		aliases: []string{
-			"green_heart",
-		},
-	},
-	{
-		emoji:       "💙",
-		description: "blue heart",
-		aliases: []string{
-			"blue_heart",
-		},
-	},
-	{
-		emoji:       "💜",
-		description: "purple heart",
-		aliases: []string{
-			"purple_heart",
-		},
-	},
-	{
-		emoji:       "💔",
-		description: "broken heart",
-		aliases: []string{
-			"broken_heart",
-		},
-	},
-	{
-		emoji:       "❣️",
-		description: "heavy heart exclamation",
-		aliases: []string{
-			"heavy_heart_exclamation",
-		},
-	},
-	{
-		emoji:       "💕",
-		description: "two hearts",
-		aliases: []string{
-			"two_hearts",
-		},
-	},
-	{
-		emoji:       "💞",
-		description: "revolving hearts",
-		aliases: []string{
-			"revolving_hearts",
-		},
-	},
-	{
-		emoji:       "💓",
-		description: "beating heart",
-		aliases: []string{
-			"heartbeat",
-		},
-	},
-	{
-		emoji:       "💗",
-		description: "growing heart",
-		aliases: []string{
-			"heartpulse",
-		},
-	},
-	{
-		emoji:       "💖",
-		description: "sparkling heart",
-		aliases: []string{
-			"sparkling_heart",
-		},
-	},
-	{
-		emoji:       "💘",
-		description: "heart with arrow",
-		aliases: []string{
-			"cupid",
-		},
-	},
-	{
-		emoji:       "💝",
-		description: "heart with ribbon",
-		aliases: []string{
-			"gift_heart",
-		},
-	},
-	{
-		emoji:       "💟",
-		description: "heart decoration",
-		aliases: []string{
-			"heart_decoration",
-		},
-	},
-	{
-		emoji:       "☮️",
-		description: "peace symbol",
-		aliases: []string{
-			"peace_symbol",
-		},
-	},
-	{
-		emoji:       "✝️",
-		description: "latin cross",
-		aliases: []string{
-			"latin_cross",
-		},
-	},
-	{
-		emoji:       "☪️",
-		description: "star and crescent",
-		aliases: []string{
-			"star_and_crescent",
-		},
-	},
-	{
-		emoji:       "🕉",
-		description: "om",
-		aliases: []string{
-			"om",
-		},
-	},
-	{
-		emoji:       "☸️",
-		description: "wheel of dharma",
-		aliases: []string{
-			"wheel_of_dharma",
-		},
-	},
-	{
-		emoji:       "✡️",
-		description: "star of David",
-		aliases: []string{
-			"star_of_david",
-		},
-	},
-	{
-		emoji:       "🔯",
-		description: "dotted six-pointed star",
-		aliases: []string{
-			"six_pointed_star",
-		},
-	},
-	{
-		emoji:       "🕎",
-		description: "menorah",
-		aliases: []string{
-			"menorah",
-		},
-	},
-	{
-		emoji:       "☯️",
-		description: "yin yang",
-		aliases: []string{
-			"yin_yang",
-		},
-	},
-	{
-		emoji:       "☦️",
-		description: "orthodox cross",
-		aliases: []string{
-			"orthodox_cross",
-		},
-	},
-	{
-		emoji:       "🛐",
-		description: "place of worship",
-		aliases: []string{
-			"place_of_worship",
-		},
-	},
-	{
-		emoji:       "⛎",
-		description: "Ophiuchus",
-		aliases: []string{
-			"ophiuchus",
-		},
-	},
-	{
-		emoji:       "♈️",
-		description: "Aries",
-		aliases: []string{
-			"aries",
-		},
-	},
-	{
-		emoji:       "♉️",
-		description: "Taurus",
-		aliases: []string{
-			"taurus",
-		},
-	},
-	{
-		emoji:       "♊️",
-		description: "Gemini",
-		aliases: []string{
-			"gemini",
-		},
-	},
-	{
-		emoji:       "♋️",
-		description: "Cancer",
-		aliases: []string{
-			"cancer",
-		},
-	},
-	{
-		emoji:       "♌️",
-		description: "Leo",
-		aliases: []string{
-			"leo",
-		},
-	},
-	{
-		emoji:       "♍️",
-		description: "Virgo",
-		aliases: []string{
-			"virgo",
-		},
-	},
-	{
-		emoji:       "♎️",
-		description: "Libra",
-		aliases: []string{
-			"libra",
-		},
-	},
-	{
-		emoji:       "♏️",
-		description: "Scorpius",
-		aliases: []string{
-			"scorpius",
-		},
-	},
-	{
-		emoji:       "♐️",
-		description: "Sagittarius",
-		aliases: []string{
-			"sagittarius",
-		},
-	},
-	{
-		emoji:       "♑️",
-		description: "Capricorn",
-		aliases: []string{
-			"capricorn",
-		},
-	},
-	{
-		emoji:       "♒️",
-		description: "Aquarius",
-		aliases: []string{
-			"aquarius",
-		},
-	},
-	{
-		emoji:       "♓️",
-		description: "Pisces",
-		aliases: []string{
-			"pisces",
-		},
-	},
-	{
-		emoji:       "🆔",
-		description: "ID button",
-		aliases: []string{
-			"id",
-		},
-	},
-	{
-		emoji:       "⚛",
-		description: "atom symbol",
-		aliases: []string{
-			"atom_symbol",
-		},
-	},
-	{
-		emoji:       "🈳",
-		description: "Japanese “vacancy” button",
-		aliases: []string{
-			"u7a7a",
-		},
-	},
-	{
-		emoji:       "🈹",
-		description: "Japanese “discount” button",
-		aliases: []string{
-			"u5272",
-		},
-	},
-	{
-		emoji:       "☢️",
-		description: "radioactive",
-		aliases: []string{
-			"radioactive",
-		},
-	},
-	{
-		emoji:       "☣️",
-		description: "biohazard",
-		aliases: []string{
-			"biohazard",
-		},
-	},
-	{
-		emoji:       "📴",
-		description: "mobile phone off",
-		aliases: []string{
-			"mobile_phone_off",
-		},
-	},
-	{
-		emoji:       "📳",
-		description: "vibration mode",
-		aliases: []string{
-			"vibration_mode",
-		},
-	},
-	{
-		emoji:       "🈶",
-		description: "Japanese “not free of charge” button",
-		aliases: []string{
-			"u6709",
-		},
-	},
-	{
-		emoji:       "🈚️",
-		description: "Japanese “free of charge” button",
-		aliases: []string{
-			"u7121",
-		},
-	},
-	{
-		emoji:       "🈸",
-		description: "Japanese “application” button",
-		aliases: []string{
-			"u7533",
-		},
-	},
-	{
-		emoji:       "🈺",
-		description: "Japanese “open for business” button",
-		aliases: []string{
-			"u55b6",
-		},
-	},
-	{
-		emoji:       "🈷️",
-		description: "Japanese “monthly amount” button",
-		aliases: []string{
-			"u6708",
-		},
-	},
-	{
-		emoji:       "✴️",
-		description: "eight-pointed star",
-		aliases: []string{
-			"eight_pointed_black_star",
-		},
-	},
-	{
-		emoji:       "🆚",
-		description: "VS button",
-		aliases: []string{
-			"vs",
-		},
-	},
-	{
-		emoji:       "🉑",
-		description: "Japanese “acceptable” button",
-		aliases: []string{
-			"accept",
-		},
-	},
-	{
-		emoji:       "💮",
-		description: "white flower",
-		aliases: []string{
-			"white_flower",
-		},
-	},
-	{
-		emoji:       "🉐",
-		description: "Japanese “bargain” button",
-		aliases: []string{
-			"ideograph_advantage",
-		},
-	},
-	{
-		emoji:       "㊙️",
-		description: "Japanese “secret” button",
-		aliases: []string{
-			"secret",
-		},
-	},
-	{
-		emoji:       "㊗️",
-		description: "Japanese “congratulations” button",
-		aliases: []string{
-			"congratulations",
-		},
-	},
-	{
-		emoji:       "🈴",
-		description: "Japanese “passing grade” button",
-		aliases: []string{
-			"u5408",
-		},
-	},
-	{
-		emoji:       "🈵",
-		description: "Japanese “no vacancy” button",
-		aliases: []string{
-			"u6e80",
-		},
-	},
-	{
-		emoji:       "🈲",
-		description: "Japanese “prohibited” button",
-		aliases: []string{
-			"u7981",
-		},
-	},
-	{
-		emoji:       "🅰️",
-		description: "A button (blood type)",
-		aliases: []string{
-			"a",
-		},
-	},
-	{
-		emoji:       "🅱️",
-		description: "B button (blood type)",
-		aliases: []string{
-			"b",
-		},
-	},
-	{
-		emoji:       "🆎",
-		description: "AB button (blood type)",
-		aliases: []string{
-			"ab",
-		},
-	},
-	{
-		emoji:       "🆑",
-		description: "CL button",
-		aliases: []string{
-			"cl",
-		},
-	},
-	{
-		emoji:       "🅾️",
-		description: "O button (blood type)",
-		aliases: []string{
-			"o2",
-		},
-	},
-	{
-		emoji:       "🆘",
-		description: "SOS button",
-		aliases: []string{
-			"sos",
-		},
-	},
-	{
-		emoji:       "⛔️",
-		description: "no entry",
-		aliases: []string{
-			"no_entry",
-		},
-	},
-	{
-		emoji:       "📛",
-		description: "name badge",
-		aliases: []string{
-			"name_badge",
-		},
-	},
-	{
-		emoji:       "🚫",
-		description: "prohibited",
-		aliases: []string{
-			"no_entry_sign",
-		},
-	},
-	{
-		emoji:       "❌",
-		description: "cross mark",
-		aliases: []string{
-			"x",
-		},
-	},
-	{
-		emoji:       "⭕️",
-		description: "heavy large circle",
-		aliases: []string{
-			"o",
-		},
-	},
-	{
-		emoji:       "💢",
-		description: "anger symbol",
-		aliases: []string{
-			"anger",
-		},
-	},
-	{
-		emoji:       "♨️",
-		description: "hot springs",
-		aliases: []string{
-			"hotsprings",
-		},
-	},
-	{
-		emoji:       "🚷",
-		description: "no pedestrians",
-		aliases: []string{
-			"no_pedestrians",
-		},
-	},
-	{
-		emoji:       "🚯",
-		description: "no littering",
-		aliases: []string{
-			"do_not_litter",
-		},
-	},
-	{
-		emoji:       "🚳",
-		description: "no bicycles",
-		aliases: []string{
-			"no_bicycles",
-		},
-	},
-	{
-		emoji:       "🚱",
-		description: "non-potable water",
-		aliases: []string{
-			"non-potable_water",
-		},
-	},
-	{
-		emoji:       "🔞",
-		description: "no one under eighteen",
-		aliases: []string{
-			"underage",
-		},
-	},
-	{
-		emoji:       "📵",
-		description: "no mobile phones",
-		aliases: []string{
-			"no_mobile_phones",
-		},
-	},
-	{
-		emoji:       "❗️",
-		description: "exclamation mark",
-		aliases: []string{
-			"exclamation",
-			"heavy_exclamation_mark",
-		},
-	},
-	{
-		emoji:       "❕",
-		description: "white exclamation mark",
-		aliases: []string{
-			"grey_exclamation",
-		},
-	},
-	{
-		emoji:       "❓",
-		description: "question mark",
-		aliases: []string{
-			"question",
-		},
-	},
-	{
-		emoji:       "❔",
-		description: "white question mark",
-		aliases: []string{
-			"grey_question",
-		},
-	},
-	{
-		emoji:       "‼️",
-		description: "double exclamation mark",
-		aliases: []string{
-			"bangbang",
-		},
-	},
-	{
-		emoji:       "⁉️",
-		description: "exclamation question mark",
-		aliases: []string{
-			"interrobang",
-		},
-	},
-	{
-		emoji:       "💯",
-		description: "hundred points",
-		aliases: []string{
-			"100",
-		},
-	},
-	{
-		emoji:       "🔅",
-		description: "dim button",
-		aliases: []string{
-			"low_brightness",
-		},
-	},
-	{
-		emoji:       "🔆",
-		description: "bright button",
-		aliases: []string{
-			"high_brightness",
-		},
-	},
-	{
-		emoji:       "🔱",
-		description: "trident emblem",
-		aliases: []string{
-			"trident",
-		},
-	},
-	{
-		emoji:       "⚜",
-		description: "fleur-de-lis",
-		aliases: []string{
-			"fleur_de_lis",
-		},
-	},
-	{
-		emoji:       "〽️",
-		description: "part alternation mark",
-		aliases: []string{
-			"part_alternation_mark",
-		},
-	},
-	{
-		emoji:       "⚠️",
-		description: "warning",
-		aliases: []string{
-			"warning",
-		},
-	},
-	{
-		emoji:       "🚸",
-		description: "children crossing",
-		aliases: []string{
-			"children_crossing",
-		},
-	},
-	{
-		emoji:       "🔰",
-		description: "Japanese symbol for beginner",
-		aliases: []string{
-			"beginner",
-		},
-	},
-	{
-		emoji:       "♻️",
-		description: "recycling symbol",
-		aliases: []string{
-			"recycle",
-		},
-	},
-	{
-		emoji:       "🈯️",
-		description: "Japanese “reserved” button",
-		aliases: []string{
-			"u6307",
-		},
-	},
-	{
-		emoji:       "💹",
-		description: "chart increasing with yen",
-		aliases: []string{
-			"chart",
-		},
-	},
-	{
-		emoji:       "❇️",
-		description: "sparkle",
-		aliases: []string{
-			"sparkle",
-		},
-	},
-	{
-		emoji:       "✳️",
-		description: "eight-spoked asterisk",
-		aliases: []string{
-			"eight_spoked_asterisk",
-		},
-	},
-	{
-		emoji:       "❎",
-		description: "cross mark button",
-		aliases: []string{
-			"negative_squared_cross_mark",
-		},
-	},
-	{
-		emoji:       "✅",
-		description: "white heavy check mark",
-		aliases: []string{
-			"white_check_mark",
-		},
-	},
-	{
-		emoji:       "🌐",
-		description: "globe with meridians",
-		aliases: []string{
-			"globe_with_meridians",
-		},
-	},
-	{
-		emoji:       "Ⓜ️",
-		description: "circled M",
-		aliases: []string{
-			"m",
-		},
-	},
-	{
-		emoji:       "💠",
-		description: "diamond with a dot",
-		aliases: []string{
-			"diamond_shape_with_a_dot_inside",
-		},
-	},
-	{
-		emoji:       "🌀",
-		description: "cyclone",
-		aliases: []string{
-			"cyclone",
-		},
-	},
-	{
-		emoji:       "➿",
-		description: "double curly loop",
-		aliases: []string{
-			"loop",
-		},
-	},
-	{
-		emoji:       "🏧",
-		description: "ATM sign",
-		aliases: []string{
-			"atm",
-		},
-	},
-	{
-		emoji:       "🈂️",
-		description: "Japanese “service charge” button",
-		aliases: []string{
-			"sa",
-		},
-	},
-	{
-		emoji:       "🛂",
-		description: "passport control",
-		aliases: []string{
-			"passport_control",
-		},
-	},
-	{
-		emoji:       "🛃",
-		description: "customs",
-		aliases: []string{
-			"customs",
-		},
-	},
-	{
-		emoji:       "🛄",
-		description: "baggage claim",
-		aliases: []string{
-			"baggage_claim",
-		},
-	},
-	{
-		emoji:       "🛅",
-		description: "left luggage",
-		aliases: []string{
-			"left_luggage",
-		},
-	},
-	{
-		emoji:       "♿️",
-		description: "wheelchair symbol",
-		aliases: []string{
-			"wheelchair",
-		},
-	},
-	{
-		emoji:       "🚭",
-		description: "no smoking",
-		aliases: []string{
-			"no_smoking",
-		},
-	},
-	{
-		emoji:       "🚾",
-		description: "water closet",
-		aliases: []string{
-			"wc",
-		},
-	},
-	{
-		emoji:       "🅿️",
-		description: "P button",
-		aliases: []string{
-			"parking",
-		},
-	},
-	{
-		emoji:       "🚰",
-		description: "potable water",
-		aliases: []string{
-			"potable_water",
-		},
-	},
-	{
-		emoji:       "🚹",
-		description: "men’s room",
-		aliases: []string{
-			"mens",
-		},
-	},
-	{
-		emoji:       "🚺",
-		description: "women’s room",
-		aliases: []string{
-			"womens",
-		},
-	},
-	{
-		emoji:       "🚼",
-		description: "baby symbol",
-		aliases: []string{
-			"baby_symbol",
-		},
-	},
-	{
-		emoji:       "🚻",
-		description: "restroom",
-		aliases: []string{
-			"restroom",
-		},
-	},
-	{
-		emoji:       "🚮",
-		description: "litter in bin sign",
-		aliases: []string{
-			"put_litter_in_its_place",
-		},
-	},
-	{
-		emoji:       "🎦",
-		description: "cinema",
-		aliases: []string{
-			"cinema",
-		},
-	},
-	{
-		emoji:       "📶",
-		description: "antenna bars",
-		aliases: []string{
-			"signal_strength",
-		},
-	},
-	{
-		emoji:       "🈁",
-		description: "Japanese “here” button",
-		aliases: []string{
-			"koko",
-		},
-	},
-	{
-		emoji:       "🔤",
-		description: "input latin letters",
-		aliases: []string{
-			"abc",
-		},
-	},
-	{
-		emoji:       "🔡",
-		description: "input latin lowercase",
-		aliases: []string{
-			"abcd",
-		},
-	},
-	{
-		emoji:       "🔠",
-		description: "input latin uppercase",
-		aliases: []string{
-			"capital_abcd",
-		},
-	},
-	{
-		emoji:       "🔣",
-		description: "input symbols",
-		aliases: []string{
-			"symbols",
-		},
-	},
-	{
-		emoji:       "ℹ️",
-		description: "information",
-		aliases: []string{
-			"information_source",
-		},
-	},
-	{
-		emoji:       "🆖",
-		description: "NG button",
-		aliases: []string{
-			"ng",
-		},
-	},
-	{
-		emoji:       "🆗",
-		description: "OK button",
-		aliases: []string{
-			"ok",
-		},
-	},
-	{
-		emoji:       "🆙",
-		description: "UP! button",
-		aliases: []string{
-			"up",
-		},
-	},
-	{
-		emoji:       "🆒",
-		description: "COOL button",
-		aliases: []string{
-			"cool",
-		},
-	},
-	{
-		emoji:       "🆕",
-		description: "NEW button",
-		aliases: []string{
-			"new",
-		},
-	},
-	{
-		emoji:       "🆓",
-		description: "FREE button",
-		aliases: []string{
-			"free",
-		},
-	},
-	{
-		emoji:       "0️⃣",
-		description: "keycap: 0",
-		aliases: []string{
-			"zero",
-		},
-	},
-	{
-		emoji:       "1️⃣",
-		description: "keycap: 1",
-		aliases: []string{
-			"one",
-		},
-	},
-	{
-		emoji:       "2️⃣",
-		description: "keycap: 2",
-		aliases: []string{
-			"two",
-		},
-	},
-	{
-		emoji:       "3️⃣",
-		description: "keycap: 3",
-		aliases: []string{
-			"three",
-		},
-	},
-	{
-		emoji:       "4️⃣",
-		description: "keycap: 4",
-		aliases: []string{
-			"four",
-		},
-	},
-	{
-		emoji:       "5️⃣",
-		description: "keycap: 5",
-		aliases: []string{
-			"five",
-		},
-	},
-	{
-		emoji:       "6️⃣",
-		description: "keycap: 6",
-		aliases: []string{
-			"six",
-		},
-	},
-	{
-		emoji:       "7️⃣",
-		description: "keycap: 7",
-		aliases: []string{
-			"seven",
-		},
-	},
-	{
-		emoji:       "8️⃣",
-		description: "keycap: 8",
-		aliases: []string{
-			"eight",
-		},
-	},
-	{
-		emoji:       "9️⃣",
-		description: "keycap: 9",
-		aliases: []string{
-			"nine",
-		},
-	},
-	{
-		emoji:       "🔟",
-		description: "keycap 10",
-		aliases: []string{
-			"keycap_ten",
-		},
-	},
-	{
-		emoji:       "🔢",
-		description: "input numbers",
-		aliases: []string{
-			"1234",
-		},
-	},
-	{
-		emoji:       "#️⃣",
-		description: "keycap: #",
-		aliases: []string{
-			"hash",
-		},
-	},
-	{
-		emoji:       "*️⃣",
-		description: "keycap: *",
-		aliases: []string{
-			"asterisk",
-		},
-	},
-	{
-		emoji:       "▶️",
-		description: "play button",
-		aliases: []string{
-			"arrow_forward",
-		},
-	},
-	{
-		emoji:       "⏸",
-		description: "pause button",
-		aliases: []string{
-			"pause_button",
-		},
-	},
-	{
-		emoji:       "⏯",
-		description: "play or pause button",
-		aliases: []string{
-			"play_or_pause_button",
-		},
-	},
-	{
-		emoji:       "⏹",
-		description: "stop button",
-		aliases: []string{
-			"stop_button",
-		},
-	},
-	{
-		emoji:       "⏺",
-		description: "record button",
-		aliases: []string{
-			"record_button",
-		},
-	},
-	{
-		emoji:       "⏭",
-		description: "next track button",
-		aliases: []string{
-			"next_track_button",
-		},
-	},
-	{
-		emoji:       "⏮",
-		description: "last track button",
-		aliases: []string{
-			"previous_track_button",
-		},
-	},
-	{
-		emoji:       "⏩",
-		description: "fast-forward button",
-		aliases: []string{
-			"fast_forward",
-		},
-	},
-	{
-		emoji:       "⏪",
-		description: "fast reverse button",
-		aliases: []string{
-			"rewind",
-		},
-	},
-	{
-		emoji:       "⏫",
-		description: "fast up button",
-		aliases: []string{
-			"arrow_double_up",
-		},
-	},
-	{
-		emoji:       "⏬",
-		description: "fast down button",
-		aliases: []string{
-			"arrow_double_down",
-		},
-	},
-	{
-		emoji:       "◀️",
-		description: "reverse button",
-		aliases: []string{
-			"arrow_backward",
-		},
-	},
-	{
-		emoji:       "🔼",
-		description: "up button",
-		aliases: []string{
-			"arrow_up_small",
-		},
-	},
-	{
-		emoji:       "🔽",
-		description: "down button",
-		aliases: []string{
-			"arrow_down_small",
-		},
-	},
-	{
-		emoji:       "➡️",
-		description: "right arrow",
-		aliases: []string{
-			"arrow_right",
-		},
-	},
-	{
-		emoji:       "⬅️",
-		description: "left arrow",
-		aliases: []string{
-			"arrow_left",
-		},
-	},
-	{
-		emoji:       "⬆️",
-		description: "up arrow",
-		aliases: []string{
-			"arrow_up",
-		},
-	},
-	{
-		emoji:       "⬇️",
-		description: "down arrow",
-		aliases: []string{
-			"arrow_down",
-		},
-	},
-	{
-		emoji:       "↗️",
-		description: "up-right arrow",
-		aliases: []string{
-			"arrow_upper_right",
-		},
-	},
-	{
-		emoji:       "↘️",
-		description: "down-right arrow",
-		aliases: []string{
-			"arrow_lower_right",
-		},
-	},
-	{
-		emoji:       "↙️",
-		description: "down-left arrow",
-		aliases: []string{
-			"arrow_lower_left",
-		},
-	},
-	{
-		emoji:       "↖️",
-		description: "up-left arrow",
-		aliases: []string{
-			"arrow_upper_left",
-		},
-	},
-	{
-		emoji:       "↕️",
-		description: "up-down arrow",
-		aliases: []string{
-			"arrow_up_down",
-		},
-	},
-	{
-		emoji:       "↔️",
-		description: "left-right arrow",
-		aliases: []string{
-			"left_right_arrow",
-		},
-	},
-	{
-		emoji:       "↪️",
-		description: "left arrow curving right",
-		aliases: []string{
-			"arrow_right_hook",
-		},
-	},
-	{
-		emoji:       "↩️",
-		description: "right arrow curving left",
-		aliases: []string{
-			"leftwards_arrow_with_hook",
-		},
-	},
-	{
-		emoji:       "⤴️",
-		description: "right arrow curving up",
-		aliases: []string{
-			"arrow_heading_up",
-		},
-	},
-	{
-		emoji:       "⤵️",
-		description: "right arrow curving down",
-		aliases: []string{
-			"arrow_heading_down",
-		},
-	},
-	{
-		emoji:       "🔀",
-		description: "shuffle tracks button",
-		aliases: []string{
-			"twisted_rightwards_arrows",
-		},
-	},
-	{
-		emoji:       "🔁",
-		description: "repeat button",
-		aliases: []string{
-			"repeat",
-		},
-	},
-	{
-		emoji:       "🔂",
-		description: "repeat single button",
-		aliases: []string{
-			"repeat_one",
-		},
-	},
-	{
-		emoji:       "🔄",
-		description: "anticlockwise arrows button",
-		aliases: []string{
-			"arrows_counterclockwise",
-		},
-	},
-	{
-		emoji:       "🔃",
-		description: "clockwise vertical arrows",
-		aliases: []string{
-			"arrows_clockwise",
-		},
-	},
-	{
-		emoji:       "🎵",
-		description: "musical note",
-		aliases: []string{
-			"musical_note",
-		},
-	},
-	{
-		emoji:       "🎶",
-		description: "musical notes",
-		aliases: []string{
-			"notes",
-		},
-	},
-	{
-		emoji:       "〰️",
-		description: "wavy dash",
-		aliases: []string{
-			"wavy_dash",
-		},
-	},
-	{
-		emoji:       "➰",
-		description: "curly loop",
-		aliases: []string{
-			"curly_loop",
-		},
-	},
-	{
-		emoji:       "✔️",
-		description: "heavy check mark",
-		aliases: []string{
-			"heavy_check_mark",
-		},
-	},
-	{
-		emoji:       "➕",
-		description: "heavy plus sign",
-		aliases: []string{
-			"heavy_plus_sign",
-		},
-	},
-	{
-		emoji:       "➖",
-		description: "heavy minus sign",
-		aliases: []string{
-			"heavy_minus_sign",
-		},
-	},
-	{
-		emoji:       "➗",
-		description: "heavy division sign",
-		aliases: []string{
-			"heavy_division_sign",
-		},
-	},
-	{
-		emoji:       "✖️",
-		description: "heavy multiplication x",
-		aliases: []string{
-			"heavy_multiplication_x",
-		},
-	},
-	{
-		emoji:       "💲",
-		description: "heavy dollar sign",
-		aliases: []string{
-			"heavy_dollar_sign",
-		},
-	},
-	{
-		emoji:       "💱",
-		description: "currency exchange",
-		aliases: []string{
-			"currency_exchange",
-		},
-	},
-	{
-		emoji:       "™️",
-		description: "trade mark",
-		aliases: []string{
-			"tm",
-		},
-	},
-	{
-		emoji:       "©️",
-		description: "copyright",
-		aliases: []string{
-			"copyright",
-		},
-	},
-	{
-		emoji:       "®️",
-		description: "registered",
-		aliases: []string{
-			"registered",
-		},
-	},
-	{
-		emoji:       "🔚",
-		description: "END arrow",
-		aliases: []string{
-			"end",
-		},
-	},
-	{
-		emoji:       "🔙",
-		description: "BACK arrow",
-		aliases: []string{
-			"back",
-		},
-	},
-	{
-		emoji:       "🔛",
-		description: "ON! arrow",
-		aliases: []string{
-			"on",
-		},
-	},
-	{
-		emoji:       "🔝",
-		description: "TOP arrow",
-		aliases: []string{
-			"top",
-		},
-	},
-	{
-		emoji:       "🔜",
-		description: "SOON arrow",
-		aliases: []string{
-			"soon",
-		},
-	},
-	{
-		emoji:       "☑️",
-		description: "ballot box with check",
-		aliases: []string{
-			"ballot_box_with_check",
-		},
-	},
-	{
-		emoji:       "🔘",
-		description: "radio button",
-		aliases: []string{
-			"radio_button",
-		},
-	},
-	{
-		emoji:       "⚪️",
-		description: "white circle",
-		aliases: []string{
-			"white_circle",
-		},
-	},
-	{
-		emoji:       "⚫️",
-		description: "black circle",
-		aliases: []string{
-			"black_circle",
-		},
-	},
-	{
-		emoji:       "🔴",
-		description: "red circle",
-		aliases: []string{
-			"red_circle",
-		},
-	},
-	{
-		emoji:       "🔵",
-		description: "blue circle",
-		aliases: []string{
-			"large_blue_circle",
-		},
-	},
-	{
-		emoji:       "🔺",
-		description: "red triangle pointed up",
-		aliases: []string{
-			"small_red_triangle",
-		},
-	},
-	{
-		emoji:       "🔻",
-		description: "red triangle pointed down",
-		aliases: []string{
-			"small_red_triangle_down",
-		},
-	},
-	{
-		emoji:       "🔸",
-		description: "small orange diamond",
-		aliases: []string{
-			"small_orange_diamond",
-		},
-	},
-	{
-		emoji:       "🔹",
-		description: "small blue diamond",
-		aliases: []string{
-			"small_blue_diamond",
-		},
-	},
-	{
-		emoji:       "🔶",
-		description: "large orange diamond",
-		aliases: []string{
-			"large_orange_diamond",
-		},
-	},
-	{
-		emoji:       "🔷",
-		description: "large blue diamond",
-		aliases: []string{
-			"large_blue_diamond",
-		},
-	},
-	{
-		emoji:       "🔳",
-		description: "white square button",
-		aliases: []string{
-			"white_square_button",
-		},
-	},
-	{
-		emoji:       "🔲",
-		description: "black square button",
-		aliases: []string{
-			"black_square_button",
-		},
-	},
-	{
-		emoji:       "▪️",
-		description: "black small square",
-		aliases: []string{
-			"black_small_square",
-		},
-	},
-	{
-		emoji:       "▫️",
-		description: "white small square",
-		aliases: []string{
-			"white_small_square",
-		},
-	},
-	{
-		emoji:       "◾️",
-		description: "black medium-small square",
-		aliases: []string{
-			"black_medium_small_square",
-		},
-	},
-	{
-		emoji:       "◽️",
-		description: "white medium-small square",
-		aliases: []string{
-			"white_medium_small_square",
-		},
-	},
-	{
-		emoji:       "◼️",
-		description: "black medium square",
-		aliases: []string{
-			"black_medium_square",
-		},
-	},
-	{
-		emoji:       "◻️",
-		description: "white medium square",
-		aliases: []string{
-			"white_medium_square",
-		},
-	},
-	{
-		emoji:       "⬛️",
-		description: "black large square",
-		aliases: []string{
-			"black_large_square",
-		},
-	},
-	{
-		emoji:       "⬜️",
-		description: "white large square",
-		aliases: []string{
-			"white_large_square",
-		},
-	},
-	{
-		emoji:       "🔇",
-		description: "muted speaker",
-		aliases: []string{
-			"mute",
-		},
-	},
-	{
-		emoji:       "🔈",
-		description: "speaker low volume",
-		aliases: []string{
-			"speaker",
-		},
-	},
-	{
-		emoji:       "🔉",
-		description: "speaker medium volume",
-		aliases: []string{
-			"sound",
-		},
-	},
-	{
-		emoji:       "🔊",
-		description: "speaker high volume",
-		aliases: []string{
-			"loud_sound",
-		},
-	},
-	{
-		emoji:       "🔕",
-		description: "bell with slash",
-		aliases: []string{
-			"no_bell",
-		},
-	},
-	{
-		emoji:       "🔔",
-		description: "bell",
-		aliases: []string{
-			"bell",
-		},
-	},
-	{
-		emoji:       "📣",
-		description: "megaphone",
-		aliases: []string{
-			"mega",
-		},
-	},
-	{
-		emoji:       "📢",
-		description: "loudspeaker",
-		aliases: []string{
-			"loudspeaker",
-		},
-	},
-	{
-		emoji:       "👁\u200d🗨",
-		description: "eye in speech bubble",
-		aliases: []string{
-			"eye_speech_bubble",
-		},
-	},
-	{
-		emoji:       "💬",
-		description: "speech balloon",
-		aliases: []string{
-			"speech_balloon",
-		},
-	},
-	{
-		emoji:       "💭",
-		description: "thought balloon",
-		aliases: []string{
-			"thought_balloon",
-		},
-	},
-	{
-		emoji:       "🗯",
-		description: "right anger bubble",
-		aliases: []string{
-			"right_anger_bubble",
-		},
-	},
-	{
-		emoji:       "🃏",
-		description: "joker",
-		aliases: []string{
-			"black_joker",
-		},
-	},
-	{
-		emoji:       "🀄️",
-		description: "mahjong red dragon",
-		aliases: []string{
-			"mahjong",
-		},
-	},
-	{
-		emoji:       "🎴",
-		description: "flower playing cards",
-		aliases: []string{
-			"flower_playing_cards",
-		},
-	},
-	{
-		emoji:       "♠️",
-		description: "spade suit",
-		aliases: []string{
-			"spades",
-		},
-	},
-	{
-		emoji:       "♣️",
-		description: "club suit",
-		aliases: []string{
-			"clubs",
-		},
-	},
-	{
-		emoji:       "♥️",
-		description: "heart suit",
-		aliases: []string{
-			"hearts",
-		},
-	},
-	{
-		emoji:       "♦️",
-		description: "diamond suit",
-		aliases: []string{
-			"diamonds",
-		},
-	},
-	{
-		emoji:       "🕐",
-		description: "one o’clock",
-		aliases: []string{
-			"clock1",
-		},
-	},
-	{
-		emoji:       "🕑",
-		description: "two o’clock",
-		aliases: []string{
-			"clock2",
-		},
-	},
-	{
-		emoji:       "🕒",
-		description: "three o’clock",
-		aliases: []string{
-			"clock3",
-		},
-	},
-	{
-		emoji:       "🕓",
-		description: "four o’clock",
-		aliases: []string{
-			"clock4",
-		},
-	},
-	{
-		emoji:       "🕔",
-		description: "five o’clock",
-		aliases: []string{
-			"clock5",
-		},
-	},
-	{
-		emoji:       "🕕",
-		description: "six o’clock",
-		aliases: []string{
-			"clock6",
-		},
-	},
-	{
-		emoji:       "🕖",
-		description: "seven o’clock",
-		aliases: []string{
-			"clock7",
-		},
-	},
-	{
-		emoji:       "🕗",
-		description: "eight o’clock",
-		aliases: []string{
-			"clock8",
-		},
-	},
-	{
-		emoji:       "🕘",
-		description: "nine o’clock",
-		aliases: []string{
-			"clock9",
-		},
-	},
-	{
-		emoji:       "🕙",
-		description: "ten o’clock",
-		aliases: []string{
-			"clock10",
-		},
-	},
-	{
-		emoji:       "🕚",
-		description: "eleven o’clock",
-		aliases: []string{
-			"clock11",
-		},
-	},
-	{
-		emoji:       "🕛",
-		description: "twelve o’clock",
-		aliases: []string{
-			"clock12",
-		},
-	},
-	{
-		emoji:       "🕜",
-		description: "one-thirty",
-		aliases: []string{
-			"clock130",
-		},
-	},
-	{
-		emoji:       "🕝",
-		description: "two-thirty",
-		aliases: []string{
-			"clock230",
-		},
-	},
-	{
-		emoji:       "🕞",
-		description: "three-thirty",
-		aliases: []string{
-			"clock330",
-		},
-	},
-	{
-		emoji:       "🕟",
-		description: "four-thirty",
-		aliases: []string{
-			"clock430",
-		},
-	},
-	{
-		emoji:       "🕠",
-		description: "five-thirty",
-		aliases: []string{
-			"clock530",
-		},
-	},
-	{
-		emoji:       "🕡",
-		description: "six-thirty",
-		aliases: []string{
-			"clock630",
-		},
-	},
-	{
-		emoji:       "🕢",
-		description: "seven-thirty",
-		aliases: []string{
-			"clock730",
-		},
-	},
-	{
-		emoji:       "🕣",
-		description: "eight-thirty",
-		aliases: []string{
-			"clock830",
-		},
-	},
-	{
-		emoji:       "🕤",
-		description: "nine-thirty",
-		aliases: []string{
-			"clock930",
-		},
-	},
-	{
-		emoji:       "🕥",
-		description: "ten-thirty",
-		aliases: []string{
-			"clock1030",
-		},
-	},
-	{
-		emoji:       "🕦",
-		description: "eleven-thirty",
-		aliases: []string{
-			"clock1130",
-		},
-	},
-	{
-		emoji:       "🕧",
-		description: "twelve-thirty",
-		aliases: []string{
-			"clock1230",
-		},
-	},
-	{
-		emoji:       "🇦🇫",
-		description: "Afghanistan",
-		aliases: []string{
-			"afghanistan",
-		},
-	},
-	{
-		emoji:       "🇦🇽",
-		description: "Åland Islands",
-		aliases: []string{
-			"aland_islands",
-		},
-	},
-	{
-		emoji:       "🇦🇱",
-		description: "Albania",
-		aliases: []string{
-			"albania",
-		},
-	},
-	{
-		emoji:       "🇩🇿",
-		description: "Algeria",
-		aliases: []string{
-			"algeria",
-		},
-	},
-	{
-		emoji:       "🇦🇸",
-		description: "American Samoa",
-		aliases: []string{
-			"american_samoa",
-		},
-	},
-	{
-		emoji:       "🇦🇩",
-		description: "Andorra",
-		aliases: []string{
-			"andorra",
-		},
-	},
-	{
-		emoji:       "🇦🇴",
-		description: "Angola",
-		aliases: []string{
-			"angola",
-		},
-	},
-	{
-		emoji:       "🇦🇮",
-		description: "Anguilla",
-		aliases: []string{
-			"anguilla",
-		},
-	},
-	{
-		emoji:       "🇦🇶",
-		description: "Antarctica",
-		aliases: []string{
-			"antarctica",
-		},
-	},
-	{
-		emoji:       "🇦🇬",
-		description: "Antigua & Barbuda",
-		aliases: []string{
-			"antigua_barbuda",
-		},
-	},
-	{
-		emoji:       "🇦🇷",
-		description: "Argentina",
-		aliases: []string{
-			"argentina",
-		},
-	},
-	{
-		emoji:       "🇦🇲",
-		description: "Armenia",
-		aliases: []string{
-			"armenia",
-		},
-	},
-	{
-		emoji:       "🇦🇼",
-		description: "Aruba",
-		aliases: []string{
-			"aruba",
-		},
-	},
-	{
-		emoji:       "🇦🇺",
-		description: "Australia",
-		aliases: []string{
-			"australia",
-		},
-	},
-	{
-		emoji:       "🇦🇹",
-		description: "Austria",
-		aliases: []string{
-			"austria",
-		},
-	},
-	{
-		emoji:       "🇦🇿",
-		description: "Azerbaijan",
-		aliases: []string{
-			"azerbaijan",
-		},
-	},
-	{
-		emoji:       "🇧🇸",
-		description: "Bahamas",
-		aliases: []string{
-			"bahamas",
-		},
-	},
-	{
-		emoji:       "🇧🇭",
-		description: "Bahrain",
-		aliases: []string{
-			"bahrain",
-		},
-	},
-	{
-		emoji:       "🇧🇩",
-		description: "Bangladesh",
-		aliases: []string{
-			"bangladesh",
-		},
-	},
-	{
-		emoji:       "🇧🇧",
-		description: "Barbados",
-		aliases: []string{
-			"barbados",
-		},
-	},
-	{
-		emoji:       "🇧🇾",
-		description: "Belarus",
-		aliases: []string{
-			"belarus",
-		},
-	},
-	{
-		emoji:       "🇧🇪",
-		description: "Belgium",
-		aliases: []string{
-			"belgium",
-		},
-	},
-	{
-		emoji:       "🇧🇿",
-		description: "Belize",
-		aliases: []string{
-			"belize",
-		},
-	},
-	{
-		emoji:       "🇧🇯",
-		description: "Benin",
-		aliases: []string{
-			"benin",
-		},
-	},
-	{
-		emoji:       "🇧🇲",
-		description: "Bermuda",
-		aliases: []string{
-			"bermuda",
-		},
-	},
-	{
-		emoji:       "🇧🇹",
-		description: "Bhutan",
-		aliases: []string{
-			"bhutan",
-		},
-	},
-	{
-		emoji:       "🇧🇴",
-		description: "Bolivia",
-		aliases: []string{
-			"bolivia",
-		},
-	},
-	{
-		emoji:       "🇧🇶",
-		description: "Caribbean Netherlands",
-		aliases: []string{
-			"caribbean_netherlands",
-		},
-	},
-	{
-		emoji:       "🇧🇦",
-		description: "Bosnia & Herzegovina",
-		aliases: []string{
-			"bosnia_herzegovina",
-		},
-	},
-	{
-		emoji:       "🇧🇼",
-		description: "Botswana",
-		aliases: []string{
-			"botswana",
-		},
-	},
-	{
-		emoji:       "🇧🇷",
-		description: "Brazil",
-		aliases: []string{
-			"brazil",
-		},
-	},
-	{
-		emoji:       "🇮🇴",
-		description: "British Indian Ocean Territory",
-		aliases: []string{
-			"british_indian_ocean_territory",
-		},
-	},
-	{
-		emoji:       "🇻🇬",
-		description: "British Virgin Islands",
-		aliases: []string{
-			"british_virgin_islands",
-		},
-	},
-	{
-		emoji:       "🇧🇳",
-		description: "Brunei",
-		aliases: []string{
-			"brunei",
-		},
-	},
-	{
-		emoji:       "🇧🇬",
-		description: "Bulgaria",
-		aliases: []string{
-			"bulgaria",
-		},
-	},
-	{
-		emoji:       "🇧🇫",
-		description: "Burkina Faso",
-		aliases: []string{
-			"burkina_faso",
-		},
-	},
-	{
-		emoji:       "🇧🇮",
-		description: "Burundi",
-		aliases: []string{
-			"burundi",
-		},
-	},
-	{
-		emoji:       "🇨🇻",
-		description: "Cape Verde",
-		aliases: []string{
-			"cape_verde",
-		},
-	},
-	{
-		emoji:       "🇰🇭",
-		description: "Cambodia",
-		aliases: []string{
-			"cambodia",
-		},
-	},
-	{
-		emoji:       "🇨🇲",
-		description: "Cameroon",
-		aliases: []string{
-			"cameroon",
-		},
-	},
-	{
-		emoji:       "🇨🇦",
-		description: "Canada",
-		aliases: []string{
-			"canada",
-		},
-	},
-	{
-		emoji:       "🇮🇨",
-		description: "Canary Islands",
-		aliases: []string{
-			"canary_islands",
-		},
-	},
-	{
-		emoji:       "🇰🇾",
-		description: "Cayman Islands",
-		aliases: []string{
-			"cayman_islands",
-		},
-	},
-	{
-		emoji:       "🇨🇫",
-		description: "Central African Republic",
-		aliases: []string{
-			"central_african_republic",
-		},
-	},
-	{
-		emoji:       "🇹🇩",
-		description: "Chad",
-		aliases: []string{
-			"chad",
-		},
-	},
-	{
-		emoji:       "🇨🇱",
-		description: "Chile",
-		aliases: []string{
-			"chile",
-		},
-	},
-	{
-		emoji:       "🇨🇳",
-		description: "China",
-		aliases: []string{
-			"cn",
-		},
-	},
-	{
-		emoji:       "🇨🇽",
-		description: "Christmas Island",
-		aliases: []string{
-			"christmas_island",
-		},
-	},
-	{
-		emoji:       "🇨🇨",
-		description: "Cocos (Keeling) Islands",
-		aliases: []string{
-			"cocos_islands",
-		},
-	},
-	{
-		emoji:       "🇨🇴",
-		description: "Colombia",
-		aliases: []string{
-			"colombia",
-		},
-	},
-	{
-		emoji:       "🇰🇲",
-		description: "Comoros",
-		aliases: []string{
-			"comoros",
-		},
-	},
-	{
-		emoji:       "🇨🇬",
-		description: "Congo - Brazzaville",
-		aliases: []string{
-			"congo_brazzaville",
-		},
-	},
-	{
-		emoji:       "🇨🇩",
-		description: "Congo - Kinshasa",
-		aliases: []string{
-			"congo_kinshasa",
-		},
-	},
-	{
-		emoji:       "🇨🇰",
-		description: "Cook Islands",
-		aliases: []string{
-			"cook_islands",
-		},
-	},
-	{
-		emoji:       "🇨🇷",
-		description: "Costa Rica",
-		aliases: []string{
-			"costa_rica",
-		},
-	},
-	{
-		emoji:       "🇭🇷",
-		description: "Croatia",
-		aliases: []string{
-			"croatia",
-		},
-	},
-	{
-		emoji:       "🇨🇺",
-		description: "Cuba",
-		aliases: []string{
-			"cuba",
-		},
-	},
-	{
-		emoji:       "🇨🇼",
-		description: "Curaçao",
-		aliases: []string{
-			"curacao",
-		},
-	},
-	{
-		emoji:       "🇨🇾",
-		description: "Cyprus",
-		aliases: []string{
-			"cyprus",
-		},
-	},
-	{
-		emoji:       "🇨🇿",
-		description: "Czech Republic",
-		aliases: []string{
-			"czech_republic",
-		},
-	},
-	{
-		emoji:       "🇩🇰",
-		description: "Denmark",
-		aliases: []string{
-			"denmark",
-		},
-	},
-	{
-		emoji:       "🇩🇯",
-		description: "Djibouti",
-		aliases: []string{
-			"djibouti",
-		},
-	},
-	{
-		emoji:       "🇩🇲",
-		description: "Dominica",
-		aliases: []string{
-			"dominica",
-		},
-	},
-	{
-		emoji:       "🇩🇴",
-		description: "Dominican Republic",
-		aliases: []string{
-			"dominican_republic",
-		},
-	},
-	{
-		emoji:       "🇪🇨",
-		description: "Ecuador",
-		aliases: []string{
-			"ecuador",
-		},
-	},
-	{
-		emoji:       "🇪🇬",
-		description: "Egypt",
-		aliases: []string{
-			"egypt",
-		},
-	},
-	{
-		emoji:       "🇸🇻",
-		description: "El Salvador",
-		aliases: []string{
-			"el_salvador",
-		},
-	},
-	{
-		emoji:       "🇬🇶",
-		description: "Equatorial Guinea",
-		aliases: []string{
-			"equatorial_guinea",
-		},
-	},
-	{
-		emoji:       "🇪🇷",
-		description: "Eritrea",
-		aliases: []string{
-			"eritrea",
-		},
-	},
-	{
-		emoji:       "🇪🇪",
-		description: "Estonia",
-		aliases: []string{
-			"estonia",
-		},
-	},
-	{
-		emoji:       "🇪🇹",
-		description: "Ethiopia",
-		aliases: []string{
-			"ethiopia",
-		},
-	},
-	{
-		emoji:       "🇪🇺",
-		description: "European Union",
-		aliases: []string{
-			"eu",
-			"european_union",
-		},
-	},
-	{
-		emoji:       "🇫🇰",
-		description: "Falkland Islands",
-		aliases: []string{
-			"falkland_islands",
-		},
-	},
-	{
-		emoji:       "🇫🇴",
-		description: "Faroe Islands",
-		aliases: []string{
-			"faroe_islands",
-		},
-	},
-	{
-		emoji:       "🇫🇯",
-		description: "Fiji",
-		aliases: []string{
-			"fiji",
-		},
-	},
-	{
-		emoji:       "🇫🇮",
-		description: "Finland",
-		aliases: []string{
-			"finland",
-		},
-	},
-	{
-		emoji:       "🇫🇷",
-		description: "France",
-		aliases: []string{
-			"fr",
-		},
-	},
-	{
-		emoji:       "🇬🇫",
-		description: "French Guiana",
-		aliases: []string{
-			"french_guiana",
-		},
-	},
-	{
-		emoji:       "🇵🇫",
-		description: "French Polynesia",
-		aliases: []string{
-			"french_polynesia",
-		},
-	},
-	{
-		emoji:       "🇹🇫",
-		description: "French Southern Territories",
-		aliases: []string{
-			"french_southern_territories",
-		},
-	},
-	{
-		emoji:       "🇬🇦",
-		description: "Gabon",
-		aliases: []string{
-			"gabon",
-		},
-	},
-	{
-		emoji:       "🇬🇲",
-		description: "Gambia",
-		aliases: []string{
-			"gambia",
-		},
-	},
-	{
-		emoji:       "🇬🇪",
-		description: "Georgia",
-		aliases: []string{
-			"georgia",
-		},
-	},
-	{
-		emoji:       "🇩🇪",
-		description: "Germany",
-		aliases: []string{
-			"de",
-		},
-	},
-	{
-		emoji:       "🇬🇭",
-		description: "Ghana",
-		aliases: []string{
-			"ghana",
-		},
-	},
-	{
-		emoji:       "🇬🇮",
-		description: "Gibraltar",
-		aliases: []string{
-			"gibraltar",
-		},
-	},
-	{
-		emoji:       "🇬🇷",
-		description: "Greece",
-		aliases: []string{
-			"greece",
-		},
-	},
-	{
-		emoji:       "🇬🇱",
-		description: "Greenland",
-		aliases: []string{
-			"greenland",
-		},
-	},
-	{
-		emoji:       "🇬🇩",
-		description: "Grenada",
-		aliases: []string{
-			"grenada",
-		},
-	},
-	{
-		emoji:       "🇬🇵",
-		description: "Guadeloupe",
-		aliases: []string{
-			"guadeloupe",
-		},
-	},
-	{
-		emoji:       "🇬🇺",
-		description: "Guam",
-		aliases: []string{
-			"guam",
-		},
-	},
-	{
-		emoji:       "🇬🇹",
-		description: "Guatemala",
-		aliases: []string{
-			"guatemala",
-		},
-	},
-	{
-		emoji:       "🇬🇬",
-		description: "Guernsey",
-		aliases: []string{
-			"guernsey",
-		},
-	},
-	{
-		emoji:       "🇬🇳",
-		description: "Guinea",
-		aliases: []string{
-			"guinea",
-		},
-	},
-	{
-		emoji:       "🇬🇼",
-		description: "Guinea-Bissau",
-		aliases: []string{
-			"guinea_bissau",
-		},
-	},
-	{
-		emoji:       "🇬🇾",
-		description: "Guyana",
-		aliases: []string{
-			"guyana",
-		},
-	},
-	{
-		emoji:       "🇭🇹",
-		description: "Haiti",
-		aliases: []string{
-			"haiti",
-		},
-	},
-	{
-		emoji:       "🇭🇳",
-		description: "Honduras",
-		aliases: []string{
-			"honduras",
-		},
-	},
-	{
-		emoji:       "🇭🇰",
-		description: "Hong Kong SAR China",
-		aliases: []string{
-			"hong_kong",
-		},
-	},
-	{
-		emoji:       "🇭🇺",
-		description: "Hungary",
-		aliases: []string{
-			"hungary",
-		},
-	},
-	{
-		emoji:       "🇮🇸",
-		description: "Iceland",
-		aliases: []string{
-			"iceland",
-		},
-	},
-	{
-		emoji:       "🇮🇳",
-		description: "India",
-		aliases: []string{
-			"india",
-		},
-	},
-	{
-		emoji:       "🇮🇩",
-		description: "Indonesia",
-		aliases: []string{
-			"indonesia",
-		},
-	},
-	{
-		emoji:       "🇮🇷",
-		description: "Iran",
-		aliases: []string{
-			"iran",
-		},
-	},
-	{
-		emoji:       "🇮🇶",
-		description: "Iraq",
-		aliases: []string{
-			"iraq",
-		},
-	},
-	{
-		emoji:       "🇮🇪",
-		description: "Ireland",
-		aliases: []string{
-			"ireland",
-		},
-	},
-	{
-		emoji:       "🇮🇲",
-		description: "Isle of Man",
-		aliases: []string{
-			"isle_of_man",
-		},
-	},
-	{
-		emoji:       "🇮🇱",
-		description: "Israel",
-		aliases: []string{
-			"israel",
-		},
-	},
-	{
-		emoji:       "🇮🇹",
-		description: "Italy",
-		aliases: []string{
-			"it",
-		},
-	},
-	{
-		emoji:       "🇨🇮",
-		description: "Côte d’Ivoire",
-		aliases: []string{
-			"cote_divoire",
-		},
-	},
-	{
-		emoji:       "🇯🇲",
-		description: "Jamaica",
-		aliases: []string{
-			"jamaica",
-		},
-	},
-	{
-		emoji:       "🇯🇵",
-		description: "Japan",
-		aliases: []string{
-			"jp",
-		},
-	},
-	{
-		emoji:       "🇯🇪",
-		description: "Jersey",
-		aliases: []string{
-			"jersey",
-		},
-	},
-	{
-		emoji:       "🇯🇴",
-		description: "Jordan",
-		aliases: []string{
-			"jordan",
-		},
-	},
-	{
-		emoji:       "🇰🇿",
-		description: "Kazakhstan",
-		aliases: []string{
-			"kazakhstan",
-		},
-	},
-	{
-		emoji:       "🇰🇪",
-		description: "Kenya",
-		aliases: []string{
-			"kenya",
-		},
-	},
-	{
-		emoji:       "🇰🇮",
-		description: "Kiribati",
-		aliases: []string{
-			"kiribati",
-		},
-	},
-	{
-		emoji:       "🇽🇰",
-		description: "Kosovo",
-		aliases: []string{
-			"kosovo",
-		},
-	},
-	{
-		emoji:       "🇰🇼",
-		description: "Kuwait",
-		aliases: []string{
-			"kuwait",
-		},
-	},
-	{
-		emoji:       "🇰🇬",
-		description: "Kyrgyzstan",
-		aliases: []string{
-			"kyrgyzstan",
-		},
-	},
-	{
-		emoji:       "🇱🇦",
-		description: "Laos",
-		aliases: []string{
-			"laos",
-		},
-	},
-	{
-		emoji:       "🇱🇻",
-		description: "Latvia",
-		aliases: []string{
-			"latvia",
-		},
-	},
-	{
-		emoji:       "🇱🇧",
-		description: "Lebanon",
-		aliases: []string{
-			"lebanon",
-		},
-	},
-	{
-		emoji:       "🇱🇸",
-		description: "Lesotho",
-		aliases: []string{
-			"lesotho",
-		},
-	},
-	{
-		emoji:       "🇱🇷",
-		description: "Liberia",
-		aliases: []string{
-			"liberia",
-		},
-	},
-	{
-		emoji:       "🇱🇾",
-		description: "Libya",
-		aliases: []string{
-			"libya",
-		},
-	},
-	{
-		emoji:       "🇱🇮",
-		description: "Liechtenstein",
-		aliases: []string{
-			"liechtenstein",
-		},
-	},
-	{
-		emoji:       "🇱🇹",
-		description: "Lithuania",
-		aliases: []string{
-			"lithuania",
-		},
-	},
-	{
-		emoji:       "🇱🇺",
-		description: "Luxembourg",
-		aliases: []string{
-			"luxembourg",
-		},
-	},
-	{
-		emoji:       "🇲🇴",
-		description: "Macau SAR China",
-		aliases: []string{
-			"macau",
-		},
-	},
-	{
-		emoji:       "🇲🇰",
-		description: "Macedonia",
-		aliases: []string{
-			"macedonia",
-		},
-	},
-	{
-		emoji:       "🇲🇬",
-		description: "Madagascar",
-		aliases: []string{
-			"madagascar",
-		},
-	},
-	{
-		emoji:       "🇲🇼",
-		description: "Malawi",
-		aliases: []string{
-			"malawi",
-		},
-	},
-	{
-		emoji:       "🇲🇾",
-		description: "Malaysia",
-		aliases: []string{
-			"malaysia",
-		},
-	},
-	{
-		emoji:       "🇲🇻",
-		description: "Maldives",
-		aliases: []string{
-			"maldives",
-		},
-	},
-	{
-		emoji:       "🇲🇱",
-		description: "Mali",
-		aliases: []string{
-			"mali",
-		},
-	},
-	{
-		emoji:       "🇲🇹",
-		description: "Malta",
-		aliases: []string{
-			"malta",
-		},
-	},
-	{
-		emoji:       "🇲🇭",
-		description: "Marshall Islands",
-		aliases: []string{
-			"marshall_islands",
-		},
-	},
-	{
-		emoji:       "🇲🇶",
-		description: "Martinique",
-		aliases: []string{
-			"martinique",
-		},
-	},
-	{
-		emoji:       "🇲🇷",
-		description: "Mauritania",
-		aliases: []string{
-			"mauritania",
-		},
-	},
-	{
-		emoji:       "🇲🇺",
-		description: "Mauritius",
-		aliases: []string{
-			"mauritius",
-		},
-	},
-	{
-		emoji:       "🇾🇹",
-		description: "Mayotte",
-		aliases: []string{
-			"mayotte",
-		},
-	},
-	{
-		emoji:       "🇲🇽",
-		description: "Mexico",
-		aliases: []string{
-			"mexico",
-		},
-	},
-	{
-		emoji:       "🇫🇲",
-		description: "Micronesia",
-		aliases: []string{
-			"micronesia",
-		},
-	},
-	{
-		emoji:       "🇲🇩",
-		description: "Moldova",
-		aliases: []string{
-			"moldova",
-		},
-	},
-	{
-		emoji:       "🇲🇨",
-		description: "Monaco",
-		aliases: []string{
-			"monaco",
-		},
-	},
-	{
-		emoji:       "🇲🇳",
-		description: "Mongolia",
-		aliases: []string{
-			"mongolia",
-		},
-	},
-	{
-		emoji:       "🇲🇪",
-		description: "Montenegro",
-		aliases: []string{
-			"montenegro",
-		},
-	},
-	{
-		emoji:       "🇲🇸",
-		description: "Montserrat",
-		aliases: []string{
-			"montserrat",
-		},
-	},
-	{
-		emoji:       "🇲🇦",
-		description: "Morocco",
-		aliases: []string{
-			"morocco",
-		},
-	},
-	{
-		emoji:       "🇲🇿",
-		description: "Mozambique",
-		aliases: []string{
-			"mozambique",
-		},
-	},
-	{
-		emoji:       "🇲🇲",
-		description: "Myanmar (Burma)",
-		aliases: []string{
-			"myanmar",
-		},
-	},
-	{
-		emoji:       "🇳🇦",
-		description: "Namibia",
-		aliases: []string{
-			"namibia",
-		},
-	},
-	{
-		emoji:       "🇳🇷",
-		description: "Nauru",
-		aliases: []string{
-			"nauru",
-		},
-	},
-	{
-		emoji:       "🇳🇵",
-		description: "Nepal",
-		aliases: []string{
-			"nepal",
-		},
-	},
-	{
-		emoji:       "🇳🇱",
-		description: "Netherlands",
-		aliases: []string{
-			"netherlands",
-		},
-	},
-	{
-		emoji:       "🇳🇨",
-		description: "New Caledonia",
-		aliases: []string{
-			"new_caledonia",
-		},
-	},
-	{
-		emoji:       "🇳🇿",
-		description: "New Zealand",
-		aliases: []string{
-			"new_zealand",
-		},
-	},
-	{
-		emoji:       "🇳🇮",
-		description: "Nicaragua",
-		aliases: []string{
-			"nicaragua",
-		},
-	},
-	{
-		emoji:       "🇳🇪",
-		description: "Niger",
-		aliases: []string{
-			"niger",
-		},
-	},
-	{
-		emoji:       "🇳🇬",
-		description: "Nigeria",
-		aliases: []string{
-			"nigeria",
-		},
-	},
-	{
-		emoji:       "🇳🇺",
-		description: "Niue",
-		aliases: []string{
-			"niue",
-		},
-	},
-	{
-		emoji:       "🇳🇫",
-		description: "Norfolk Island",
-		aliases: []string{
-			"norfolk_island",
-		},
-	},
-	{
-		emoji:       "🇲🇵",
-		description: "Northern Mariana Islands",
-		aliases: []string{
-			"northern_mariana_islands",
-		},
-	},
-	{
-		emoji:       "🇰🇵",
-		description: "North Korea",
-		aliases: []string{
-			"north_korea",
-		},
-	},
-	{
-		emoji:       "🇳🇴",
-		description: "Norway",
-		aliases: []string{
-			"norway",
-		},
-	},
-	{
-		emoji:       "🇴🇲",
-		description: "Oman",
-		aliases: []string{
-			"oman",
-		},
-	},
-	{
-		emoji:       "🇵🇰",
-		description: "Pakistan",
-		aliases: []string{
-			"pakistan",
-		},
-	},
-	{
-		emoji:       "🇵🇼",
-		description: "Palau",
-		aliases: []string{
-			"palau",
-		},
-	},
-	{
-		emoji:       "🇵🇸",
-		description: "Palestinian Territories",
-		aliases: []string{
-			"palestinian_territories",
-		},
-	},
-	{
-		emoji:       "🇵🇦",
-		description: "Panama",
-		aliases: []string{
-			"panama",
-		},
-	},
-	{
-		emoji:       "🇵🇬",
-		description: "Papua New Guinea",
-		aliases: []string{
-			"papua_new_guinea",
-		},
-	},
-	{
-		emoji:       "🇵🇾",
-		description: "Paraguay",
-		aliases: []string{
-			"paraguay",
-		},
-	},
-	{
-		emoji:       "🇵🇪",
-		description: "Peru",
-		aliases: []string{
-			"peru",
-		},
-	},
-	{
-		emoji:       "🇵🇭",
-		description: "Philippines",
-		aliases: []string{
-			"philippines",
-		},
-	},
-	{
-		emoji:       "🇵🇳",
-		description: "Pitcairn Islands",
-		aliases: []string{
-			"pitcairn_islands",
-		},
-	},
-	{
-		emoji:       "🇵🇱",
-		description: "Poland",
-		aliases: []string{
-			"poland",
-		},
-	},
-	{
-		emoji:       "🇵🇹",
-		description: "Portugal",
-		aliases: []string{
-			"portugal",
-		},
-	},
-	{
-		emoji:       "🇵🇷",
-		description: "Puerto Rico",
-		aliases: []string{
-			"puerto_rico",
-		},
-	},
-	{
-		emoji:       "🇶🇦",
-		description: "Qatar",
-		aliases: []string{
-			"qatar",
-		},
-	},
-	{
-		emoji:       "🇷🇪",
-		description: "Réunion",
-		aliases: []string{
-			"reunion",
-		},
-	},
-	{
-		emoji:       "🇷🇴",
-		description: "Romania",
-		aliases: []string{
-			"romania",
-		},
-	},
-	{
-		emoji:       "🇷🇺",
-		description: "Russia",
-		aliases: []string{
-			"ru",
-		},
-	},
-	{
-		emoji:       "🇷🇼",
-		description: "Rwanda",
-		aliases: []string{
-			"rwanda",
-		},
-	},
-	{
-		emoji:       "🇧🇱",
-		description: "St. Barthélemy",
-		aliases: []string{
-			"st_barthelemy",
-		},
-	},
-	{
-		emoji:       "🇸🇭",
-		description: "St. Helena",
-		aliases: []string{
-			"st_helena",
-		},
-	},
-	{
-		emoji:       "🇰🇳",
-		description: "St. Kitts & Nevis",
-		aliases: []string{
-			"st_kitts_nevis",
-		},
-	},
-	{
-		emoji:       "🇱🇨",
-		description: "St. Lucia",
-		aliases: []string{
-			"st_lucia",
-		},
-	},
-	{
-		emoji:       "🇵🇲",
-		description: "St. Pierre & Miquelon",
-		aliases: []string{
-			"st_pierre_miquelon",
-		},
-	},
-	{
-		emoji:       "🇻🇨",
-		description: "St. Vincent & Grenadines",
-		aliases: []string{
-			"st_vincent_grenadines",
-		},
-	},
-	{
-		emoji:       "🇼🇸",
-		description: "Samoa",
-		aliases: []string{
-			"samoa",
-		},
-	},
-	{
-		emoji:       "🇸🇲",
-		description: "San Marino",
-		aliases: []string{
-			"san_marino",
-		},
-	},
-	{
-		emoji:       "🇸🇹",
-		description: "São Tomé & Príncipe",
-		aliases: []string{
-			"sao_tome_principe",
-		},
-	},
-	{
-		emoji:       "🇸🇦",
-		description: "Saudi Arabia",
-		aliases: []string{
-			"saudi_arabia",
-		},
-	},
-	{
-		emoji:       "🇸🇳",
-		description: "Senegal",
-		aliases: []string{
-			"senegal",
-		},
-	},
-	{
-		emoji:       "🇷🇸",
-		description: "Serbia",
-		aliases: []string{
-			"serbia",
-		},
-	},
-	{
-		emoji:       "🇸🇨",
-		description: "Seychelles",
-		aliases: []string{
-			"seychelles",
-		},
-	},
-	{
-		emoji:       "🇸🇱",
-		description: "Sierra Leone",
-		aliases: []string{
-			"sierra_leone",
-		},
-	},
-	{
-		emoji:       "🇸🇬",
-		description: "Singapore",
-		aliases: []string{
-			"singapore",
-		},
-	},
-	{
-		emoji:       "🇸🇽",
-		description: "Sint Maarten",
-		aliases: []string{
-			"sint_maarten",
-		},
-	},
-	{
-		emoji:       "🇸🇰",
-		description: "Slovakia",
-		aliases: []string{
-			"slovakia",
-		},
-	},
-	{
-		emoji:       "🇸🇮",
-		description: "Slovenia",
-		aliases: []string{
-			"slovenia",
-		},
-	},
-	{
-		emoji:       "🇸🇧",
-		description: "Solomon Islands",
-		aliases: []string{
-			"solomon_islands",
-		},
-	},
-	{
-		emoji:       "🇸🇴",
-		description: "Somalia",
-		aliases: []string{
-			"somalia",
-		},
-	},
-	{
-		emoji:       "🇿🇦",
-		description: "South Africa",
-		aliases: []string{
-			"south_africa",
-		},
-	},
-	{
-		emoji:       "🇬🇸",
-		description: "South Georgia & South Sandwich Islands",
-		aliases: []string{
-			"south_georgia_south_sandwich_islands",
-		},
-	},
-	{
-		emoji:       "🇰🇷",
-		description: "South Korea",
-		aliases: []string{
-			"kr",
-		},
-	},
-	{
-		emoji:       "🇸🇸",
-		description: "South Sudan",
-		aliases: []string{
-			"south_sudan",
-		},
-	},
-	{
-		emoji:       "🇪🇸",
-		description: "Spain",
-		aliases: []string{
-			"es",
-		},
-	},
-	{
-		emoji:       "🇱🇰",
-		description: "Sri Lanka",
-		aliases: []string{
-			"sri_lanka",
-		},
-	},
-	{
-		emoji:       "🇸🇩",
-		description: "Sudan",
-		aliases: []string{
-			"sudan",
-		},
-	},
-	{
-		emoji:       "🇸🇷",
-		description: "Suriname",
-		aliases: []string{
-			"suriname",
-		},
-	},
-	{
-		emoji:       "🇸🇿",
-		description: "Swaziland",
-		aliases: []string{
-			"swaziland",
-		},
-	},
-	{
-		emoji:       "🇸🇪",
-		description: "Sweden",
-		aliases: []string{
-			"sweden",
-		},
-	},
-	{
-		emoji:       "🇨🇭",
-		description: "Switzerland",
-		aliases: []string{
-			"switzerland",
-		},
-	},
-	{
-		emoji:       "🇸🇾",
-		description: "Syria",
-		aliases: []string{
-			"syria",
-		},
-	},
-	{
-		emoji:       "🇹🇼",
-		description: "Taiwan",
-		aliases: []string{
-			"taiwan",
-		},
-	},
-	{
-		emoji:       "🇹🇯",
-		description: "Tajikistan",
-		aliases: []string{
-			"tajikistan",
-		},
-	},
-	{
-		emoji:       "🇹🇿",
-		description: "Tanzania",
-		aliases: []string{
-			"tanzania",
-		},
-	},
-	{
-		emoji:       "🇹🇭",
-		description: "Thailand",
-		aliases: []string{
-			"thailand",
-		},
-	},
-	{
-		emoji:       "🇹🇱",
-		description: "Timor-Leste",
-		aliases: []string{
-			"timor_leste",
-		},
-	},
-	{
-		emoji:       "🇹🇬",
-		description: "Togo",
-		aliases: []string{
-			"togo",
-		},
-	},
-	{
-		emoji:       "🇹🇰",
-		description: "Tokelau",
-		aliases: []string{
-			"tokelau",
-		},
-	},
-	{
-		emoji:       "🇹🇴",
-		description: "Tonga",
-		aliases: []string{
-			"tonga",
-		},
-	},
-	{
-		emoji:       "🇹🇹",
-		description: "Trinidad & Tobago",
-		aliases: []string{
-			"trinidad_tobago",
-		},
-	},
-	{
-		emoji:       "🇹🇳",
-		description: "Tunisia",
-		aliases: []string{
-			"tunisia",
-		},
-	},
-	{
-		emoji:       "🇹🇷",
-		description: "Turkey",
-		aliases: []string{
-			"tr",
-		},
-	},
-	{
-		emoji:       "🇹🇲",
-		description: "Turkmenistan",
-		aliases: []string{
-			"turkmenistan",
-		},
-	},
-	{
-		emoji:       "🇹🇨",
-		description: "Turks & Caicos Islands",
-		aliases: []string{
-			"turks_caicos_islands",
-		},
-	},
-	{
-		emoji:       "🇹🇻",
-		description: "Tuvalu",
-		aliases: []string{
-			"tuvalu",
-		},
-	},
-	{
-		emoji:       "🇺🇬",
-		description: "Uganda",
-		aliases: []string{
-			"uganda",
-		},
-	},
-	{
-		emoji:       "🇺🇦",
-		description: "Ukraine",
-		aliases: []string{
-			"ukraine",
-		},
-	},
-	{
-		emoji:       "🇦🇪",
-		description: "United Arab Emirates",
-		aliases: []string{
-			"united_arab_emirates",
-		},
-	},
-	{
-		emoji:       "🇬🇧",
-		description: "United Kingdom",
-		aliases: []string{
-			"gb",
-			"uk",
-		},
-	},
-	{
-		emoji:       "🇺🇸",
-		description: "United States",
-		aliases: []string{
-			"us",
-		},
-	},
-	{
-		emoji:       "🇻🇮",
-		description: "U.S. Virgin Islands",
-		aliases: []string{
-			"us_virgin_islands",
-		},
-	},
-	{
-		emoji:       "🇺🇾",
-		description: "Uruguay",
-		aliases: []string{
-			"uruguay",
-		},
-	},
-	{
-		emoji:       "🇺🇿",
-		description: "Uzbekistan",
-		aliases: []string{
-			"uzbekistan",
-		},
-	},
-	{
-		emoji:       "🇻🇺",
-		description: "Vanuatu",
-		aliases: []string{
-			"vanuatu",
-		},
-	},
-	{
-		emoji:       "🇻🇦",
-		description: "Vatican City",
-		aliases: []string{
-			"vatican_city",
-		},
-	},
-	{
-		emoji:       "🇻🇪",
-		description: "Venezuela",
-		aliases: []string{
-			"venezuela",
-		},
-	},
-	{
-		emoji:       "🇻🇳",
-		description: "Vietnam",
-		aliases: []string{
-			"vietnam",
-		},
-	},
-	{
-		emoji:       "🇼🇫",
-		description: "Wallis & Futuna",
-		aliases: []string{
-			"wallis_futuna",
-		},
-	},
-	{
-		emoji:       "🇪🇭",
-		description: "Western Sahara",
-		aliases: []string{
-			"western_sahara",
-		},
-	},
-	{
-		emoji:       "🇾🇪",
-		description: "Yemen",
-		aliases: []string{
-			"yemen",
-		},
-	},
-	{
-		emoji:       "🇿🇲",
-		description: "Zambia",
-		aliases: []string{
-			"zambia",
-		},
-	},
-	{
-		emoji:       "🇿🇼",
-		description: "Zimbabwe",
-		aliases: []string{
-			"zimbabwe",
-		},
-	},
-}
-
-var categories = []string{
-	"Activity",
-	"Flags",
-	"Foods",
-	"Nature",
-	"Objects",
-	"People",
-	"Places",
-	"Symbols",
-}
-
-var byCategory = [][]*emoji{
-	// Activity
-	{
-		&allEmoji[448],
-		&allEmoji[449],
-		&allEmoji[450],
-		&allEmoji[451],
-		&allEmoji[452],
-		&allEmoji[453],
-		&allEmoji[454],
-		&allEmoji[455],
-		&allEmoji[456],
-		&allEmoji[457],
-		&allEmoji[458],
-		&allEmoji[459],
-		&allEmoji[460],
-		&allEmoji[461],
-		&allEmoji[462],
-		&allEmoji[463],
-		&allEmoji[464],
-		&allEmoji[465],
-		&allEmoji[466],
-		&allEmoji[467],
-		&allEmoji[468],
-		&allEmoji[469],
-		&allEmoji[470],
-		&allEmoji[471],
-		&allEmoji[472],
-		&allEmoji[473],
-		&allEmoji[474],
-		&allEmoji[475],
-		&allEmoji[476],
-		&allEmoji[477],
-		&allEmoji[478],
-		&allEmoji[479],
-		&allEmoji[480],
-		&allEmoji[481],
-		&allEmoji[482],
-		&allEmoji[483],
-		&allEmoji[484],
-		&allEmoji[485],
-		&allEmoji[486],
-		&allEmoji[487],
-		&allEmoji[488],
-		&allEmoji[489],
-		&allEmoji[490],
-		&allEmoji[491],
-		&allEmoji[492],
-		&allEmoji[493],
-		&allEmoji[494],
-		&allEmoji[495],
-		&allEmoji[496],
-		&allEmoji[497],
-		&allEmoji[498],
-		&allEmoji[499],
-		&allEmoji[500],
-		&allEmoji[501],
-		&allEmoji[502],
-		&allEmoji[503],
-		&allEmoji[504],
-		&allEmoji[505],
-		&allEmoji[506],
-		&allEmoji[507],
-		&allEmoji[508],
-		&allEmoji[509],
-		&allEmoji[510],
-		&allEmoji[511],
-	},
-	// Flags
-	{
-		&allEmoji[1074],
-		&allEmoji[1075],
-		&allEmoji[1076],
-		&allEmoji[1077],
-		&allEmoji[1078],
-		&allEmoji[1079],
-		&allEmoji[1080],
-		&allEmoji[1081],
-		&allEmoji[1082],
-		&allEmoji[1083],
-		&allEmoji[1084],
-		&allEmoji[1085],
-		&allEmoji[1086],
-		&allEmoji[1087],
-		&allEmoji[1088],
-		&allEmoji[1089],
-		&allEmoji[1090],
-		&allEmoji[1091],
-		&allEmoji[1092],
-		&allEmoji[1093],
-		&allEmoji[1094],
-		&allEmoji[1095],
-		&allEmoji[1096],
-		&allEmoji[1097],
-		&allEmoji[1098],
-		&allEmoji[1099],
-		&allEmoji[1100],
-		&allEmoji[1101],
-		&allEmoji[1102],
-		&allEmoji[1103],
-		&allEmoji[1104],
-		&allEmoji[1105],
-		&allEmoji[1106],
-		&allEmoji[1107],
-		&allEmoji[1108],
-		&allEmoji[1109],
-		&allEmoji[1110],
-		&allEmoji[1111],
-		&allEmoji[1112],
-		&allEmoji[1113],
-		&allEmoji[1114],
-		&allEmoji[1115],
-		&allEmoji[1116],
-		&allEmoji[1117],
-		&allEmoji[1118],
-		&allEmoji[1119],
-		&allEmoji[1120],
-		&allEmoji[1121],
-		&allEmoji[1122],
-		&allEmoji[1123],
-		&allEmoji[1124],
-		&allEmoji[1125],
-		&allEmoji[1126],
-		&allEmoji[1127],
-		&allEmoji[1128],
-		&allEmoji[1129],
-		&allEmoji[1130],
-		&allEmoji[1131],
-		&allEmoji[1132],
-		&allEmoji[1133],
-		&allEmoji[1134],
-		&allEmoji[1135],
-		&allEmoji[1136],
-		&allEmoji[1137],
-		&allEmoji[1138],
-		&allEmoji[1139],
-		&allEmoji[1140],
-		&allEmoji[1141],
-		&allEmoji[1142],
-		&allEmoji[1143],
-		&allEmoji[1144],
-		&allEmoji[1145],
-		&allEmoji[1146],
-		&allEmoji[1147],
-		&allEmoji[1148],
-		&allEmoji[1149],
-		&allEmoji[1150],
-		&allEmoji[1151],
-		&allEmoji[1152],
-		&allEmoji[1153],
-		&allEmoji[1154],
-		&allEmoji[1155],
-		&allEmoji[1156],
-		&allEmoji[1157],
-		&allEmoji[1158],
-		&allEmoji[1159],
-		&allEmoji[1160],
-		&allEmoji[1161],
-		&allEmoji[1162],
-		&allEmoji[1163],
-		&allEmoji[1164],
-		&allEmoji[1165],
-		&allEmoji[1166],
-		&allEmoji[1167],
-		&allEmoji[1168],
-		&allEmoji[1169],
-		&allEmoji[1170],
-		&allEmoji[1171],
-		&allEmoji[1172],
-		&allEmoji[1173],
-		&allEmoji[1174],
-		&allEmoji[1175],
-		&allEmoji[1176],
-		&allEmoji[1177],
-		&allEmoji[1178],
-		&allEmoji[1179],
-		&allEmoji[1180],
-		&allEmoji[1181],
-		&allEmoji[1182],
-		&allEmoji[1183],
-		&allEmoji[1184],
-		&allEmoji[1185],
-		&allEmoji[1186],
-		&allEmoji[1187],
-		&allEmoji[1188],
-		&allEmoji[1189],
-		&allEmoji[1190],
-		&allEmoji[1191],
-		&allEmoji[1192],
-		&allEmoji[1193],
-		&allEmoji[1194],
-		&allEmoji[1195],
-		&allEmoji[1196],
-		&allEmoji[1197],
-		&allEmoji[1198],
-		&allEmoji[1199],
-		&allEmoji[1200],
-		&allEmoji[1201],
-		&allEmoji[1202],
-		&allEmoji[1203],
-		&allEmoji[1204],
-		&allEmoji[1205],
-		&allEmoji[1206],
-		&allEmoji[1207],
-		&allEmoji[1208],
-		&allEmoji[1209],
-		&allEmoji[1210],
-		&allEmoji[1211],
-		&allEmoji[1212],
-		&allEmoji[1213],
-		&allEmoji[1214],
-		&allEmoji[1215],
-		&allEmoji[1216],
-		&allEmoji[1217],
-		&allEmoji[1218],
-		&allEmoji[1219],
-		&allEmoji[1220],
-		&allEmoji[1221],
-		&allEmoji[1222],
-		&allEmoji[1223],
-		&allEmoji[1224],
-		&allEmoji[1225],
-		&allEmoji[1226],
-		&allEmoji[1227],
-		&allEmoji[1228],
-		&allEmoji[1229],
-		&allEmoji[1230],
-		&allEmoji[1231],
-		&allEmoji[1232],
-		&allEmoji[1233],
-		&allEmoji[1234],
-		&allEmoji[1235],
-		&allEmoji[1236],
-		&allEmoji[1237],
-		&allEmoji[1238],
-		&allEmoji[1239],
-		&allEmoji[1240],
-		&allEmoji[1241],
-		&allEmoji[1242],
-		&allEmoji[1243],
-		&allEmoji[1244],
-		&allEmoji[1245],
-		&allEmoji[1246],
-		&allEmoji[1247],
-		&allEmoji[1248],
-		&allEmoji[1249],
-		&allEmoji[1250],
-		&allEmoji[1251],
-		&allEmoji[1252],
-		&allEmoji[1253],
-		&allEmoji[1254],
-		&allEmoji[1255],
-		&allEmoji[1256],
-		&allEmoji[1257],
-		&allEmoji[1258],
-		&allEmoji[1259],
-		&allEmoji[1260],
-		&allEmoji[1261],
-		&allEmoji[1262],
-		&allEmoji[1263],
-		&allEmoji[1264],
-		&allEmoji[1265],
-		&allEmoji[1266],
-		&allEmoji[1267],
-		&allEmoji[1268],
-		&allEmoji[1269],
-		&allEmoji[1270],
-		&allEmoji[1271],
-		&allEmoji[1272],
-		&allEmoji[1273],
-		&allEmoji[1274],
-		&allEmoji[1275],
-		&allEmoji[1276],
-		&allEmoji[1277],
-		&allEmoji[1278],
-		&allEmoji[1279],
-		&allEmoji[1280],
-		&allEmoji[1281],
-		&allEmoji[1282],
-		&allEmoji[1283],
-		&allEmoji[1284],
-		&allEmoji[1285],
-		&allEmoji[1286],
-		&allEmoji[1287],
-		&allEmoji[1288],
-		&allEmoji[1289],
-		&allEmoji[1290],
-		&allEmoji[1291],
-		&allEmoji[1292],
-		&allEmoji[1293],
-		&allEmoji[1294],
-		&allEmoji[1295],
-		&allEmoji[1296],
-		&allEmoji[1297],
-		&allEmoji[1298],
-		&allEmoji[1299],
-		&allEmoji[1300],
-		&allEmoji[1301],
-		&allEmoji[1302],
-		&allEmoji[1303],
-		&allEmoji[1304],
-		&allEmoji[1305],
-		&allEmoji[1306],
-		&allEmoji[1307],
-		&allEmoji[1308],
-		&allEmoji[1309],
-		&allEmoji[1310],
-		&allEmoji[1311],
-		&allEmoji[1312],
-		&allEmoji[1313],
-		&allEmoji[1314],
-		&allEmoji[1315],
-		&allEmoji[1316],
-		&allEmoji[1317],
-		&allEmoji[1318],
-		&allEmoji[1319],
-		&allEmoji[1320],
-	},
-	// Foods
-	{
-		&allEmoji[381],
-		&allEmoji[382],
-		&allEmoji[383],
-		&allEmoji[384],
-		&allEmoji[385],
-		&allEmoji[386],
-		&allEmoji[387],
-		&allEmoji[388],
-		&allEmoji[389],
-		&allEmoji[390],
-		&allEmoji[391],
-		&allEmoji[392],
-		&allEmoji[393],
-		&allEmoji[394],
-		&allEmoji[395],
-		&allEmoji[396],
-		&allEmoji[397],
-		&allEmoji[398],
-		&allEmoji[399],
-		&allEmoji[400],
-		&allEmoji[401],
-		&allEmoji[402],
-		&allEmoji[403],
-		&allEmoji[404],
-		&allEmoji[405],
-		&allEmoji[406],
-		&allEmoji[407],
-		&allEmoji[408],
-		&allEmoji[409],
-		&allEmoji[410],
-		&allEmoji[411],
-		&allEmoji[412],
-		&allEmoji[413],
-		&allEmoji[414],
-		&allEmoji[415],
-		&allEmoji[416],
-		&allEmoji[417],
-		&allEmoji[418],
-		&allEmoji[419],
-		&allEmoji[420],
-		&allEmoji[421],
-		&allEmoji[422],
-		&allEmoji[423],
-		&allEmoji[424],
-		&allEmoji[425],
-		&allEmoji[426],
-		&allEmoji[427],
-		&allEmoji[428],
-		&allEmoji[429],
-		&allEmoji[430],
-		&allEmoji[431],
-		&allEmoji[432],
-		&allEmoji[433],
-		&allEmoji[434],
-		&allEmoji[435],
-		&allEmoji[436],
-		&allEmoji[437],
-		&allEmoji[438],
-		&allEmoji[439],
-		&allEmoji[440],
-		&allEmoji[441],
-		&allEmoji[442],
-		&allEmoji[443],
-		&allEmoji[444],
-		&allEmoji[445],
-		&allEmoji[446],
-		&allEmoji[447],
-	},
-	// Nature
-	{
-		&allEmoji[234],
-		&allEmoji[235],
-		&allEmoji[236],
-		&allEmoji[237],
-		&allEmoji[238],
-		&allEmoji[239],
-		&allEmoji[240],
-		&allEmoji[241],
-		&allEmoji[242],
-		&allEmoji[243],
-		&allEmoji[244],
-		&allEmoji[245],
-		&allEmoji[246],
-		&allEmoji[247],
-		&allEmoji[248],
-		&allEmoji[249],
-		&allEmoji[250],
-		&allEmoji[251],
-		&allEmoji[252],
-		&allEmoji[253],
-		&allEmoji[254],
-		&allEmoji[255],
-		&allEmoji[256],
-		&allEmoji[257],
-		&allEmoji[258],
-		&allEmoji[259],
-		&allEmoji[260],
-		&allEmoji[261],
-		&allEmoji[262],
-		&allEmoji[263],
-		&allEmoji[264],
-		&allEmoji[265],
-		&allEmoji[266],
-		&allEmoji[267],
-		&allEmoji[268],
-		&allEmoji[269],
-		&allEmoji[270],
-		&allEmoji[271],
-		&allEmoji[272],
-		&allEmoji[273],
-		&allEmoji[274],
-		&allEmoji[275],
-		&allEmoji[276],
-		&allEmoji[277],
-		&allEmoji[278],
-		&allEmoji[279],
-		&allEmoji[280],
-		&allEmoji[281],
-		&allEmoji[282],
-		&allEmoji[283],
-		&allEmoji[284],
-		&allEmoji[285],
-		&allEmoji[286],
-		&allEmoji[287],
-		&allEmoji[288],
-		&allEmoji[289],
-		&allEmoji[290],
-		&allEmoji[291],
-		&allEmoji[292],
-		&allEmoji[293],
-		&allEmoji[294],
-		&allEmoji[295],
-		&allEmoji[296],
-		&allEmoji[297],
-		&allEmoji[298],
-		&allEmoji[299],
-		&allEmoji[300],
-		&allEmoji[301],
-		&allEmoji[302],
-		&allEmoji[303],
-		&allEmoji[304],
-		&allEmoji[305],
-		&allEmoji[306],
-		&allEmoji[307],
-		&allEmoji[308],
-		&allEmoji[309],
-		&allEmoji[310],
-		&allEmoji[311],
-		&allEmoji[312],
-		&allEmoji[313],
-		&allEmoji[314],
-		&allEmoji[315],
-		&allEmoji[316],
-		&allEmoji[317],
-		&allEmoji[318],
-		&allEmoji[319],
-		&allEmoji[320],
-		&allEmoji[321],
-		&allEmoji[322],
-		&allEmoji[323],
-		&allEmoji[324],
-		&allEmoji[325],
-		&allEmoji[326],
-		&allEmoji[327],
-		&allEmoji[328],
-		&allEmoji[329],
-		&allEmoji[330],
-		&allEmoji[331],
-		&allEmoji[332],
-		&allEmoji[333],
-		&allEmoji[334],
-		&allEmoji[335],
-		&allEmoji[336],
-		&allEmoji[337],
-		&allEmoji[338],
-		&allEmoji[339],
-		&allEmoji[340],
-		&allEmoji[341],
-		&allEmoji[342],
-		&allEmoji[343],
-		&allEmoji[344],
-		&allEmoji[345],
-		&allEmoji[346],
-		&allEmoji[347],
-		&allEmoji[348],
-		&allEmoji[349],
-		&allEmoji[350],
-		&allEmoji[351],
-		&allEmoji[352],
-		&allEmoji[353],
-		&allEmoji[354],
-		&allEmoji[355],
-		&allEmoji[356],
-		&allEmoji[357],
-		&allEmoji[358],
-		&allEmoji[359],
-		&allEmoji[360],
-		&allEmoji[361],
-		&allEmoji[362],
-		&allEmoji[363],
-		&allEmoji[364],
-		&allEmoji[365],
-		&allEmoji[366],
-		&allEmoji[367],
-		&allEmoji[368],
-		&allEmoji[369],
-		&allEmoji[370],
-		&allEmoji[371],
-		&allEmoji[372],
-		&allEmoji[373],
-		&allEmoji[374],
-		&allEmoji[375],
-		&allEmoji[376],
-		&allEmoji[377],
-		&allEmoji[378],
-		&allEmoji[379],
-		&allEmoji[380],
-	},
-	// Objects
-	{
-		&allEmoji[627],
-		&allEmoji[628],
-		&allEmoji[629],
-		&allEmoji[630],
-		&allEmoji[631],
-		&allEmoji[632],
-		&allEmoji[633],
-		&allEmoji[634],
-		&allEmoji[635],
-		&allEmoji[636],
-		&allEmoji[637],
-		&allEmoji[638],
-		&allEmoji[639],
-		&allEmoji[640],
-		&allEmoji[641],
-		&allEmoji[642],
-		&allEmoji[643],
-		&allEmoji[644],
-		&allEmoji[645],
-		&allEmoji[646],
-		&allEmoji[647],
-		&allEmoji[648],
-		&allEmoji[649],
-		&allEmoji[650],
-		&allEmoji[651],
-		&allEmoji[652],
-		&allEmoji[653],
-		&allEmoji[654],
-		&allEmoji[655],
-		&allEmoji[656],
-		&allEmoji[657],
-		&allEmoji[658],
-		&allEmoji[659],
-		&allEmoji[660],
-		&allEmoji[661],
-		&allEmoji[662],
-		&allEmoji[663],
-		&allEmoji[664],
-		&allEmoji[665],
-		&allEmoji[666],
-		&allEmoji[667],
-		&allEmoji[668],
-		&allEmoji[669],
-		&allEmoji[670],
-		&allEmoji[671],
-		&allEmoji[672],
-		&allEmoji[673],
-		&allEmoji[674],
-		&allEmoji[675],
-		&allEmoji[676],
-		&allEmoji[677],
-		&allEmoji[678],
-		&allEmoji[679],
-		&allEmoji[680],
-		&allEmoji[681],
-		&allEmoji[682],
-		&allEmoji[683],
-		&allEmoji[684],
-		&allEmoji[685],
-		&allEmoji[686],
-		&allEmoji[687],
-		&allEmoji[688],
-		&allEmoji[689],
-		&allEmoji[690],
-		&allEmoji[691],
-		&allEmoji[692],
-		&allEmoji[693],
-		&allEmoji[694],
-		&allEmoji[695],
-		&allEmoji[696],
-		&allEmoji[697],
-		&allEmoji[698],
-		&allEmoji[699],
-		&allEmoji[700],
-		&allEmoji[701],
-		&allEmoji[702],
-		&allEmoji[703],
-		&allEmoji[704],
-		&allEmoji[705],
-		&allEmoji[706],
-		&allEmoji[707],
-		&allEmoji[708],
-		&allEmoji[709],
-		&allEmoji[710],
-		&allEmoji[711],
-		&allEmoji[712],
-		&allEmoji[713],
-		&allEmoji[714],
-		&allEmoji[715],
-		&allEmoji[716],
-		&allEmoji[717],
-		&allEmoji[718],
-		&allEmoji[719],
-		&allEmoji[720],
-		&allEmoji[721],
-		&allEmoji[722],
-		&allEmoji[723],
-		&allEmoji[724],
-		&allEmoji[725],
-		&allEmoji[726],
-		&allEmoji[727],
-		&allEmoji[728],
-		&allEmoji[729],
-		&allEmoji[730],
-		&allEmoji[731],
-		&allEmoji[732],
-		&allEmoji[733],
-		&allEmoji[734],
-		&allEmoji[735],
-		&allEmoji[736],
-		&allEmoji[737],
-		&allEmoji[738],
-		&allEmoji[739],
-		&allEmoji[740],
-		&allEmoji[741],
-		&allEmoji[742],
-		&allEmoji[743],
-		&allEmoji[744],
-		&allEmoji[745],
-		&allEmoji[746],
-		&allEmoji[747],
-		&allEmoji[748],
-		&allEmoji[749],
-		&allEmoji[750],
-		&allEmoji[751],
-		&allEmoji[752],
-		&allEmoji[753],
-		&allEmoji[754],
-		&allEmoji[755],
-		&allEmoji[756],
-		&allEmoji[757],
-		&allEmoji[758],
-		&allEmoji[759],
-		&allEmoji[760],
-		&allEmoji[761],
-		&allEmoji[762],
-		&allEmoji[763],
-		&allEmoji[764],
-		&allEmoji[765],
-		&allEmoji[766],
-		&allEmoji[767],
-		&allEmoji[768],
-		&allEmoji[769],
-		&allEmoji[770],
-		&allEmoji[771],
-		&allEmoji[772],
-		&allEmoji[773],
-		&allEmoji[774],
-		&allEmoji[775],
-		&allEmoji[776],
-		&allEmoji[777],
-		&allEmoji[778],
-		&allEmoji[779],
-		&allEmoji[780],
-		&allEmoji[781],
-		&allEmoji[782],
-		&allEmoji[783],
-		&allEmoji[784],
-		&allEmoji[785],
-		&allEmoji[786],
-		&allEmoji[787],
-		&allEmoji[788],
-		&allEmoji[789],
-		&allEmoji[790],
-		&allEmoji[791],
-		&allEmoji[792],
-		&allEmoji[793],
-		&allEmoji[794],
-		&allEmoji[795],
-		&allEmoji[796],
-		&allEmoji[797],
-		&allEmoji[798],
-		&allEmoji[799],
-		&allEmoji[800],
-		&allEmoji[801],
-		&allEmoji[802],
-		&allEmoji[803],
-		&allEmoji[804],
-	},
-	// People
-	{
-		&allEmoji[0],
-		&allEmoji[1],
-		&allEmoji[2],
-		&allEmoji[3],
-		&allEmoji[4],
-		&allEmoji[5],
-		&allEmoji[6],
-		&allEmoji[7],
-		&allEmoji[8],
-		&allEmoji[9],
-		&allEmoji[10],
-		&allEmoji[11],
-		&allEmoji[12],
-		&allEmoji[13],
-		&allEmoji[14],
-		&allEmoji[15],
-		&allEmoji[16],
-		&allEmoji[17],
-		&allEmoji[18],
-		&allEmoji[19],
-		&allEmoji[20],
-		&allEmoji[21],
-		&allEmoji[22],
-		&allEmoji[23],
-		&allEmoji[24],
-		&allEmoji[25],
-		&allEmoji[26],
-		&allEmoji[27],
-		&allEmoji[28],
-		&allEmoji[29],
-		&allEmoji[30],
-		&allEmoji[31],
-		&allEmoji[32],
-		&allEmoji[33],
-		&allEmoji[34],
-		&allEmoji[35],
-		&allEmoji[36],
-		&allEmoji[37],
-		&allEmoji[38],
-		&allEmoji[39],
-		&allEmoji[40],
-		&allEmoji[41],
-		&allEmoji[42],
-		&allEmoji[43],
-		&allEmoji[44],
-		&allEmoji[45],
-		&allEmoji[46],
-		&allEmoji[47],
-		&allEmoji[48],
-		&allEmoji[49],
-		&allEmoji[50],
-		&allEmoji[51],
-		&allEmoji[52],
-		&allEmoji[53],
-		&allEmoji[54],
-		&allEmoji[55],
-		&allEmoji[56],
-		&allEmoji[57],
-		&allEmoji[58],
-		&allEmoji[59],
-		&allEmoji[60],
-		&allEmoji[61],
-		&allEmoji[62],
-		&allEmoji[63],
-		&allEmoji[64],
-		&allEmoji[65],
-		&allEmoji[66],
-		&allEmoji[67],
-		&allEmoji[68],
-		&allEmoji[69],
-		&allEmoji[70],
-		&allEmoji[71],
-		&allEmoji[72],
-		&allEmoji[73],
-		&allEmoji[74],
-		&allEmoji[75],
-		&allEmoji[76],
-		&allEmoji[77],
-		&allEmoji[78],
-		&allEmoji[79],
-		&allEmoji[80],
-		&allEmoji[81],
-		&allEmoji[82],
-		&allEmoji[83],
-		&allEmoji[84],
-		&allEmoji[85],
-		&allEmoji[86],
-		&allEmoji[87],
-		&allEmoji[88],
-		&allEmoji[89],
-		&allEmoji[90],
-		&allEmoji[91],
-		&allEmoji[92],
-		&allEmoji[93],
-		&allEmoji[94],
-		&allEmoji[95],
-		&allEmoji[96],
-		&allEmoji[97],
-		&allEmoji[98],
-		&allEmoji[99],
-		&allEmoji[100],
-		&allEmoji[101],
-		&allEmoji[102],
-		&allEmoji[103],
-		&allEmoji[104],
-		&allEmoji[105],
-		&allEmoji[106],
-		&allEmoji[107],
-		&allEmoji[108],
-		&allEmoji[109],
-		&allEmoji[110],
-		&allEmoji[111],
-		&allEmoji[112],
-		&allEmoji[113],
-		&allEmoji[114],
-		&allEmoji[115],
-		&allEmoji[116],
-		&allEmoji[117],
-		&allEmoji[118],
-		&allEmoji[119],
-		&allEmoji[120],
-		&allEmoji[121],
-		&allEmoji[122],
-		&allEmoji[123],
-		&allEmoji[124],
-		&allEmoji[125],
-		&allEmoji[126],
-		&allEmoji[127],
-		&allEmoji[128],
-		&allEmoji[129],
-		&allEmoji[130],
-		&allEmoji[131],
-		&allEmoji[132],
-		&allEmoji[133],
-		&allEmoji[134],
-		&allEmoji[135],
-		&allEmoji[136],
-		&allEmoji[137],
-		&allEmoji[138],
-		&allEmoji[139],
-		&allEmoji[140],
-		&allEmoji[141],
-		&allEmoji[142],
-		&allEmoji[143],
-		&allEmoji[144],
-		&allEmoji[145],
-		&allEmoji[146],
-		&allEmoji[147],
-		&allEmoji[148],
-		&allEmoji[149],
-		&allEmoji[150],
-		&allEmoji[151],
-		&allEmoji[152],
-		&allEmoji[153],
-		&allEmoji[154],
-		&allEmoji[155],
-		&allEmoji[156],
-		&allEmoji[157],
-		&allEmoji[158],
-		&allEmoji[159],
-		&allEmoji[160],
-		&allEmoji[161],
-		&allEmoji[162],
-		&allEmoji[163],
-		&allEmoji[164],
-		&allEmoji[165],
-		&allEmoji[166],
-		&allEmoji[167],
-		&allEmoji[168],
-		&allEmoji[169],
-		&allEmoji[170],
-		&allEmoji[171],
-		&allEmoji[172],
-		&allEmoji[173],
-		&allEmoji[174],
-		&allEmoji[175],
-		&allEmoji[176],
-		&allEmoji[177],
-		&allEmoji[178],
-		&allEmoji[179],
-		&allEmoji[180],
-		&allEmoji[181],
-		&allEmoji[182],
-		&allEmoji[183],
-		&allEmoji[184],
-		&allEmoji[185],
-		&allEmoji[186],
-		&allEmoji[187],
-		&allEmoji[188],
-		&allEmoji[189],
-		&allEmoji[190],
-		&allEmoji[191],
-		&allEmoji[192],
-		&allEmoji[193],
-		&allEmoji[194],
-		&allEmoji[195],
-		&allEmoji[196],
-		&allEmoji[197],
-		&allEmoji[198],
-		&allEmoji[199],
-		&allEmoji[200],
-		&allEmoji[201],
-		&allEmoji[202],
-		&allEmoji[203],
-		&allEmoji[204],
-		&allEmoji[205],
-		&allEmoji[206],
-		&allEmoji[207],
-		&allEmoji[208],
-		&allEmoji[209],
-		&allEmoji[210],
-		&allEmoji[211],
-		&allEmoji[212],
-		&allEmoji[213],
-		&allEmoji[214],
-		&allEmoji[215],
-		&allEmoji[216],
-		&allEmoji[217],
-		&allEmoji[218],
-		&allEmoji[219],
-		&allEmoji[220],
-		&allEmoji[221],
-		&allEmoji[222],
-		&allEmoji[223],
-		&allEmoji[224],
-		&allEmoji[225],
-		&allEmoji[226],
-		&allEmoji[227],
-		&allEmoji[228],
-		&allEmoji[229],
-		&allEmoji[230],
-		&allEmoji[231],
-		&allEmoji[232],
-		&allEmoji[233],
-	},
-	// Places
-	{
-		&allEmoji[512],
-		&allEmoji[513],
-		&allEmoji[514],
-		&allEmoji[515],
-		&allEmoji[516],
-		&allEmoji[517],
-		&allEmoji[518],
-		&allEmoji[519],
-		&allEmoji[520],
-		&allEmoji[521],
-		&allEmoji[522],
-		&allEmoji[523],
-		&allEmoji[524],
-		&allEmoji[525],
-		&allEmoji[526],
-		&allEmoji[527],
-		&allEmoji[528],
-		&allEmoji[529],
-		&allEmoji[530],
-		&allEmoji[531],
-		&allEmoji[532],
-		&allEmoji[533],
-		&allEmoji[534],
-		&allEmoji[535],
-		&allEmoji[536],
-		&allEmoji[537],
-		&allEmoji[538],
-		&allEmoji[539],
-		&allEmoji[540],
-		&allEmoji[541],
-		&allEmoji[542],
-		&allEmoji[543],
-		&allEmoji[544],
-		&allEmoji[545],
-		&allEmoji[546],
-		&allEmoji[547],
-		&allEmoji[548],
-		&allEmoji[549],
-		&allEmoji[550],
-		&allEmoji[551],
-		&allEmoji[552],
-		&allEmoji[553],
-		&allEmoji[554],
-		&allEmoji[555],
-		&allEmoji[556],
-		&allEmoji[557],
-		&allEmoji[558],
-		&allEmoji[559],
-		&allEmoji[560],
-		&allEmoji[561],
-		&allEmoji[562],
-		&allEmoji[563],
-		&allEmoji[564],
-		&allEmoji[565],
-		&allEmoji[566],
-		&allEmoji[567],
-		&allEmoji[568],
-		&allEmoji[569],
-		&allEmoji[570],
-		&allEmoji[571],
-		&allEmoji[572],
-		&allEmoji[573],
-		&allEmoji[574],
-		&allEmoji[575],
-		&allEmoji[576],
-		&allEmoji[577],
-		&allEmoji[578],
-		&allEmoji[579],
-		&allEmoji[580],
-		&allEmoji[581],
-		&allEmoji[582],
-		&allEmoji[583],
-		&allEmoji[584],
-		&allEmoji[585],
-		&allEmoji[586],
-		&allEmoji[587],
-		&allEmoji[588],
-		&allEmoji[589],
-		&allEmoji[590],
-		&allEmoji[591],
-		&allEmoji[592],
-		&allEmoji[593],
-		&allEmoji[594],
-		&allEmoji[595],
-		&allEmoji[596],
-		&allEmoji[597],
-		&allEmoji[598],
-		&allEmoji[599],
-		&allEmoji[600],
-		&allEmoji[601],
-		&allEmoji[602],
-		&allEmoji[603],
-		&allEmoji[604],
-		&allEmoji[605],
-		&allEmoji[606],
-		&allEmoji[607],
-		&allEmoji[608],
-		&allEmoji[609],
-		&allEmoji[610],
-		&allEmoji[611],
-		&allEmoji[612],
-		&allEmoji[613],
-		&allEmoji[614],
-		&allEmoji[615],
-		&allEmoji[616],
-		&allEmoji[617],
-		&allEmoji[618],
-		&allEmoji[619],
-		&allEmoji[620],
-		&allEmoji[621],
-		&allEmoji[622],
-		&allEmoji[623],
-		&allEmoji[624],
-		&allEmoji[625],
-		&allEmoji[626],
-	},
-	// Symbols
-	{
-		&allEmoji[805],
-		&allEmoji[806],
-		&allEmoji[807],
-		&allEmoji[808],
-		&allEmoji[809],
-		&allEmoji[810],
-		&allEmoji[811],
-		&allEmoji[812],
-		&allEmoji[813],
-		&allEmoji[814],
-		&allEmoji[815],
-		&allEmoji[816],
-		&allEmoji[817],
-		&allEmoji[818],
-		&allEmoji[819],
-		&allEmoji[820],
-		&allEmoji[821],
-		&allEmoji[822],
-		&allEmoji[823],
-		&allEmoji[824],
-		&allEmoji[825],
-		&allEmoji[826],
-		&allEmoji[827],
-		&allEmoji[828],
-		&allEmoji[829],
-		&allEmoji[830],
-		&allEmoji[831],
-		&allEmoji[832],
-		&allEmoji[833],
-		&allEmoji[834],
-		&allEmoji[835],
-		&allEmoji[836],
-		&allEmoji[837],
-		&allEmoji[838],
-		&allEmoji[839],
-		&allEmoji[840],
-		&allEmoji[841],
-		&allEmoji[842],
-		&allEmoji[843],
-		&allEmoji[844],
-		&allEmoji[845],
-		&allEmoji[846],
-		&allEmoji[847],
-		&allEmoji[848],
-		&allEmoji[849],
-		&allEmoji[850],
-		&allEmoji[851],
-		&allEmoji[852],
-		&allEmoji[853],
-		&allEmoji[854],
-		&allEmoji[855],
-		&allEmoji[856],
-		&allEmoji[857],
-		&allEmoji[858],
-		&allEmoji[859],
-		&allEmoji[860],
-		&allEmoji[861],
-		&allEmoji[862],
-		&allEmoji[863],
-		&allEmoji[864],
-		&allEmoji[865],
-		&allEmoji[866],
-		&allEmoji[867],
-		&allEmoji[868],
-		&allEmoji[869],
-		&allEmoji[870],
-		&allEmoji[871],
-		&allEmoji[872],
-		&allEmoji[873],
-		&allEmoji[874],
-		&allEmoji[875],
-		&allEmoji[876],
-		&allEmoji[877],
-		&allEmoji[878],
-		&allEmoji[879],
-		&allEmoji[880],
-		&allEmoji[881],
-		&allEmoji[882],
-		&allEmoji[883],
-		&allEmoji[884],
-		&allEmoji[885],
-		&allEmoji[886],
-		&allEmoji[887],
-		&allEmoji[888],
-		&allEmoji[889],
-		&allEmoji[890],
-		&allEmoji[891],
-		&allEmoji[892],
-		&allEmoji[893],
-		&allEmoji[894],
-		&allEmoji[895],
-		&allEmoji[896],
-		&allEmoji[897],
-		&allEmoji[898],
-		&allEmoji[899],
-		&allEmoji[900],
-		&allEmoji[901],
-		&allEmoji[902],
-		&allEmoji[903],
-		&allEmoji[904],
-		&allEmoji[905],
-		&allEmoji[906],
-		&allEmoji[907],
-		&allEmoji[908],
-		&allEmoji[909],
-		&allEmoji[910],
-		&allEmoji[911],
-		&allEmoji[912],
-		&allEmoji[913],
-		&allEmoji[914],
-		&allEmoji[915],
-		&allEmoji[916],
-		&allEmoji[917],
-		&allEmoji[918],
-		&allEmoji[919],
-		&allEmoji[920],
-		&allEmoji[921],
-		&allEmoji[922],
-		&allEmoji[923],
-		&allEmoji[924],
-		&allEmoji[925],
-		&allEmoji[926],
-		&allEmoji[927],
-		&allEmoji[928],
-		&allEmoji[929],
-		&allEmoji[930],
-		&allEmoji[931],
-		&allEmoji[932],
-		&allEmoji[933],
-		&allEmoji[934],
-		&allEmoji[935],
-		&allEmoji[936],
-		&allEmoji[937],
-		&allEmoji[938],
-		&allEmoji[939],
-		&allEmoji[940],
-		&allEmoji[941],
-		&allEmoji[942],
-		&allEmoji[943],
-		&allEmoji[944],
-		&allEmoji[945],
-		&allEmoji[946],
-		&allEmoji[947],
-		&allEmoji[948],
-		&allEmoji[949],
-		&allEmoji[950],
-		&allEmoji[951],
-		&allEmoji[952],
-		&allEmoji[953],
-		&allEmoji[954],
-		&allEmoji[955],
-		&allEmoji[956],
-		&allEmoji[957],
-		&allEmoji[958],
-		&allEmoji[959],
-		&allEmoji[960],
-		&allEmoji[961],
-		&allEmoji[962],
-		&allEmoji[963],
-		&allEmoji[964],
-		&allEmoji[965],
-		&allEmoji[966],
-		&allEmoji[967],
-		&allEmoji[968],
-		&allEmoji[969],
-		&allEmoji[970],
-		&allEmoji[971],
-		&allEmoji[972],
-		&allEmoji[973],
-		&allEmoji[974],
-		&allEmoji[975],
-		&allEmoji[976],
-		&allEmoji[977],
-		&allEmoji[978],
-		&allEmoji[979],
-		&allEmoji[980],
-		&allEmoji[981],
-		&allEmoji[982],
-		&allEmoji[983],
-		&allEmoji[984],
-		&allEmoji[985],
-		&allEmoji[986],
-		&allEmoji[987],
-		&allEmoji[988],
-		&allEmoji[989],
-		&allEmoji[990],
-		&allEmoji[991],
-		&allEmoji[992],
-		&allEmoji[993],
-		&allEmoji[994],
-		&allEmoji[995],
-		&allEmoji[996],
-		&allEmoji[997],
-		&allEmoji[998],
-		&allEmoji[999],
-		&allEmoji[1000],
-		&allEmoji[1001],
-		&allEmoji[1002],
-		&allEmoji[1003],
-		&allEmoji[1004],
-		&allEmoji[1005],
-		&allEmoji[1006],
-		&allEmoji[1007],
-		&allEmoji[1008],
-		&allEmoji[1009],
-		&allEmoji[1010],
-		&allEmoji[1011],
-		&allEmoji[1012],
-		&allEmoji[1013],
-		&allEmoji[1014],
-		&allEmoji[1015],
-		&allEmoji[1016],
-		&allEmoji[1017],
-		&allEmoji[1018],
-		&allEmoji[1019],
-		&allEmoji[1020],
-		&allEmoji[1021],
-		&allEmoji[1022],
-		&allEmoji[1023],
-		&allEmoji[1024],
-		&allEmoji[1025],
-		&allEmoji[1026],
-		&allEmoji[1027],
-		&allEmoji[1028],
-		&allEmoji[1029],
-		&allEmoji[1030],
-		&allEmoji[1031],
-		&allEmoji[1032],
-		&allEmoji[1033],
-		&allEmoji[1034],
-		&allEmoji[1035],
-		&allEmoji[1036],
-		&allEmoji[1037],
-		&allEmoji[1038],
-		&allEmoji[1039],
-		&allEmoji[1040],
-		&allEmoji[1041],
-		&allEmoji[1042],
-		&allEmoji[1043],
-		&allEmoji[1044],
-		&allEmoji[1045],
-		&allEmoji[1046],
-		&allEmoji[1047],
-		&allEmoji[1048],
-		&allEmoji[1049],
-		&allEmoji[1050],
-		&allEmoji[1051],
-		&allEmoji[1052],
-		&allEmoji[1053],
-		&allEmoji[1054],
-		&allEmoji[1055],
-		&allEmoji[1056],
-		&allEmoji[1057],
-		&allEmoji[1058],
-		&allEmoji[1059],
-		&allEmoji[1060],
-		&allEmoji[1061],
-		&allEmoji[1062],
-		&allEmoji[1063],
-		&allEmoji[1064],
-		&allEmoji[1065],
-		&allEmoji[1066],
-		&allEmoji[1067],
-		&allEmoji[1068],
-		&allEmoji[1069],
-		&allEmoji[1070],
-		&allEmoji[1071],
-		&allEmoji[1072],
-		&allEmoji[1073],
-	},
-}
-
-var tags = []string{
-	"911",
-	"accessibility",
-	"airport",
-	"alphabet",
-	"amazed",
-	"america",
-	"angel",
-	"angry",
-	"announcement",
-	"annoyed",
-	"applause",
-	"approve",
-	"archery",
-	"attack",
-	"aubergine",
-	"autumn",
-	"award",
-	"bag",
-	"bags",
-	"bang",
-	"bath",
-	"bawling",
-	"beach",
-	"beach_umbrella",
-	"beauty",
-	"bicep",
-	"bicycle",
-	"billiards",
-	"birthday",
-	"blind",
-	"block",
-	"blonde",
-	"blow",
-	"blush",
-	"boom",
-	"bottle",
-	"boy",
-	"breakfast",
-	"british",
-	"bubbly",
-	"bug",
-	"bunny",
-	"burger",
-	"burma",
-	"burn",
-	"bury",
-	"business",
-	"cafe",
-	"calendar",
-	"call",
-	"camping",
-	"canada",
-	"celebration",
-	"chicken",
-	"child",
-	"china",
-	"chocolates",
-	"chop",
-	"christmas",
-	"cigarette",
-	"classy",
-	"cloud",
-	"cold",
-	"college",
-	"comment",
-	"confused",
-	"console",
-	"contest",
-	"controller",
-	"cool",
-	"couple",
-	"crap",
-	"cream",
-	"crown",
-	"cruise",
-	"crush",
-	"cry",
-	"cut",
-	"cutlery",
-	"dad",
-	"danger",
-	"date",
-	"dead",
-	"deaf",
-	"desert",
-	"design",
-	"desktop",
-	"dessert",
-	"devil",
-	"diamond",
-	"dice",
-	"dining",
-	"dinner",
-	"directory",
-	"disapprove",
-	"document",
-	"dog",
-	"dollar",
-	"drama",
-	"dress",
-	"drink",
-	"drinks",
-	"earphones",
-	"education",
-	"email",
-	"emergency",
-	"engaged",
-	"envelope",
-	"environment",
-	"espresso",
-	"evil",
-	"exercise",
-	"explode",
-	"fast",
-	"father",
-	"feet",
-	"festival",
-	"film",
-	"finish",
-	"flag",
-	"flex",
-	"flight",
-	"flirt",
-	"flower",
-	"flowers",
-	"forbidden",
-	"formal",
-	"fortune",
-	"france",
-	"french",
-	"fresh",
-	"fruit",
-	"funeral",
-	"gambling",
-	"game",
-	"gasp",
-	"geek",
-	"germany",
-	"girls",
-	"glasses",
-	"global",
-	"globe",
-	"gold",
-	"goodbye",
-	"graduation",
-	"graph",
-	"green",
-	"group",
-	"gym",
-	"haha",
-	"halloween",
-	"halt",
-	"happy",
-	"hat",
-	"health",
-	"hear",
-	"heart",
-	"helmet",
-	"help",
-	"highfive",
-	"home",
-	"hooray",
-	"hope",
-	"horns",
-	"horror",
-	"hospital",
-	"hot",
-	"hurt",
-	"hush",
-	"idea",
-	"ignore",
-	"ill",
-	"impressed",
-	"incoming",
-	"information",
-	"international",
-	"investigate",
-	"italy",
-	"ivory",
-	"japan",
-	"joy",
-	"karl",
-	"keeling",
-	"king",
-	"kiss",
-	"korea",
-	"laboratory",
-	"launch",
-	"law",
-	"leaf",
-	"letter",
-	"letters",
-	"library",
-	"lick",
-	"light",
-	"lightning",
-	"limit",
-	"lipstick",
-	"listen",
-	"location",
-	"lock",
-	"look",
-	"loop",
-	"love",
-	"luck",
-	"mad",
-	"makeup",
-	"manicure",
-	"marathon",
-	"marriage",
-	"meat",
-	"medicine",
-	"meh",
-	"metrics",
-	"milestone",
-	"milk",
-	"mobile",
-	"money",
-	"monkey",
-	"monster",
-	"morning",
-	"movie",
-	"music",
-	"mustache",
-	"mute",
-	"needle",
-	"nervous",
-	"newborn",
-	"night",
-	"noodle",
-	"note",
-	"notification",
-	"number",
-	"numbers",
-	"off",
-	"ok",
-	"oops",
-	"orbit",
-	"paint",
-	"pants",
-	"parents",
-	"party",
-	"password",
-	"pasta",
-	"peace",
-	"perfect",
-	"pet",
-	"phew",
-	"phone",
-	"photo",
-	"piano",
-	"pirate",
-	"plant",
-	"play",
-	"please",
-	"pleased",
-	"podcast",
-	"poison",
-	"police",
-	"pool",
-	"power",
-	"praise",
-	"prank",
-	"present",
-	"press",
-	"pride",
-	"private",
-	"prosper",
-	"proud",
-	"queen",
-	"rain",
-	"respect",
-	"restroom",
-	"retro",
-	"return",
-	"rich",
-	"rock",
-	"royal",
-	"running",
-	"russia",
-	"sad",
-	"save",
-	"scared",
-	"schedule",
-	"science",
-	"score",
-	"screen",
-	"sea",
-	"search",
-	"security",
-	"see",
-	"semaphore",
-	"shiny",
-	"ship",
-	"shipping",
-	"shirt",
-	"shocked",
-	"shoe",
-	"shoot",
-	"shower",
-	"shuffle",
-	"sick",
-	"signal",
-	"silence",
-	"silly",
-	"sing",
-	"skating",
-	"skyline",
-	"sleeping",
-	"sleuth",
-	"slow",
-	"smartphone",
-	"smell",
-	"smile",
-	"smug",
-	"sneaker",
-	"sound",
-	"spa",
-	"space",
-	"spain",
-	"speechless",
-	"speed",
-	"spicy",
-	"spock",
-	"sport",
-	"sports",
-	"spring",
-	"star",
-	"stats",
-	"stone",
-	"stop",
-	"strong",
-	"struggling",
-	"stunned",
-	"subscription",
-	"summer",
-	"surprise",
-	"sweat",
-	"sweet",
-	"swirl",
-	"sync",
-	"tag",
-	"target",
-	"taste",
-	"team",
-	"tear",
-	"tears",
-	"tempura",
-	"thanks",
-	"thanksgiving",
-	"theater",
-	"thinking",
-	"thunder",
-	"time",
-	"tired",
-	"toast",
-	"toilet",
-	"tongue",
-	"tool",
-	"tracks",
-	"trademark",
-	"train",
-	"trash",
-	"travel",
-	"turkey",
-	"ufo",
-	"united",
-	"university",
-	"upset",
-	"user",
-	"users",
-	"vacation",
-	"victory",
-	"video",
-	"volume",
-	"watch",
-	"water",
-	"wc",
-	"weapon",
-	"weather",
-	"wedding",
-	"whew",
-	"whine",
-	"wifi",
-	"wind",
-	"winner",
-	"winter",
-	"wip",
-	"wish",
-	"wood",
-	"workout",
-	"world",
-	"wow",
-	"yes",
-	"zoom",
-	"zzz",
-}
-
-var byTag = [][]*emoji{
-	// 911
-	{
-		&allEmoji[527],
-	},
-	// accessibility
-	{
-		&allEmoji[919],
-	},
-	// airport
-	{
-		&allEmoji[917],
-	},
-	// alphabet
-	{
-		&allEmoji[932],
-	},
-	// amazed
-	{
-		&allEmoji[62],
-	},
-	// america
-	{
-		&allEmoji[1308],
-	},
-	// angel
-	{
-		&allEmoji[8],
-	},
-	// angry
-	{
-		&allEmoji[39],
-		&allEmoji[71],
-		&allEmoji[878],
-	},
-	// announcement
-	{
-		&allEmoji[1038],
-	},
-	// annoyed
-	{
-		&allEmoji[38],
-	},
-	// applause
-	{
-		&allEmoji[90],
-	},
-	// approve
-	{
-		&allEmoji[91],
-	},
-	// archery
-	{
-		&allEmoji[461],
-	},
-	// attack
-	{
-		&allEmoji[93],
-	},
-	// aubergine
-	{
-		&allEmoji[395],
-	},
-	// autumn
-	{
-		&allEmoji[319],
-	},
-	// award
-	{
-		&allEmoji[491],
-	},
-	// bag
-	{
-		&allEmoji[226],
-		&allEmoji[228],
-	},
-	// bags
-	{
-		&allEmoji[722],
-	},
-	// bang
-	{
-		&allEmoji[886],
-	},
-	// bath
-	{
-		&allEmoji[710],
-	},
-	// bawling
-	{
-		&allEmoji[60],
-	},
-	// beach
-	{
-		&allEmoji[210],
-		&allEmoji[332],
-	},
-	// beach_umbrella
-	{
-		&allEmoji[720],
-	},
-	// beauty
-	{
-		&allEmoji[112],
-		&allEmoji[160],
-	},
-	// bicep
-	{
-		&allEmoji[106],
-	},
-	// bicycle
-	{
-		&allEmoji[526],
-	},
-	// billiards
-	{
-		&allEmoji[455],
-	},
-	// birthday
-	{
-		&allEmoji[723],
-		&allEmoji[724],
-	},
-	// blind
-	{
-		&allEmoji[250],
-	},
-	// block
-	{
-		&allEmoji[875],
-	},
-	// blonde
-	{
-		&allEmoji[143],
-	},
-	// blow
-	{
-		&allEmoji[373],
-	},
-	// blush
-	{
-		&allEmoji[13],
-	},
-	// boom
-	{
-		&allEmoji[690],
-	},
-	// bottle
-	{
-		&allEmoji[441],
-	},
-	// boy
-	{
-		&allEmoji[128],
-	},
-	// breakfast
-	{
-		&allEmoji[405],
-		&allEmoji[443],
-	},
-	// british
-	{
-		&allEmoji[1307],
-	},
-	// bubbly
-	{
-		&allEmoji[441],
-	},
-	// bug
-	{
-		&allEmoji[267],
-	},
-	// bunny
-	{
-		&allEmoji[165],
-		&allEmoji[166],
-		&allEmoji[238],
-	},
-	// burger
-	{
-		&allEmoji[406],
-	},
-	// burma
-	{
-		&allEmoji[1225],
-	},
-	// burn
-	{
-		&allEmoji[366],
-	},
-	// bury
-	{
-		&allEmoji[92],
-	},
-	// business
-	{
-		&allEmoji[229],
-	},
-	// cafe
-	{
-		&allEmoji[444],
-	},
-	// calendar
-	{
-		&allEmoji[758],
-	},
-	// call
-	{
-		&allEmoji[629],
-		&allEmoji[649],
-	},
-	// camping
-	{
-		&allEmoji[583],
-	},
-	// canada
-	{
-		&allEmoji[320],
-	},
-	// celebration
-	{
-		&allEmoji[441],
-		&allEmoji[600],
-	},
-	// chicken
-	{
-		&allEmoji[402],
-	},
-	// child
-	{
-		&allEmoji[122],
-		&allEmoji[123],
-		&allEmoji[124],
-		&allEmoji[180],
-	},
-	// china
-	{
-		&allEmoji[1120],
-	},
-	// chocolates
-	{
-		&allEmoji[818],
-	},
-	// chop
-	{
-		&allEmoji[691],
-	},
-	// christmas
-	{
-		&allEmoji[142],
-		&allEmoji[370],
-		&allEmoji[723],
-	},
-	// cigarette
-	{
-		&allEmoji[695],
-	},
-	// classy
-	{
-		&allEmoji[221],
-	},
-	// cloud
-	{
-		&allEmoji[358],
-	},
-	// cold
-	{
-		&allEmoji[368],
-	},
-	// college
-	{
-		&allEmoji[222],
-	},
-	// comment
-	{
-		&allEmoji[1040],
-	},
-	// confused
-	{
-		&allEmoji[888],
-	},
-	// console
-	{
-		&allEmoji[508],
-	},
-	// contest
-	{
-		&allEmoji[491],
-	},
-	// controller
-	{
-		&allEmoji[508],
-	},
-	// cool
-	{
-		&allEmoji[26],
-	},
-	// couple
-	{
-		&allEmoji[172],
-		&allEmoji[173],
-	},
-	// crap
-	{
-		&allEmoji[69],
-	},
-	// cream
-	{
-		&allEmoji[677],
-	},
-	// crown
-	{
-		&allEmoji[143],
-	},
-	// cruise
-	{
-		&allEmoji[556],
-	},
-	// crush
-	{
-		&allEmoji[16],
-	},
-	// cry
-	{
-		&allEmoji[60],
-	},
-	// cut
-	{
-		&allEmoji[691],
-		&allEmoji[783],
-	},
-	// cutlery
-	{
-		&allEmoji[446],
-	},
-	// dad
-	{
-		&allEmoji[125],
-	},
-	// danger
-	{
-		&allEmoji[75],
-		&allEmoji[76],
-	},
-	// date
-	{
-		&allEmoji[171],
-		&allEmoji[172],
-		&allEmoji[173],
-	},
-	// dead
-	{
-		&allEmoji[75],
-	},
-	// deaf
-	{
-		&allEmoji[251],
-	},
-	// desert
-	{
-		&allEmoji[286],
-	},
-	// design
-	{
-		&allEmoji[497],
-	},
-	// desktop
-	{
-		&allEmoji[630],
-	},
-	// dessert
-	{
-		&allEmoji[427],
-	},
-	// devil
-	{
-		&allEmoji[70],
-		&allEmoji[71],
-	},
-	// diamond
-	{
-		&allEmoji[679],
-	},
-	// dice
-	{
-		&allEmoji[510],
-	},
-	// dining
-	{
-		&allEmoji[447],
-	},
-	// dinner
-	{
-		&allEmoji[447],
-	},
-	// directory
-	{
-		&allEmoji[764],
-	},
-	// disapprove
-	{
-		&allEmoji[92],
-	},
-	// document
-	{
-		&allEmoji[748],
-		&allEmoji[750],
-		&allEmoji[797],
-	},
-	// dog
-	{
-		&allEmoji[300],
-	},
-	// dollar
-	{
-		&allEmoji[672],
-		&allEmoji[677],
-	},
-	// drama
-	{
-		&allEmoji[496],
-	},
-	// dress
-	{
-		&allEmoji[164],
-	},
-	// drink
-	{
-		&allEmoji[436],
-		&allEmoji[439],
-	},
-	// drinks
-	{
-		&allEmoji[437],
-	},
-	// earphones
-	{
-		&allEmoji[501],
-	},
-	// education
-	{
-		&allEmoji[222],
-	},
-	// email
-	{
-		&allEmoji[736],
-	},
-	// emergency
-	{
-		&allEmoji[527],
-		&allEmoji[872],
-	},
-	// engaged
-	{
-		&allEmoji[232],
-	},
-	// envelope
-	{
-		&allEmoji[736],
-	},
-	// environment
-	{
-		&allEmoji[901],
-	},
-	// espresso
-	{
-		&allEmoji[444],
-	},
-	// evil
-	{
-		&allEmoji[70],
-		&allEmoji[71],
-	},
-	// exercise
-	{
-		&allEmoji[169],
-		&allEmoji[170],
-	},
-	// explode
-	{
-		&allEmoji[367],
-	},
-	// fast
-	{
-		&allEmoji[373],
-	},
-	// father
-	{
-		&allEmoji[125],
-	},
-	// feet
-	{
-		&allEmoji[214],
-	},
-	// festival
-	{
-		&allEmoji[600],
-	},
-	// film
-	{
-		&allEmoji[499],
-		&allEmoji[646],
-		&allEmoji[929],
-	},
-	// finish
-	{
-		&allEmoji[790],
-	},
-	// flag
-	{
-		&allEmoji[1157],
-		&allEmoji[1307],
-		&allEmoji[1308],
-	},
-	// flex
-	{
-		&allEmoji[106],
-	},
-	// flight
-	{
-		&allEmoji[548],
-		&allEmoji[549],
-	},
-	// flirt
-	{
-		&allEmoji[9],
-		&allEmoji[17],
-	},
-	// flower
-	{
-		&allEmoji[324],
-		&allEmoji[325],
-		&allEmoji[327],
-	},
-	// flowers
-	{
-		&allEmoji[328],
-	},
-	// forbidden
-	{
-		&allEmoji[875],
-	},
-	// formal
-	{
-		&allEmoji[208],
-	},
-	// fortune
-	{
-		&allEmoji[699],
-	},
-	// france
-	{
-		&allEmoji[1150],
-	},
-	// french
-	{
-		&allEmoji[1150],
-	},
-	// fresh
-	{
-		&allEmoji[941],
-	},
-	// fruit
-	{
-		&allEmoji[381],
-		&allEmoji[386],
-		&allEmoji[389],
-		&allEmoji[391],
-	},
-	// funeral
-	{
-		&allEmoji[696],
-	},
-	// gambling
-	{
-		&allEmoji[510],
-	},
-	// game
-	{
-		&allEmoji[78],
-	},
-	// gasp
-	{
-		&allEmoji[62],
-	},
-	// geek
-	{
-		&allEmoji[25],
-	},
-	// germany
-	{
-		&allEmoji[1157],
-	},
-	// girls
-	{
-		&allEmoji[126],
-	},
-	// glasses
-	{
-		&allEmoji[25],
-		&allEmoji[230],
-	},
-	// global
-	{
-		&allEmoji[908],
-	},
-	// globe
-	{
-		&allEmoji[334],
-		&allEmoji[335],
-		&allEmoji[336],
-	},
-	// gold
-	{
-		&allEmoji[489],
-	},
-	// goodbye
-	{
-		&allEmoji[95],
-	},
-	// graduation
-	{
-		&allEmoji[222],
-	},
-	// graph
-	{
-		&allEmoji[753],
-		&allEmoji[754],
-	},
-	// green
-	{
-		&allEmoji[443],
-		&allEmoji[901],
-	},
-	// group
-	{
-		&allEmoji[121],
-	},
-	// gym
-	{
-		&allEmoji[468],
-		&allEmoji[469],
-	},
-	// haha
-	{
-		&allEmoji[4],
-		&allEmoji[7],
-	},
-	// halloween
-	{
-		&allEmoji[74],
-		&allEmoji[331],
-	},
-	// halt
-	{
-		&allEmoji[150],
-		&allEmoji[151],
-	},
-	// happy
-	{
-		&allEmoji[0],
-		&allEmoji[4],
-		&allEmoji[5],
-		&allEmoji[7],
-	},
-	// hat
-	{
-		&allEmoji[221],
-	},
-	// health
-	{
-		&allEmoji[706],
-		&allEmoji[707],
-	},
-	// hear
-	{
-		&allEmoji[115],
-	},
-	// heart
-	{
-		&allEmoji[817],
-	},
-	// helmet
-	{
-		&allEmoji[137],
-	},
-	// help
-	{
-		&allEmoji[872],
-	},
-	// highfive
-	{
-		&allEmoji[103],
-	},
-	// home
-	{
-		&allEmoji[180],
-	},
-	// hooray
-	{
-		&allEmoji[89],
-	},
-	// hope
-	{
-		&allEmoji[107],
-	},
-	// horns
-	{
-		&allEmoji[70],
-		&allEmoji[71],
-	},
-	// horror
-	{
-		&allEmoji[50],
-		&allEmoji[86],
-	},
-	// hospital
-	{
-		&allEmoji[707],
-	},
-	// hot
-	{
-		&allEmoji[6],
-	},
-	// hurt
-	{
-		&allEmoji[66],
-	},
-	// hush
-	{
-		&allEmoji[63],
-		&allEmoji[252],
-	},
-	// idea
-	{
-		&allEmoji[667],
-	},
-	// ignore
-	{
-		&allEmoji[250],
-	},
-	// ill
-	{
-		&allEmoji[64],
-	},
-	// impressed
-	{
-		&allEmoji[49],
-	},
-	// incoming
-	{
-		&allEmoji[629],
-	},
-	// information
-	{
-		&allEmoji[149],
-	},
-	// international
-	{
-		&allEmoji[334],
-		&allEmoji[335],
-		&allEmoji[336],
-		&allEmoji[908],
-	},
-	// investigate
-	{
-		&allEmoji[704],
-	},
-	// italy
-	{
-		&allEmoji[1182],
-	},
-	// ivory
-	{
-		&allEmoji[1183],
-	},
-	// japan
-	{
-		&allEmoji[1185],
-	},
-	// joy
-	{
-		&allEmoji[4],
-		&allEmoji[5],
-	},
-	// karl
-	{
-		&allEmoji[572],
-	},
-	// keeling
-	{
-		&allEmoji[1122],
-	},
-	// king
-	{
-		&allEmoji[223],
-	},
-	// kiss
-	{
-		&allEmoji[113],
-	},
-	// korea
-	{
-		&allEmoji[1280],
-	},
-	// laboratory
-	{
-		&allEmoji[704],
-	},
-	// launch
-	{
-		&allEmoji[557],
-	},
-	// law
-	{
-		&allEmoji[135],
-	},
-	// leaf
-	{
-		&allEmoji[318],
-	},
-	// letter
-	{
-		&allEmoji[732],
-	},
-	// letters
-	{
-		&allEmoji[934],
-	},
-	// library
-	{
-		&allEmoji[776],
-	},
-	// lick
-	{
-		&allEmoji[14],
-	},
-	// light
-	{
-		&allEmoji[667],
-	},
-	// lightning
-	{
-		&allEmoji[365],
-	},
-	// limit
-	{
-		&allEmoji[873],
-	},
-	// lipstick
-	{
-		&allEmoji[213],
-	},
-	// listen
-	{
-		&allEmoji[115],
-	},
-	// location
-	{
-		&allEmoji[784],
-		&allEmoji[785],
-	},
-	// lock
-	{
-		&allEmoji[713],
-	},
-	// look
-	{
-		&allEmoji[118],
-	},
-	// loop
-	{
-		&allEmoji[986],
-	},
-	// love
-	{
-		&allEmoji[16],
-		&allEmoji[805],
-		&allEmoji[817],
-	},
-	// luck
-	{
-		&allEmoji[315],
-	},
-	// mad
-	{
-		&allEmoji[38],
-	},
-	// makeup
-	{
-		&allEmoji[212],
-	},
-	// manicure
-	{
-		&allEmoji[112],
-	},
-	// marathon
-	{
-		&allEmoji[169],
-		&allEmoji[170],
-		&allEmoji[488],
-	},
-	// marriage
-	{
-		&allEmoji[144],
-		&allEmoji[232],
-		&allEmoji[620],
-	},
-	// meat
-	{
-		&allEmoji[402],
-	},
-	// medicine
-	{
-		&allEmoji[706],
-	},
-	// meh
-	{
-		&allEmoji[30],
-		&allEmoji[32],
-	},
-	// metrics
-	{
-		&allEmoji[752],
-		&allEmoji[753],
-		&allEmoji[754],
-	},
-	// milestone
-	{
-		&allEmoji[790],
-	},
-	// milk
-	{
-		&allEmoji[445],
-	},
-	// mobile
-	{
-		&allEmoji[628],
-	},
-	// money
-	{
-		&allEmoji[673],
-	},
-	// monkey
-	{
-		&allEmoji[250],
-		&allEmoji[251],
-		&allEmoji[252],
-	},
-	// monster
-	{
-		&allEmoji[72],
-	},
-	// morning
-	{
-		&allEmoji[660],
-	},
-	// movie
-	{
-		&allEmoji[929],
-	},
-	// music
-	{
-		&allEmoji[501],
-		&allEmoji[991],
-	},
-	// mustache
-	{
-		&allEmoji[125],
-	},
-	// mute
-	{
-		&allEmoji[29],
-		&allEmoji[252],
-		&allEmoji[850],
-	},
-	// needle
-	{
-		&allEmoji[707],
-	},
-	// nervous
-	{
-		&allEmoji[37],
-		&allEmoji[52],
-		&allEmoji[57],
-	},
-	// newborn
-	{
-		&allEmoji[122],
-	},
-	// night
-	{
-		&allEmoji[350],
-	},
-	// noodle
-	{
-		&allEmoji[413],
-	},
-	// note
-	{
-		&allEmoji[797],
-	},
-	// notification
-	{
-		&allEmoji[1036],
-	},
-	// number
-	{
-		&allEmoji[955],
-	},
-	// numbers
-	{
-		&allEmoji[954],
-	},
-	// off
-	{
-		&allEmoji[850],
-		&allEmoji[1035],
-	},
-	// ok
-	{
-		&allEmoji[91],
-	},
-	// oops
-	{
-		&allEmoji[51],
-	},
-	// orbit
-	{
-		&allEmoji[558],
-	},
-	// paint
-	{
-		&allEmoji[497],
-	},
-	// pants
-	{
-		&allEmoji[207],
-	},
-	// parents
-	{
-		&allEmoji[180],
-	},
-	// party
-	{
-		&allEmoji[428],
-		&allEmoji[724],
-		&allEmoji[728],
-	},
-	// password
-	{
-		&allEmoji[713],
-	},
-	// pasta
-	{
-		&allEmoji[410],
-	},
-	// peace
-	{
-		&allEmoji[102],
-		&allEmoji[298],
-	},
-	// perfect
-	{
-		&allEmoji[892],
-	},
-	// pet
-	{
-		&allEmoji[234],
-		&allEmoji[235],
-		&allEmoji[237],
-	},
-	// phew
-	{
-		&allEmoji[57],
-	},
-	// phone
-	{
-		&allEmoji[649],
-	},
-	// photo
-	{
-		&allEmoji[643],
-		&allEmoji[644],
-	},
-	// piano
-	{
-		&allEmoji[503],
-	},
-	// pirate
-	{
-		&allEmoji[76],
-	},
-	// plant
-	{
-		&allEmoji[312],
-	},
-	// play
-	{
-		&allEmoji[508],
-	},
-	// please
-	{
-		&allEmoji[107],
-	},
-	// pleased
-	{
-		&allEmoji[5],
-		&allEmoji[13],
-	},
-	// podcast
-	{
-		&allEmoji[654],
-		&allEmoji[655],
-	},
-	// poison
-	{
-		&allEmoji[75],
-	},
-	// police
-	{
-		&allEmoji[135],
-	},
-	// pool
-	{
-		&allEmoji[455],
-	},
-	// power
-	{
-		&allEmoji[94],
-		&allEmoji[665],
-	},
-	// praise
-	{
-		&allEmoji[90],
-	},
-	// prank
-	{
-		&allEmoji[21],
-		&allEmoji[22],
-	},
-	// present
-	{
-		&allEmoji[723],
-	},
-	// press
-	{
-		&allEmoji[767],
-		&allEmoji[768],
-	},
-	// pride
-	{
-		&allEmoji[791],
-	},
-	// private
-	{
-		&allEmoji[801],
-	},
-	// prosper
-	{
-		&allEmoji[108],
-	},
-	// proud
-	{
-		&allEmoji[10],
-	},
-	// queen
-	{
-		&allEmoji[223],
-	},
-	// rain
-	{
-		&allEmoji[233],
-		&allEmoji[377],
-	},
-	// respect
-	{
-		&allEmoji[146],
-		&allEmoji[147],
-	},
-	// restroom
-	{
-		&allEmoji[921],
-	},
-	// retro
-	{
-		&allEmoji[78],
-	},
-	// return
-	{
-		&allEmoji[982],
-	},
-	// rich
-	{
-		&allEmoji[24],
-	},
-	// rock
-	{
-		&allEmoji[506],
-	},
-	// royal
-	{
-		&allEmoji[143],
-		&allEmoji[223],
-	},
-	// running
-	{
-		&allEmoji[219],
-	},
-	// russia
-	{
-		&allEmoji[1256],
-	},
-	// sad
-	{
-		&allEmoji[36],
-		&allEmoji[56],
-		&allEmoji[60],
-		&allEmoji[87],
-		&allEmoji[158],
-	},
-	// save
-	{
-		&allEmoji[639],
-	},
-	// scared
-	{
-		&allEmoji[51],
-	},
-	// schedule
-	{
-		&allEmoji[757],
-		&allEmoji[758],
-	},
-	// science
-	{
-		&allEmoji[704],
-	},
-	// score
-	{
-		&allEmoji[892],
-	},
-	// screen
-	{
-		&allEmoji[630],
-	},
-	// sea
-	{
-		&allEmoji[278],
-		&allEmoji[332],
-		&allEmoji[380],
-	},
-	// search
-	{
-		&allEmoji[803],
-	},
-	// security
-	{
-		&allEmoji[800],
-		&allEmoji[801],
-		&allEmoji[802],
-	},
-	// see
-	{
-		&allEmoji[118],
-	},
-	// semaphore
-	{
-		&allEmoji[564],
-	},
-	// shiny
-	{
-		&allEmoji[354],
-	},
-	// ship
-	{
-		&allEmoji[554],
-		&allEmoji[557],
-		&allEmoji[560],
-	},
-	// shipping
-	{
-		&allEmoji[739],
-	},
-	// shirt
-	{
-		&allEmoji[208],
-	},
-	// shocked
-	{
-		&allEmoji[50],
-		&allEmoji[51],
-	},
-	// shoe
-	{
-		&allEmoji[215],
-		&allEmoji[216],
-	},
-	// shoot
-	{
-		&allEmoji[689],
-	},
-	// shower
-	{
-		&allEmoji[485],
-	},
-	// shuffle
-	{
-		&allEmoji[985],
-	},
-	// sick
-	{
-		&allEmoji[64],
-		&allEmoji[65],
-	},
-	// signal
-	{
-		&allEmoji[664],
-	},
-	// silence
-	{
-		&allEmoji[29],
-		&allEmoji[53],
-		&allEmoji[63],
-	},
-	// silly
-	{
-		&allEmoji[21],
-	},
-	// sing
-	{
-		&allEmoji[500],
-	},
-	// skating
-	{
-		&allEmoji[464],
-	},
-	// skyline
-	{
-		&allEmoji[594],
-	},
-	// sleeping
-	{
-		&allEmoji[68],
-	},
-	// sleuth
-	{
-		&allEmoji[140],
-		&allEmoji[141],
-	},
-	// slow
-	{
-		&allEmoji[266],
-		&allEmoji[273],
-	},
-	// smartphone
-	{
-		&allEmoji[628],
-	},
-	// smell
-	{
-		&allEmoji[116],
-	},
-	// smile
-	{
-		&allEmoji[0],
-	},
-	// smug
-	{
-		&allEmoji[28],
-		&allEmoji[48],
-	},
-	// sneaker
-	{
-		&allEmoji[219],
-	},
-	// sound
-	{
-		&allEmoji[115],
-		&allEmoji[1031],
-		&allEmoji[1036],
-	},
-	// spa
-	{
-		&allEmoji[162],
-		&allEmoji[163],
-	},
-	// space
-	{
-		&allEmoji[558],
-	},
-	// spain
-	{
-		&allEmoji[1282],
-	},
-	// speechless
-	{
-		&allEmoji[53],
-	},
-	// speed
-	{
-		&allEmoji[292],
-	},
-	// spicy
-	{
-		&allEmoji[396],
-	},
-	// spock
-	{
-		&allEmoji[108],
-	},
-	// sport
-	{
-		&allEmoji[219],
-	},
-	// sports
-	{
-		&allEmoji[448],
-		&allEmoji[449],
-		&allEmoji[450],
-		&allEmoji[451],
-		&allEmoji[452],
-	},
-	// spring
-	{
-		&allEmoji[327],
-	},
-	// star
-	{
-		&allEmoji[353],
-	},
-	// stats
-	{
-		&allEmoji[752],
-	},
-	// stone
-	{
-		&allEmoji[721],
-	},
-	// stop
-	{
-		&allEmoji[103],
-		&allEmoji[150],
-		&allEmoji[151],
-	},
-	// strong
-	{
-		&allEmoji[106],
-	},
-	// struggling
-	{
-		&allEmoji[44],
-	},
-	// stunned
-	{
-		&allEmoji[55],
-	},
-	// subscription
-	{
-		&allEmoji[678],
-	},
-	// summer
-	{
-		&allEmoji[349],
-		&allEmoji[440],
-	},
-	// surprise
-	{
-		&allEmoji[49],
-	},
-	// sweat
-	{
-		&allEmoji[57],
-	},
-	// sweet
-	{
-		&allEmoji[430],
-	},
-	// swirl
-	{
-		&allEmoji[911],
-	},
-	// sync
-	{
-		&allEmoji[988],
-	},
-	// tag
-	{
-		&allEmoji[740],
-	},
-	// target
-	{
-		&allEmoji[493],
-	},
-	// taste
-	{
-		&allEmoji[114],
-	},
-	// team
-	{
-		&allEmoji[121],
-	},
-	// tear
-	{
-		&allEmoji[56],
-		&allEmoji[87],
-	},
-	// tears
-	{
-		&allEmoji[3],
-	},
-	// tempura
-	{
-		&allEmoji[404],
-	},
-	// thanks
-	{
-		&allEmoji[146],
-		&allEmoji[147],
-	},
-	// thanksgiving
-	{
-		&allEmoji[297],
-	},
-	// theater
-	{
-		&allEmoji[496],
-	},
-	// thinking
-	{
-		&allEmoji[1041],
-	},
-	// thunder
-	{
-		&allEmoji[365],
-	},
-	// time
-	{
-		&allEmoji[627],
-		&allEmoji[662],
-		&allEmoji[663],
-	},
-	// tired
-	{
-		&allEmoji[47],
-		&allEmoji[58],
-	},
-	// toast
-	{
-		&allEmoji[400],
-	},
-	// toilet
-	{
-		&allEmoji[921],
-		&allEmoji[927],
-	},
-	// tongue
-	{
-		&allEmoji[14],
-	},
-	// tool
-	{
-		&allEmoji[681],
-		&allEmoji[682],
-	},
-	// tracks
-	{
-		&allEmoji[214],
-	},
-	// trademark
-	{
-		&allEmoji[1001],
-	},
-	// train
-	{
-		&allEmoji[538],
-		&allEmoji[539],
-		&allEmoji[542],
-	},
-	// trash
-	{
-		&allEmoji[670],
-	},
-	// travel
-	{
-		&allEmoji[566],
-	},
-	// turkey
-	{
-		&allEmoji[1300],
-	},
-	// ufo
-	{
-		&allEmoji[77],
-	},
-	// united
-	{
-		&allEmoji[1308],
-	},
-	// university
-	{
-		&allEmoji[222],
-	},
-	// upset
-	{
-		&allEmoji[46],
-	},
-	// user
-	{
-		&allEmoji[120],
-	},
-	// users
-	{
-		&allEmoji[121],
-	},
-	// vacation
-	{
-		&allEmoji[440],
-	},
-	// victory
-	{
-		&allEmoji[102],
-	},
-	// video
-	{
-		&allEmoji[646],
-	},
-	// volume
-	{
-		&allEmoji[1031],
-		&allEmoji[1033],
-		&allEmoji[1034],
-		&allEmoji[1035],
-	},
-	// watch
-	{
-		&allEmoji[118],
-	},
-	// water
-	{
-		&allEmoji[378],
-		&allEmoji[379],
-	},
-	// wc
-	{
-		&allEmoji[709],
-	},
-	// weapon
-	{
-		&allEmoji[689],
-	},
-	// weather
-	{
-		&allEmoji[233],
-		&allEmoji[356],
-		&allEmoji[358],
-		&allEmoji[368],
-		&allEmoji[377],
-	},
-	// wedding
-	{
-		&allEmoji[144],
-		&allEmoji[232],
-	},
-	// whew
-	{
-		&allEmoji[15],
-	},
-	// whine
-	{
-		&allEmoji[46],
-	},
-	// wifi
-	{
-		&allEmoji[930],
-	},
-	// wind
-	{
-		&allEmoji[373],
-	},
-	// winner
-	{
-		&allEmoji[489],
-		&allEmoji[491],
-	},
-	// winter
-	{
-		&allEmoji[368],
-		&allEmoji[370],
-		&allEmoji[371],
-	},
-	// wip
-	{
-		&allEmoji[561],
-		&allEmoji[898],
-	},
-	// wish
-	{
-		&allEmoji[107],
-	},
-	// wood
-	{
-		&allEmoji[309],
-		&allEmoji[310],
-	},
-	// workout
-	{
-		&allEmoji[106],
-		&allEmoji[169],
-		&allEmoji[170],
-		&allEmoji[379],
-		&allEmoji[468],
-		&allEmoji[469],
-	},
-	// world
-	{
-		&allEmoji[334],
-		&allEmoji[335],
-		&allEmoji[336],
-		&allEmoji[908],
-	},
-	// wow
-	{
-		&allEmoji[49],
-	},
-	// yes
-	{
-		&allEmoji[938],
-	},
-	// zoom
-	{
-		&allEmoji[803],
-	},
-	// zzz
-	{
-		&allEmoji[67],
-	},
-}
-
-var byName = map[string]*emoji{
-	"😀":             &allEmoji[0],
-	":grinning:":    &allEmoji[0],
-	"😬":             &allEmoji[1],
-	":grimacing:":   &allEmoji[1],
-	"😁":             &allEmoji[2],
-	":grin:":        &allEmoji[2],
-	"😂":             &allEmoji[3],
-	":joy:":         &allEmoji[3],
-	"😃":             &allEmoji[4],
-	":smiley:":      &allEmoji[4],
-	"😄":             &allEmoji[5],
-	":smile:":       &allEmoji[5],
-	"😅":             &allEmoji[6],
-	":sweat_smile:": &allEmoji[6],
-	"😆":             &allEmoji[7],
-	":laughing:":    &allEmoji[7],
-	":satisfied:":   &allEmoji[7],
-	"😇":             &allEmoji[8],
-	":innocent:":    &allEmoji[8],
-	"😉":             &allEmoji[9],
-	":wink:":        &allEmoji[9],
-	"😊":             &allEmoji[10],
-	":blush:":       &allEmoji[10],
-	"🙂":             &allEmoji[11],
-	":slightly_smiling_face:": &allEmoji[11],
-	"🙃":                  &allEmoji[12],
-	":upside_down_face:": &allEmoji[12],
-	"☺️":                 &allEmoji[13],
-	":relaxed:":          &allEmoji[13],
-	"😋":                  &allEmoji[14],
-	":yum:":              &allEmoji[14],
-	"😌":                  &allEmoji[15],
-	":relieved:":         &allEmoji[15],
-	"😍":                  &allEmoji[16],
-	":heart_eyes:":       &allEmoji[16],
-	"😘":                  &allEmoji[17],
-	":kissing_heart:":    &allEmoji[17],
-	"😗":                  &allEmoji[18],
-	":kissing:":          &allEmoji[18],
-	"😙":                  &allEmoji[19],
-	":kissing_smiling_eyes:": &allEmoji[19],
-	"😚":                     &allEmoji[20],
-	":kissing_closed_eyes:": &allEmoji[20],
-	"😜":                     &allEmoji[21],
-	":stuck_out_tongue_winking_eye:": &allEmoji[21],
-	"😝": &allEmoji[22],
-	":stuck_out_tongue_closed_eyes:": &allEmoji[22],
-	"😛":                  &allEmoji[23],
-	":stuck_out_tongue:": &allEmoji[23],
-	"🤑":                  &allEmoji[24],
-	":money_mouth_face:": &allEmoji[24],
-	"🤓":                  &allEmoji[25],
-	":nerd_face:":        &allEmoji[25],
-	"😎":                  &allEmoji[26],
-	":sunglasses:":       &allEmoji[26],
-	"🤗":                  &allEmoji[27],
-	":hugs:":             &allEmoji[27],
-	"😏":                  &allEmoji[28],
-	":smirk:":            &allEmoji[28],
-	"😶":                  &allEmoji[29],
-	":no_mouth:":         &allEmoji[29],
-	"😐":                  &allEmoji[30],
-	":neutral_face:":     &allEmoji[30],
-	"😑":                  &allEmoji[31],
-	":expressionless:":   &allEmoji[31],
-	"😒":                  &allEmoji[32],
-	":unamused:":         &allEmoji[32],
-	"🙄":                  &allEmoji[33],
-	":roll_eyes:":        &allEmoji[33],
-	"🤔":                  &allEmoji[34],
-	":thinking:":         &allEmoji[34],
-	"😳":                  &allEmoji[35],
-	":flushed:":          &allEmoji[35],
-	"😞":                  &allEmoji[36],
-	":disappointed:":     &allEmoji[36],
-	"😟":                  &allEmoji[37],
-	":worried:":          &allEmoji[37],
-	"😠":                  &allEmoji[38],
-	":angry:":            &allEmoji[38],
-	"😡":                  &allEmoji[39],
-	":rage:":             &allEmoji[39],
-	":pout:":             &allEmoji[39],
-	"😔":                  &allEmoji[40],
-	":pensive:":          &allEmoji[40],
-	"😕":                  &allEmoji[41],
-	":confused:":         &allEmoji[41],
-	"🙁":                  &allEmoji[42],
-	":slightly_frowning_face:": &allEmoji[42],
-	"☹️":                       &allEmoji[43],
-	":frowning_face:":          &allEmoji[43],
-	"😣":                        &allEmoji[44],
-	":persevere:":              &allEmoji[44],
-	"😖":                        &allEmoji[45],
-	":confounded:":             &allEmoji[45],
-	"😫":                        &allEmoji[46],
-	":tired_face:":             &allEmoji[46],
-	"😩":                        &allEmoji[47],
-	":weary:":                  &allEmoji[47],
-	"😤":                        &allEmoji[48],
-	":triumph:":                &allEmoji[48],
-	"😮":                        &allEmoji[49],
-	":open_mouth:":             &allEmoji[49],
-	"😱":                        &allEmoji[50],
-	":scream:":                 &allEmoji[50],
-	"😨":                        &allEmoji[51],
-	":fearful:":                &allEmoji[51],
-	"😰":                        &allEmoji[52],
-	":cold_sweat:":             &allEmoji[52],
-	"😯":                        &allEmoji[53],
-	":hushed:":                 &allEmoji[53],
-	"😦":                        &allEmoji[54],
-	":frowning:":               &allEmoji[54],
-	"😧":                        &allEmoji[55],
-	":anguished:":              &allEmoji[55],
-	"😢":                        &allEmoji[56],
-	":cry:":                    &allEmoji[56],
-	"😥":                        &allEmoji[57],
-	":disappointed_relieved:": &allEmoji[57],
-	"😪":                   &allEmoji[58],
-	":sleepy:":            &allEmoji[58],
-	"😓":                   &allEmoji[59],
-	":sweat:":             &allEmoji[59],
-	"😭":                   &allEmoji[60],
-	":sob:":               &allEmoji[60],
-	"😵":                   &allEmoji[61],
-	":dizzy_face:":        &allEmoji[61],
-	"😲":                   &allEmoji[62],
-	":astonished:":        &allEmoji[62],
-	"🤐":                   &allEmoji[63],
-	":zipper_mouth_face:": &allEmoji[63],
-	"😷":                   &allEmoji[64],
-	":mask:":              &allEmoji[64],
-	"🤒":                   &allEmoji[65],
-	":face_with_thermometer:": &allEmoji[65],
-	"🤕": &allEmoji[66],
-	":face_with_head_bandage:": &allEmoji[66],
-	"😴":                      &allEmoji[67],
-	":sleeping:":             &allEmoji[67],
-	"💤":                      &allEmoji[68],
-	":zzz:":                  &allEmoji[68],
-	"💩":                      &allEmoji[69],
-	":hankey:":               &allEmoji[69],
-	":poop:":                 &allEmoji[69],
-	":shit:":                 &allEmoji[69],
-	"😈":                      &allEmoji[70],
-	":smiling_imp:":          &allEmoji[70],
-	"👿":                      &allEmoji[71],
-	":imp:":                  &allEmoji[71],
-	"👹":                      &allEmoji[72],
-	":japanese_ogre:":        &allEmoji[72],
-	"👺":                      &allEmoji[73],
-	":japanese_goblin:":      &allEmoji[73],
-	"👻":                      &allEmoji[74],
-	":ghost:":                &allEmoji[74],
-	"💀":                      &allEmoji[75],
-	":skull:":                &allEmoji[75],
-	"☠️":                     &allEmoji[76],
-	":skull_and_crossbones:": &allEmoji[76],
-	"👽":                 &allEmoji[77],
-	":alien:":           &allEmoji[77],
-	"👾":                 &allEmoji[78],
-	":space_invader:":   &allEmoji[78],
-	"🤖":                 &allEmoji[79],
-	":robot:":           &allEmoji[79],
-	"😺":                 &allEmoji[80],
-	":smiley_cat:":      &allEmoji[80],
-	"😸":                 &allEmoji[81],
-	":smile_cat:":       &allEmoji[81],
-	"😹":                 &allEmoji[82],
-	":joy_cat:":         &allEmoji[82],
-	"😻":                 &allEmoji[83],
-	":heart_eyes_cat:":  &allEmoji[83],
-	"😼":                 &allEmoji[84],
-	":smirk_cat:":       &allEmoji[84],
-	"😽":                 &allEmoji[85],
-	":kissing_cat:":     &allEmoji[85],
-	"🙀":                 &allEmoji[86],
-	":scream_cat:":      &allEmoji[86],
-	"😿":                 &allEmoji[87],
-	":crying_cat_face:": &allEmoji[87],
-	"😾":                 &allEmoji[88],
-	":pouting_cat:":     &allEmoji[88],
-	"🙌":                 &allEmoji[89],
-	":raised_hands:":    &allEmoji[89],
-	"👏":                 &allEmoji[90],
-	":clap:":            &allEmoji[90],
-	"👍":                 &allEmoji[91],
-	":+1:":              &allEmoji[91],
-	":thumbsup:":        &allEmoji[91],
-	"👎":                 &allEmoji[92],
-	":-1:":              &allEmoji[92],
-	":thumbsdown:":      &allEmoji[92],
-	"👊":                 &allEmoji[93],
-	":facepunch:":       &allEmoji[93],
-	":punch:":           &allEmoji[93],
-	"✊":                 &allEmoji[94],
-	":fist:":            &allEmoji[94],
-	"👋":                 &allEmoji[95],
-	":wave:":            &allEmoji[95],
-	"👈":                 &allEmoji[96],
-	":point_left:":      &allEmoji[96],
-	"👉":                 &allEmoji[97],
-	":point_right:":     &allEmoji[97],
-	"👆":                 &allEmoji[98],
-	":point_up_2:":      &allEmoji[98],
-	"👇":                 &allEmoji[99],
-	":point_down:":      &allEmoji[99],
-	"👌":                 &allEmoji[100],
-	":ok_hand:":         &allEmoji[100],
-	"☝️":                &allEmoji[101],
-	":point_up:":        &allEmoji[101],
-	"✌️":                &allEmoji[102],
-	":v:":               &allEmoji[102],
-	"✋":                 &allEmoji[103],
-	":hand:":            &allEmoji[103],
-	":raised_hand:":     &allEmoji[103],
-	"🖐":                 &allEmoji[104],
-	":raised_hand_with_fingers_splayed:": &allEmoji[104],
-	"👐":                        &allEmoji[105],
-	":open_hands:":             &allEmoji[105],
-	"💪":                        &allEmoji[106],
-	":muscle:":                 &allEmoji[106],
-	"🙏":                        &allEmoji[107],
-	":pray:":                   &allEmoji[107],
-	"🖖":                        &allEmoji[108],
-	":vulcan_salute:":          &allEmoji[108],
-	"🤘":                        &allEmoji[109],
-	":metal:":                  &allEmoji[109],
-	"🖕":                        &allEmoji[110],
-	":middle_finger:":          &allEmoji[110],
-	":fu:":                     &allEmoji[110],
-	"✍️":                       &allEmoji[111],
-	":writing_hand:":           &allEmoji[111],
-	"💅":                        &allEmoji[112],
-	":nail_care:":              &allEmoji[112],
-	"👄":                        &allEmoji[113],
-	":lips:":                   &allEmoji[113],
-	"👅":                        &allEmoji[114],
-	":tongue:":                 &allEmoji[114],
-	"👂":                        &allEmoji[115],
-	":ear:":                    &allEmoji[115],
-	"👃":                        &allEmoji[116],
-	":nose:":                   &allEmoji[116],
-	"👁":                        &allEmoji[117],
-	":eye:":                    &allEmoji[117],
-	"👀":                        &allEmoji[118],
-	":eyes:":                   &allEmoji[118],
-	"🗣":                        &allEmoji[119],
-	":speaking_head:":          &allEmoji[119],
-	"👤":                        &allEmoji[120],
-	":bust_in_silhouette:":     &allEmoji[120],
-	"👥":                        &allEmoji[121],
-	":busts_in_silhouette:":    &allEmoji[121],
-	"👶":                        &allEmoji[122],
-	":baby:":                   &allEmoji[122],
-	"👦":                        &allEmoji[123],
-	":boy:":                    &allEmoji[123],
-	"👧":                        &allEmoji[124],
-	":girl:":                   &allEmoji[124],
-	"👨":                        &allEmoji[125],
-	":man:":                    &allEmoji[125],
-	"👩":                        &allEmoji[126],
-	":woman:":                  &allEmoji[126],
-	"👱\u200d♀️":                &allEmoji[127],
-	":blonde_woman:":           &allEmoji[127],
-	"👱":                        &allEmoji[128],
-	":blonde_man:":             &allEmoji[128],
-	":person_with_blond_hair:": &allEmoji[128],
-	"👴":                           &allEmoji[129],
-	":older_man:":                 &allEmoji[129],
-	"👵":                           &allEmoji[130],
-	":older_woman:":               &allEmoji[130],
-	"👲":                           &allEmoji[131],
-	":man_with_gua_pi_mao:":       &allEmoji[131],
-	"👳\u200d♀️":                   &allEmoji[132],
-	":woman_with_turban:":         &allEmoji[132],
-	"👳":                           &allEmoji[133],
-	":man_with_turban:":           &allEmoji[133],
-	"👮\u200d♀️":                   &allEmoji[134],
-	":policewoman:":               &allEmoji[134],
-	"👮":                           &allEmoji[135],
-	":policeman:":                 &allEmoji[135],
-	":cop:":                       &allEmoji[135],
-	"👷\u200d♀️":                   &allEmoji[136],
-	":construction_worker_woman:": &allEmoji[136],
-	"👷": &allEmoji[137],
-	":construction_worker_man:":  &allEmoji[137],
-	":construction_worker:":      &allEmoji[137],
-	"💂\u200d♀️":                  &allEmoji[138],
-	":guardswoman:":              &allEmoji[138],
-	"💂":                          &allEmoji[139],
-	":guardsman:":                &allEmoji[139],
-	"🕵️\u200d♀️":                 &allEmoji[140],
-	":female_detective:":         &allEmoji[140],
-	"🕵️":                         &allEmoji[141],
-	":male_detective:":           &allEmoji[141],
-	":detective:":                &allEmoji[141],
-	"🎅":                          &allEmoji[142],
-	":santa:":                    &allEmoji[142],
-	"👸":                          &allEmoji[143],
-	":princess:":                 &allEmoji[143],
-	"👰":                          &allEmoji[144],
-	":bride_with_veil:":          &allEmoji[144],
-	"👼":                          &allEmoji[145],
-	":angel:":                    &allEmoji[145],
-	"🙇\u200d♀️":                  &allEmoji[146],
-	":bowing_woman:":             &allEmoji[146],
-	"🙇":                          &allEmoji[147],
-	":bowing_man:":               &allEmoji[147],
-	":bow:":                      &allEmoji[147],
-	"💁":                          &allEmoji[148],
-	":tipping_hand_woman:":       &allEmoji[148],
-	":information_desk_person:":  &allEmoji[148],
-	"💁\u200d♂️":                  &allEmoji[149],
-	":tipping_hand_man:":         &allEmoji[149],
-	"🙅":                          &allEmoji[150],
-	":no_good_woman:":            &allEmoji[150],
-	":no_good:":                  &allEmoji[150],
-	":ng_woman:":                 &allEmoji[150],
-	"🙅\u200d♂️":                  &allEmoji[151],
-	":no_good_man:":              &allEmoji[151],
-	":ng_man:":                   &allEmoji[151],
-	"🙆":                          &allEmoji[152],
-	":ok_woman:":                 &allEmoji[152],
-	"🙆\u200d♂️":                  &allEmoji[153],
-	":ok_man:":                   &allEmoji[153],
-	"🙋":                          &allEmoji[154],
-	":raising_hand_woman:":       &allEmoji[154],
-	":raising_hand:":             &allEmoji[154],
-	"🙋\u200d♂️":                  &allEmoji[155],
-	":raising_hand_man:":         &allEmoji[155],
-	"🙎":                          &allEmoji[156],
-	":pouting_woman:":            &allEmoji[156],
-	":person_with_pouting_face:": &allEmoji[156],
-	"🙎\u200d♂️":                  &allEmoji[157],
-	":pouting_man:":              &allEmoji[157],
-	"🙍":                          &allEmoji[158],
-	":frowning_woman:":           &allEmoji[158],
-	":person_frowning:":          &allEmoji[158],
-	"🙍\u200d♂️":                  &allEmoji[159],
-	":frowning_man:":             &allEmoji[159],
-	"💇":                          &allEmoji[160],
-	":haircut_woman:":            &allEmoji[160],
-	":haircut:":                  &allEmoji[160],
-	"💇\u200d♂️":                  &allEmoji[161],
-	":haircut_man:":              &allEmoji[161],
-	"💆":                          &allEmoji[162],
-	":massage_woman:":            &allEmoji[162],
-	":massage:":                  &allEmoji[162],
-	"💆\u200d♂️":                  &allEmoji[163],
-	":massage_man:":              &allEmoji[163],
-	"💃":                          &allEmoji[164],
-	":dancer:":                   &allEmoji[164],
-	"👯":                          &allEmoji[165],
-	":dancing_women:":            &allEmoji[165],
-	":dancers:":                  &allEmoji[165],
-	"👯\u200d♂️":                  &allEmoji[166],
-	":dancing_men:":              &allEmoji[166],
-	"🚶\u200d♀️":                  &allEmoji[167],
-	":walking_woman:":            &allEmoji[167],
-	"🚶":                          &allEmoji[168],
-	":walking_man:":              &allEmoji[168],
-	":walking:":                  &allEmoji[168],
-	"🏃\u200d♀️":                  &allEmoji[169],
-	":running_woman:":            &allEmoji[169],
-	"🏃":                          &allEmoji[170],
-	":running_man:":              &allEmoji[170],
-	":runner:":                   &allEmoji[170],
-	":running:":                  &allEmoji[170],
-	"👫":                          &allEmoji[171],
-	":couple:":                   &allEmoji[171],
-	"👭":                          &allEmoji[172],
-	":two_women_holding_hands:": &allEmoji[172],
-	"👬": &allEmoji[173],
-	":two_men_holding_hands:": &allEmoji[173],
-	"💑": &allEmoji[174],
-	":couple_with_heart_woman_man:":   &allEmoji[174],
-	":couple_with_heart:":             &allEmoji[174],
-	"👩\u200d❤️\u200d👩":                &allEmoji[175],
-	":couple_with_heart_woman_woman:": &allEmoji[175],
-	"👨\u200d❤️\u200d👨":                &allEmoji[176],
-	":couple_with_heart_man_man:":     &allEmoji[176],
-	"💏": &allEmoji[177],
-	":couplekiss_man_woman:":   &allEmoji[177],
-	"👩\u200d❤️\u200d💋\u200d👩":  &allEmoji[178],
-	":couplekiss_woman_woman:": &allEmoji[178],
-	"👨\u200d❤️\u200d💋\u200d👨":  &allEmoji[179],
-	":couplekiss_man_man:":     &allEmoji[179],
-	"👪":                        &allEmoji[180],
-	":family_man_woman_boy:":         &allEmoji[180],
-	":family:":                       &allEmoji[180],
-	"👨\u200d👩\u200d👧":                &allEmoji[181],
-	":family_man_woman_girl:":        &allEmoji[181],
-	"👨\u200d👩\u200d👧\u200d👦":         &allEmoji[182],
-	":family_man_woman_girl_boy:":    &allEmoji[182],
-	"👨\u200d👩\u200d👦\u200d👦":         &allEmoji[183],
-	":family_man_woman_boy_boy:":     &allEmoji[183],
-	"👨\u200d👩\u200d👧\u200d👧":         &allEmoji[184],
-	":family_man_woman_girl_girl:":   &allEmoji[184],
-	"👩\u200d👩\u200d👦":                &allEmoji[185],
-	":family_woman_woman_boy:":       &allEmoji[185],
-	"👩\u200d👩\u200d👧":                &allEmoji[186],
-	":family_woman_woman_girl:":      &allEmoji[186],
-	"👩\u200d👩\u200d👧\u200d👦":         &allEmoji[187],
-	":family_woman_woman_girl_boy:":  &allEmoji[187],
-	"👩\u200d👩\u200d👦\u200d👦":         &allEmoji[188],
-	":family_woman_woman_boy_boy:":   &allEmoji[188],
-	"👩\u200d👩\u200d👧\u200d👧":         &allEmoji[189],
-	":family_woman_woman_girl_girl:": &allEmoji[189],
-	"👨\u200d👨\u200d👦":                &allEmoji[190],
-	":family_man_man_boy:":           &allEmoji[190],
-	"👨\u200d👨\u200d👧":                &allEmoji[191],
-	":family_man_man_girl:":          &allEmoji[191],
-	"👨\u200d👨\u200d👧\u200d👦":         &allEmoji[192],
-	":family_man_man_girl_boy:":      &allEmoji[192],
-	"👨\u200d👨\u200d👦\u200d👦":         &allEmoji[193],
-	":family_man_man_boy_boy:":       &allEmoji[193],
-	"👨\u200d👨\u200d👧\u200d👧":         &allEmoji[194],
-	":family_man_man_girl_girl:":     &allEmoji[194],
-	"👩\u200d👦":                       &allEmoji[195],
-	":family_woman_boy:":             &allEmoji[195],
-	"👩\u200d👧":                       &allEmoji[196],
-	":family_woman_girl:":            &allEmoji[196],
-	"👩\u200d👧\u200d👦":                &allEmoji[197],
-	":family_woman_girl_boy:":        &allEmoji[197],
-	"👩\u200d👦\u200d👦":                &allEmoji[198],
-	":family_woman_boy_boy:":         &allEmoji[198],
-	"👩\u200d👧\u200d👧":                &allEmoji[199],
-	":family_woman_girl_girl:":       &allEmoji[199],
-	"👨\u200d👦":                       &allEmoji[200],
-	":family_man_boy:":               &allEmoji[200],
-	"👨\u200d👧":                       &allEmoji[201],
-	":family_man_girl:":              &allEmoji[201],
-	"👨\u200d👧\u200d👦":                &allEmoji[202],
-	":family_man_girl_boy:":          &allEmoji[202],
-	"👨\u200d👦\u200d👦":                &allEmoji[203],
-	":family_man_boy_boy:":           &allEmoji[203],
-	"👨\u200d👧\u200d👧":                &allEmoji[204],
-	":family_man_girl_girl:":         &allEmoji[204],
-	"👚":                &allEmoji[205],
-	":womans_clothes:": &allEmoji[205],
-	"👕":                &allEmoji[206],
-	":shirt:":          &allEmoji[206],
-	":tshirt:":         &allEmoji[206],
-	"👖":                &allEmoji[207],
-	":jeans:":          &allEmoji[207],
-	"👔":                &allEmoji[208],
-	":necktie:":        &allEmoji[208],
-	"👗":                &allEmoji[209],
-	":dress:":          &allEmoji[209],
-	"👙":                &allEmoji[210],
-	":bikini:":         &allEmoji[210],
-	"👘":                &allEmoji[211],
-	":kimono:":         &allEmoji[211],
-	"💄":                &allEmoji[212],
-	":lipstick:":       &allEmoji[212],
-	"💋":                &allEmoji[213],
-	":kiss:":           &allEmoji[213],
-	"👣":                &allEmoji[214],
-	":footprints:":     &allEmoji[214],
-	"👠":                &allEmoji[215],
-	":high_heel:":      &allEmoji[215],
-	"👡":                &allEmoji[216],
-	":sandal:":         &allEmoji[216],
-	"👢":                &allEmoji[217],
-	":boot:":           &allEmoji[217],
-	"👞":                &allEmoji[218],
-	":mans_shoe:":      &allEmoji[218],
-	":shoe:":           &allEmoji[218],
-	"👟":                &allEmoji[219],
-	":athletic_shoe:":  &allEmoji[219],
-	"👒":                &allEmoji[220],
-	":womans_hat:":     &allEmoji[220],
-	"🎩":                &allEmoji[221],
-	":tophat:":         &allEmoji[221],
-	"🎓":                &allEmoji[222],
-	":mortar_board:":   &allEmoji[222],
-	"👑":                &allEmoji[223],
-	":crown:":          &allEmoji[223],
-	"⛑":                &allEmoji[224],
-	":rescue_worker_helmet:": &allEmoji[224],
-	"🎒":                     &allEmoji[225],
-	":school_satchel:":      &allEmoji[225],
-	"👝":                     &allEmoji[226],
-	":pouch:":               &allEmoji[226],
-	"👛":                     &allEmoji[227],
-	":purse:":               &allEmoji[227],
-	"👜":                     &allEmoji[228],
-	":handbag:":             &allEmoji[228],
-	"💼":                     &allEmoji[229],
-	":briefcase:":           &allEmoji[229],
-	"👓":                     &allEmoji[230],
-	":eyeglasses:":          &allEmoji[230],
-	"🕶":                     &allEmoji[231],
-	":dark_sunglasses:":     &allEmoji[231],
-	"💍":                     &allEmoji[232],
-	":ring:":                &allEmoji[232],
-	"🌂":                     &allEmoji[233],
-	":closed_umbrella:":     &allEmoji[233],
-	"🐶":                     &allEmoji[234],
-	":dog:":                 &allEmoji[234],
-	"🐱":                     &allEmoji[235],
-	":cat:":                 &allEmoji[235],
-	"🐭":                     &allEmoji[236],
-	":mouse:":               &allEmoji[236],
-	"🐹":                     &allEmoji[237],
-	":hamster:":             &allEmoji[237],
-	"🐰":                     &allEmoji[238],
-	":rabbit:":              &allEmoji[238],
-	"🐻":                     &allEmoji[239],
-	":bear:":                &allEmoji[239],
-	"🐼":                     &allEmoji[240],
-	":panda_face:":          &allEmoji[240],
-	"🐨":                     &allEmoji[241],
-	":koala:":               &allEmoji[241],
-	"🐯":                     &allEmoji[242],
-	":tiger:":               &allEmoji[242],
-	"🦁":                     &allEmoji[243],
-	":lion:":                &allEmoji[243],
-	"🐮":                     &allEmoji[244],
-	":cow:":                 &allEmoji[244],
-	"🐷":                     &allEmoji[245],
-	":pig:":                 &allEmoji[245],
-	"🐽":                     &allEmoji[246],
-	":pig_nose:":            &allEmoji[246],
-	"🐸":                     &allEmoji[247],
-	":frog:":                &allEmoji[247],
-	"🐙":                     &allEmoji[248],
-	":octopus:":             &allEmoji[248],
-	"🐵":                     &allEmoji[249],
-	":monkey_face:":         &allEmoji[249],
-	"🙈":                     &allEmoji[250],
-	":see_no_evil:":         &allEmoji[250],
-	"🙉":                     &allEmoji[251],
-	":hear_no_evil:":        &allEmoji[251],
-	"🙊":                     &allEmoji[252],
-	":speak_no_evil:":       &allEmoji[252],
-	"🐒":                     &allEmoji[253],
-	":monkey:":              &allEmoji[253],
-	"🐔":                     &allEmoji[254],
-	":chicken:":             &allEmoji[254],
-	"🐧":                     &allEmoji[255],
-	":penguin:":             &allEmoji[255],
-	"🐦":                     &allEmoji[256],
-	":bird:":                &allEmoji[256],
-	"🐤":                     &allEmoji[257],
-	":baby_chick:":          &allEmoji[257],
-	"🐣":                     &allEmoji[258],
-	":hatching_chick:":      &allEmoji[258],
-	"🐥":                     &allEmoji[259],
-	":hatched_chick:":       &allEmoji[259],
-	"🐺":                     &allEmoji[260],
-	":wolf:":                &allEmoji[260],
-	"🐗":                     &allEmoji[261],
-	":boar:":                &allEmoji[261],
-	"🐴":                     &allEmoji[262],
-	":horse:":               &allEmoji[262],
-	"🦄":                     &allEmoji[263],
-	":unicorn:":             &allEmoji[263],
-	"🐝":                     &allEmoji[264],
-	":bee:":                 &allEmoji[264],
-	":honeybee:":            &allEmoji[264],
-	"🐛":                     &allEmoji[265],
-	":bug:":                 &allEmoji[265],
-	"🐌":                     &allEmoji[266],
-	":snail:":               &allEmoji[266],
-	"🐞":                     &allEmoji[267],
-	":beetle:":              &allEmoji[267],
-	"🐜":                     &allEmoji[268],
-	":ant:":                 &allEmoji[268],
-	"🕷":                     &allEmoji[269],
-	":spider:":              &allEmoji[269],
-	"🦂":                     &allEmoji[270],
-	":scorpion:":            &allEmoji[270],
-	"🦀":                     &allEmoji[271],
-	":crab:":                &allEmoji[271],
-	"🐍":                     &allEmoji[272],
-	":snake:":               &allEmoji[272],
-	"🐢":                     &allEmoji[273],
-	":turtle:":              &allEmoji[273],
-	"🐠":                     &allEmoji[274],
-	":tropical_fish:":       &allEmoji[274],
-	"🐟":                     &allEmoji[275],
-	":fish:":                &allEmoji[275],
-	"🐡":                     &allEmoji[276],
-	":blowfish:":            &allEmoji[276],
-	"🐬":                     &allEmoji[277],
-	":dolphin:":             &allEmoji[277],
-	":flipper:":             &allEmoji[277],
-	"🐳":                     &allEmoji[278],
-	":whale:":               &allEmoji[278],
-	"🐋":                     &allEmoji[279],
-	":whale2:":              &allEmoji[279],
-	"🐊":                     &allEmoji[280],
-	":crocodile:":           &allEmoji[280],
-	"🐆":                     &allEmoji[281],
-	":leopard:":             &allEmoji[281],
-	"🐅":                     &allEmoji[282],
-	":tiger2:":              &allEmoji[282],
-	"🐃":                     &allEmoji[283],
-	":water_buffalo:":       &allEmoji[283],
-	"🐂":                     &allEmoji[284],
-	":ox:":                  &allEmoji[284],
-	"🐄":                     &allEmoji[285],
-	":cow2:":                &allEmoji[285],
-	"🐪":                     &allEmoji[286],
-	":dromedary_camel:":     &allEmoji[286],
-	"🐫":                     &allEmoji[287],
-	":camel:":               &allEmoji[287],
-	"🐘":                     &allEmoji[288],
-	":elephant:":            &allEmoji[288],
-	"🐐":                     &allEmoji[289],
-	":goat:":                &allEmoji[289],
-	"🐏":                     &allEmoji[290],
-	":ram:":                 &allEmoji[290],
-	"🐑":                     &allEmoji[291],
-	":sheep:":               &allEmoji[291],
-	"🐎":                     &allEmoji[292],
-	":racehorse:":           &allEmoji[292],
-	"🐖":                     &allEmoji[293],
-	":pig2:":                &allEmoji[293],
-	"🐀":                     &allEmoji[294],
-	":rat:":                 &allEmoji[294],
-	"🐁":                     &allEmoji[295],
-	":mouse2:":              &allEmoji[295],
-	"🐓":                     &allEmoji[296],
-	":rooster:":             &allEmoji[296],
-	"🦃":                     &allEmoji[297],
-	":turkey:":              &allEmoji[297],
-	"🕊":                     &allEmoji[298],
-	":dove:":                &allEmoji[298],
-	"🐕":                     &allEmoji[299],
-	":dog2:":                &allEmoji[299],
-	"🐩":                     &allEmoji[300],
-	":poodle:":              &allEmoji[300],
-	"🐈":                     &allEmoji[301],
-	":cat2:":                &allEmoji[301],
-	"🐇":                     &allEmoji[302],
-	":rabbit2:":             &allEmoji[302],
-	"🐿":                     &allEmoji[303],
-	":chipmunk:":            &allEmoji[303],
-	"🐾":                     &allEmoji[304],
-	":feet:":                &allEmoji[304],
-	":paw_prints:":          &allEmoji[304],
-	"🐉":                     &allEmoji[305],
-	":dragon:":              &allEmoji[305],
-	"🐲":                     &allEmoji[306],
-	":dragon_face:":         &allEmoji[306],
-	"🌵":                     &allEmoji[307],
-	":cactus:":              &allEmoji[307],
-	"🎄":                     &allEmoji[308],
-	":christmas_tree:":      &allEmoji[308],
-	"🌲":                     &allEmoji[309],
-	":evergreen_tree:":      &allEmoji[309],
-	"🌳":                     &allEmoji[310],
-	":deciduous_tree:":      &allEmoji[310],
-	"🌴":                     &allEmoji[311],
-	":palm_tree:":           &allEmoji[311],
-	"🌱":                     &allEmoji[312],
-	":seedling:":            &allEmoji[312],
-	"🌿":                     &allEmoji[313],
-	":herb:":                &allEmoji[313],
-	"☘":                     &allEmoji[314],
-	":shamrock:":            &allEmoji[314],
-	"🍀":                     &allEmoji[315],
-	":four_leaf_clover:":    &allEmoji[315],
-	"🎍":                     &allEmoji[316],
-	":bamboo:":              &allEmoji[316],
-	"🎋":                     &allEmoji[317],
-	":tanabata_tree:":       &allEmoji[317],
-	"🍃":                     &allEmoji[318],
-	":leaves:":              &allEmoji[318],
-	"🍂":                     &allEmoji[319],
-	":fallen_leaf:":         &allEmoji[319],
-	"🍁":                     &allEmoji[320],
-	":maple_leaf:":          &allEmoji[320],
-	"🌾":                     &allEmoji[321],
-	":ear_of_rice:":         &allEmoji[321],
-	"🌺":                     &allEmoji[322],
-	":hibiscus:":            &allEmoji[322],
-	"🌻":                     &allEmoji[323],
-	":sunflower:":           &allEmoji[323],
-	"🌹":                     &allEmoji[324],
-	":rose:":                &allEmoji[324],
-	"🌷":                     &allEmoji[325],
-	":tulip:":               &allEmoji[325],
-	"🌼":                     &allEmoji[326],
-	":blossom:":             &allEmoji[326],
-	"🌸":                     &allEmoji[327],
-	":cherry_blossom:":      &allEmoji[327],
-	"💐":                     &allEmoji[328],
-	":bouquet:":             &allEmoji[328],
-	"🍄":                     &allEmoji[329],
-	":mushroom:":            &allEmoji[329],
-	"🌰":                     &allEmoji[330],
-	":chestnut:":            &allEmoji[330],
-	"🎃":                     &allEmoji[331],
-	":jack_o_lantern:":      &allEmoji[331],
-	"🐚":                     &allEmoji[332],
-	":shell:":               &allEmoji[332],
-	"🕸":                     &allEmoji[333],
-	":spider_web:":          &allEmoji[333],
-	"🌎":                     &allEmoji[334],
-	":earth_americas:":      &allEmoji[334],
-	"🌍":                     &allEmoji[335],
-	":earth_africa:":        &allEmoji[335],
-	"🌏":                     &allEmoji[336],
-	":earth_asia:":          &allEmoji[336],
-	"🌕":                     &allEmoji[337],
-	":full_moon:":           &allEmoji[337],
-	"🌖":                     &allEmoji[338],
-	":waning_gibbous_moon:": &allEmoji[338],
-	"🌗":                     &allEmoji[339],
-	":last_quarter_moon:":   &allEmoji[339],
-	"🌘":                     &allEmoji[340],
-	":waning_crescent_moon:": &allEmoji[340],
-	"🌑":          &allEmoji[341],
-	":new_moon:": &allEmoji[341],
-	"🌒":          &allEmoji[342],
-	":waxing_crescent_moon:": &allEmoji[342],
-	"🌓":                     &allEmoji[343],
-	":first_quarter_moon:":  &allEmoji[343],
-	"🌔":                     &allEmoji[344],
-	":moon:":                &allEmoji[344],
-	":waxing_gibbous_moon:": &allEmoji[344],
-	"🌚":                     &allEmoji[345],
-	":new_moon_with_face:":  &allEmoji[345],
-	"🌝":                     &allEmoji[346],
-	":full_moon_with_face:": &allEmoji[346],
-	"🌛":                     &allEmoji[347],
-	":first_quarter_moon_with_face:": &allEmoji[347],
-	"🌜": &allEmoji[348],
-	":last_quarter_moon_with_face:": &allEmoji[348],
-	"🌞":               &allEmoji[349],
-	":sun_with_face:": &allEmoji[349],
-	"🌙":               &allEmoji[350],
-	":crescent_moon:": &allEmoji[350],
-	"⭐️":              &allEmoji[351],
-	":star:":          &allEmoji[351],
-	"🌟":               &allEmoji[352],
-	":star2:":         &allEmoji[352],
-	"💫":               &allEmoji[353],
-	":dizzy:":         &allEmoji[353],
-	"✨":               &allEmoji[354],
-	":sparkles:":      &allEmoji[354],
-	"☄️":              &allEmoji[355],
-	":comet:":         &allEmoji[355],
-	"☀️":              &allEmoji[356],
-	":sunny:":         &allEmoji[356],
-	"🌤":               &allEmoji[357],
-	":sun_behind_small_cloud:": &allEmoji[357],
-	"⛅️":                       &allEmoji[358],
-	":partly_sunny:":           &allEmoji[358],
-	"🌥":                        &allEmoji[359],
-	":sun_behind_large_cloud:": &allEmoji[359],
-	"🌦": &allEmoji[360],
-	":sun_behind_rain_cloud:": &allEmoji[360],
-	"☁️":                      &allEmoji[361],
-	":cloud:":                 &allEmoji[361],
-	"🌧":                       &allEmoji[362],
-	":cloud_with_rain:":       &allEmoji[362],
-	"⛈":                       &allEmoji[363],
-	":cloud_with_lightning_and_rain:": &allEmoji[363],
-	"🌩": &allEmoji[364],
-	":cloud_with_lightning:": &allEmoji[364],
-	"⚡️":                     &allEmoji[365],
-	":zap:":                  &allEmoji[365],
-	"🔥":                      &allEmoji[366],
-	":fire:":                 &allEmoji[366],
-	"💥":                      &allEmoji[367],
-	":boom:":                 &allEmoji[367],
-	":collision:":            &allEmoji[367],
-	"❄️":                     &allEmoji[368],
-	":snowflake:":            &allEmoji[368],
-	"🌨":                      &allEmoji[369],
-	":cloud_with_snow:":      &allEmoji[369],
-	"☃️":                     &allEmoji[370],
-	":snowman_with_snow:":    &allEmoji[370],
-	"⛄️":                     &allEmoji[371],
-	":snowman:":              &allEmoji[371],
-	"🌬":                      &allEmoji[372],
-	":wind_face:":            &allEmoji[372],
-	"💨":                      &allEmoji[373],
-	":dash:":                 &allEmoji[373],
-	"🌪":                      &allEmoji[374],
-	":tornado:":              &allEmoji[374],
-	"🌫":                      &allEmoji[375],
-	":fog:":                  &allEmoji[375],
-	"☂️":                     &allEmoji[376],
-	":open_umbrella:":        &allEmoji[376],
-	"☔️":                     &allEmoji[377],
-	":umbrella:":             &allEmoji[377],
-	"💧":                      &allEmoji[378],
-	":droplet:":              &allEmoji[378],
-	"💦":                      &allEmoji[379],
-	":sweat_drops:":          &allEmoji[379],
-	"🌊":                      &allEmoji[380],
-	":ocean:":                &allEmoji[380],
-	"🍏":                      &allEmoji[381],
-	":green_apple:":          &allEmoji[381],
-	"🍎":                      &allEmoji[382],
-	":apple:":                &allEmoji[382],
-	"🍐":                      &allEmoji[383],
-	":pear:":                 &allEmoji[383],
-	"🍊":                      &allEmoji[384],
-	":tangerine:":            &allEmoji[384],
-	":orange:":               &allEmoji[384],
-	":mandarin:":             &allEmoji[384],
-	"🍋":                      &allEmoji[385],
-	":lemon:":                &allEmoji[385],
-	"🍌":                      &allEmoji[386],
-	":banana:":               &allEmoji[386],
-	"🍉":                      &allEmoji[387],
-	":watermelon:":           &allEmoji[387],
-	"🍇":                      &allEmoji[388],
-	":grapes:":               &allEmoji[388],
-	"🍓":                      &allEmoji[389],
-	":strawberry:":           &allEmoji[389],
-	"🍈":                      &allEmoji[390],
-	":melon:":                &allEmoji[390],
-	"🍒":                      &allEmoji[391],
-	":cherries:":             &allEmoji[391],
-	"🍑":                      &allEmoji[392],
-	":peach:":                &allEmoji[392],
-	"🍍":                      &allEmoji[393],
-	":pineapple:":            &allEmoji[393],
-	"🍅":                      &allEmoji[394],
-	":tomato:":               &allEmoji[394],
-	"🍆":                      &allEmoji[395],
-	":eggplant:":             &allEmoji[395],
-	"🌶":                      &allEmoji[396],
-	":hot_pepper:":           &allEmoji[396],
-	"🌽":                      &allEmoji[397],
-	":corn:":                 &allEmoji[397],
-	"🍠":                      &allEmoji[398],
-	":sweet_potato:":         &allEmoji[398],
-	"🍯":                      &allEmoji[399],
-	":honey_pot:":            &allEmoji[399],
-	"🍞":                      &allEmoji[400],
-	":bread:":                &allEmoji[400],
-	"🧀":                      &allEmoji[401],
-	":cheese:":               &allEmoji[401],
-	"🍗":                      &allEmoji[402],
-	":poultry_leg:":          &allEmoji[402],
-	"🍖":                      &allEmoji[403],
-	":meat_on_bone:":         &allEmoji[403],
-	"🍤":                      &allEmoji[404],
-	":fried_shrimp:":         &allEmoji[404],
-	"🍳":                      &allEmoji[405],
-	":egg:":                  &allEmoji[405],
-	"🍔":                      &allEmoji[406],
-	":hamburger:":            &allEmoji[406],
-	"🍟":                      &allEmoji[407],
-	":fries:":                &allEmoji[407],
-	"🌭":                      &allEmoji[408],
-	":hotdog:":               &allEmoji[408],
-	"🍕":                      &allEmoji[409],
-	":pizza:":                &allEmoji[409],
-	"🍝":                      &allEmoji[410],
-	":spaghetti:":            &allEmoji[410],
-	"🌮":                      &allEmoji[411],
-	":taco:":                 &allEmoji[411],
-	"🌯":                      &allEmoji[412],
-	":burrito:":              &allEmoji[412],
-	"🍜":                      &allEmoji[413],
-	":ramen:":                &allEmoji[413],
-	"🍲":                      &allEmoji[414],
-	":stew:":                 &allEmoji[414],
-	"🍥":                      &allEmoji[415],
-	":fish_cake:":            &allEmoji[415],
-	"🍣":                      &allEmoji[416],
-	":sushi:":                &allEmoji[416],
-	"🍱":                      &allEmoji[417],
-	":bento:":                &allEmoji[417],
-	"🍛":                      &allEmoji[418],
-	":curry:":                &allEmoji[418],
-	"🍙":                      &allEmoji[419],
-	":rice_ball:":            &allEmoji[419],
-	"🍚":                      &allEmoji[420],
-	":rice:":                 &allEmoji[420],
-	"🍘":                      &allEmoji[421],
-	":rice_cracker:":         &allEmoji[421],
-	"🍢":                      &allEmoji[422],
-	":oden:":                 &allEmoji[422],
-	"🍡":                      &allEmoji[423],
-	":dango:":                &allEmoji[423],
-	"🍧":                      &allEmoji[424],
-	":shaved_ice:":           &allEmoji[424],
-	"🍨":                      &allEmoji[425],
-	":ice_cream:":            &allEmoji[425],
-	"🍦":                      &allEmoji[426],
-	":icecream:":             &allEmoji[426],
-	"🍰":                      &allEmoji[427],
-	":cake:":                 &allEmoji[427],
-	"🎂":                      &allEmoji[428],
-	":birthday:":             &allEmoji[428],
-	"🍮":                      &allEmoji[429],
-	":custard:":              &allEmoji[429],
-	"🍬":                      &allEmoji[430],
-	":candy:":                &allEmoji[430],
-	"🍭":                      &allEmoji[431],
-	":lollipop:":             &allEmoji[431],
-	"🍫":                      &allEmoji[432],
-	":chocolate_bar:":        &allEmoji[432],
-	"🍿":                      &allEmoji[433],
-	":popcorn:":              &allEmoji[433],
-	"🍩":                      &allEmoji[434],
-	":doughnut:":             &allEmoji[434],
-	"🍪":                      &allEmoji[435],
-	":cookie:":               &allEmoji[435],
-	"🍺":                      &allEmoji[436],
-	":beer:":                 &allEmoji[436],
-	"🍻":                      &allEmoji[437],
-	":beers:":                &allEmoji[437],
-	"🍷":                      &allEmoji[438],
-	":wine_glass:":           &allEmoji[438],
-	"🍸":                      &allEmoji[439],
-	":cocktail:":             &allEmoji[439],
-	"🍹":                      &allEmoji[440],
-	":tropical_drink:":       &allEmoji[440],
-	"🍾":                      &allEmoji[441],
-	":champagne:":            &allEmoji[441],
-	"🍶":                      &allEmoji[442],
-	":sake:":                 &allEmoji[442],
-	"🍵":                      &allEmoji[443],
-	":tea:":                  &allEmoji[443],
-	"☕️":                     &allEmoji[444],
-	":coffee:":               &allEmoji[444],
-	"🍼":                      &allEmoji[445],
-	":baby_bottle:":          &allEmoji[445],
-	"🍴":                      &allEmoji[446],
-	":fork_and_knife:":       &allEmoji[446],
-	"🍽":                      &allEmoji[447],
-	":plate_with_cutlery:":   &allEmoji[447],
-	"⚽️":                     &allEmoji[448],
-	":soccer:":               &allEmoji[448],
-	"🏀":                      &allEmoji[449],
-	":basketball:":           &allEmoji[449],
-	"🏈":                      &allEmoji[450],
-	":football:":             &allEmoji[450],
-	"⚾️":                     &allEmoji[451],
-	":baseball:":             &allEmoji[451],
-	"🎾":                      &allEmoji[452],
-	":tennis:":               &allEmoji[452],
-	"🏐":                      &allEmoji[453],
-	":volleyball:":           &allEmoji[453],
-	"🏉":                      &allEmoji[454],
-	":rugby_football:":       &allEmoji[454],
-	"🎱":                      &allEmoji[455],
-	":8ball:":                &allEmoji[455],
-	"🏓":                      &allEmoji[456],
-	":ping_pong:":            &allEmoji[456],
-	"🏸":                      &allEmoji[457],
-	":badminton:":            &allEmoji[457],
-	"🏒":                      &allEmoji[458],
-	":ice_hockey:":           &allEmoji[458],
-	"🏑":                      &allEmoji[459],
-	":field_hockey:":         &allEmoji[459],
-	"🏏":                      &allEmoji[460],
-	":cricket:":              &allEmoji[460],
-	"🏹":                      &allEmoji[461],
-	":bow_and_arrow:":        &allEmoji[461],
-	"⛳️":                     &allEmoji[462],
-	":golf:":                 &allEmoji[462],
-	"🎣":                      &allEmoji[463],
-	":fishing_pole_and_fish:": &allEmoji[463],
-	"⛸":                       &allEmoji[464],
-	":ice_skate:":             &allEmoji[464],
-	"🎿":                       &allEmoji[465],
-	":ski:":                   &allEmoji[465],
-	"⛷":                       &allEmoji[466],
-	":skier:":                 &allEmoji[466],
-	"🏂":                       &allEmoji[467],
-	":snowboarder:":           &allEmoji[467],
-	"🏋️\u200d♀️":              &allEmoji[468],
-	":weight_lifting_woman:":  &allEmoji[468],
-	"🏋️":                      &allEmoji[469],
-	":weight_lifting_man:":    &allEmoji[469],
-	"⛹️\u200d♀️":              &allEmoji[470],
-	":basketball_woman:":      &allEmoji[470],
-	"⛹️":                      &allEmoji[471],
-	":basketball_man:":        &allEmoji[471],
-	"🏌️\u200d♀️":              &allEmoji[472],
-	":golfing_woman:":         &allEmoji[472],
-	"🏌️":                      &allEmoji[473],
-	":golfing_man:":           &allEmoji[473],
-	"🏄\u200d♀️":               &allEmoji[474],
-	":surfing_woman:":         &allEmoji[474],
-	"🏄":                       &allEmoji[475],
-	":surfing_man:":           &allEmoji[475],
-	":surfer:":                &allEmoji[475],
-	"🏊\u200d♀️":               &allEmoji[476],
-	":swimming_woman:":        &allEmoji[476],
-	"🏊":                       &allEmoji[477],
-	":swimming_man:":          &allEmoji[477],
-	":swimmer:":               &allEmoji[477],
-	"🚣\u200d♀️":               &allEmoji[478],
-	":rowing_woman:":          &allEmoji[478],
-	"🚣":                       &allEmoji[479],
-	":rowing_man:":            &allEmoji[479],
-	":rowboat:":               &allEmoji[479],
-	"🏇":                       &allEmoji[480],
-	":horse_racing:":          &allEmoji[480],
-	"🚴\u200d♀️":               &allEmoji[481],
-	":biking_woman:":          &allEmoji[481],
-	"🚴":                       &allEmoji[482],
-	":biking_man:":            &allEmoji[482],
-	":bicyclist:":             &allEmoji[482],
-	"🚵\u200d♀️":               &allEmoji[483],
-	":mountain_biking_woman:": &allEmoji[483],
-	"🚵":                     &allEmoji[484],
-	":mountain_biking_man:": &allEmoji[484],
-	":mountain_bicyclist:":  &allEmoji[484],
-	"🛀":                     &allEmoji[485],
-	":bath:":                &allEmoji[485],
-	"🕴":                     &allEmoji[486],
-	":business_suit_levitating:": &allEmoji[486],
-	"🎗":                 &allEmoji[487],
-	":reminder_ribbon:": &allEmoji[487],
-	"🎽":                 &allEmoji[488],
-	":running_shirt_with_sash:": &allEmoji[488],
-	"🏅":                     &allEmoji[489],
-	":medal_sports:":        &allEmoji[489],
-	"🎖":                     &allEmoji[490],
-	":medal_military:":      &allEmoji[490],
-	"🏆":                     &allEmoji[491],
-	":trophy:":              &allEmoji[491],
-	"🏵":                     &allEmoji[492],
-	":rosette:":             &allEmoji[492],
-	"🎯":                     &allEmoji[493],
-	":dart:":                &allEmoji[493],
-	"🎫":                     &allEmoji[494],
-	":ticket:":              &allEmoji[494],
-	"🎟":                     &allEmoji[495],
-	":tickets:":             &allEmoji[495],
-	"🎭":                     &allEmoji[496],
-	":performing_arts:":     &allEmoji[496],
-	"🎨":                     &allEmoji[497],
-	":art:":                 &allEmoji[497],
-	"🎪":                     &allEmoji[498],
-	":circus_tent:":         &allEmoji[498],
-	"🎬":                     &allEmoji[499],
-	":clapper:":             &allEmoji[499],
-	"🎤":                     &allEmoji[500],
-	":microphone:":          &allEmoji[500],
-	"🎧":                     &allEmoji[501],
-	":headphones:":          &allEmoji[501],
-	"🎼":                     &allEmoji[502],
-	":musical_score:":       &allEmoji[502],
-	"🎹":                     &allEmoji[503],
-	":musical_keyboard:":    &allEmoji[503],
-	"🎷":                     &allEmoji[504],
-	":saxophone:":           &allEmoji[504],
-	"🎺":                     &allEmoji[505],
-	":trumpet:":             &allEmoji[505],
-	"🎸":                     &allEmoji[506],
-	":guitar:":              &allEmoji[506],
-	"🎻":                     &allEmoji[507],
-	":violin:":              &allEmoji[507],
-	"🎮":                     &allEmoji[508],
-	":video_game:":          &allEmoji[508],
-	"🎰":                     &allEmoji[509],
-	":slot_machine:":        &allEmoji[509],
-	"🎲":                     &allEmoji[510],
-	":game_die:":            &allEmoji[510],
-	"🎳":                     &allEmoji[511],
-	":bowling:":             &allEmoji[511],
-	"🚗":                     &allEmoji[512],
-	":car:":                 &allEmoji[512],
-	":red_car:":             &allEmoji[512],
-	"🚕":                     &allEmoji[513],
-	":taxi:":                &allEmoji[513],
-	"🚙":                     &allEmoji[514],
-	":blue_car:":            &allEmoji[514],
-	"🚌":                     &allEmoji[515],
-	":bus:":                 &allEmoji[515],
-	"🚎":                     &allEmoji[516],
-	":trolleybus:":          &allEmoji[516],
-	"🏎":                     &allEmoji[517],
-	":racing_car:":          &allEmoji[517],
-	"🚓":                     &allEmoji[518],
-	":police_car:":          &allEmoji[518],
-	"🚑":                     &allEmoji[519],
-	":ambulance:":           &allEmoji[519],
-	"🚒":                     &allEmoji[520],
-	":fire_engine:":         &allEmoji[520],
-	"🚐":                     &allEmoji[521],
-	":minibus:":             &allEmoji[521],
-	"🚚":                     &allEmoji[522],
-	":truck:":               &allEmoji[522],
-	"🚛":                     &allEmoji[523],
-	":articulated_lorry:":   &allEmoji[523],
-	"🚜":                     &allEmoji[524],
-	":tractor:":             &allEmoji[524],
-	"🏍":                     &allEmoji[525],
-	":motorcycle:":          &allEmoji[525],
-	"🚲":                     &allEmoji[526],
-	":bike:":                &allEmoji[526],
-	"🚨":                     &allEmoji[527],
-	":rotating_light:":      &allEmoji[527],
-	"🚔":                     &allEmoji[528],
-	":oncoming_police_car:": &allEmoji[528],
-	"🚍":                     &allEmoji[529],
-	":oncoming_bus:":        &allEmoji[529],
-	"🚘":                     &allEmoji[530],
-	":oncoming_automobile:": &allEmoji[530],
-	"🚖":                     &allEmoji[531],
-	":oncoming_taxi:":       &allEmoji[531],
-	"🚡":                     &allEmoji[532],
-	":aerial_tramway:":      &allEmoji[532],
-	"🚠":                     &allEmoji[533],
-	":mountain_cableway:":   &allEmoji[533],
-	"🚟":                     &allEmoji[534],
-	":suspension_railway:":  &allEmoji[534],
-	"🚃":                     &allEmoji[535],
-	":railway_car:":         &allEmoji[535],
-	"🚋":                     &allEmoji[536],
-	":train:":               &allEmoji[536],
-	"🚝":                     &allEmoji[537],
-	":monorail:":            &allEmoji[537],
-	"🚄":                     &allEmoji[538],
-	":bullettrain_side:":    &allEmoji[538],
-	"🚅":                     &allEmoji[539],
-	":bullettrain_front:":   &allEmoji[539],
-	"🚈":                     &allEmoji[540],
-	":light_rail:":          &allEmoji[540],
-	"🚞":                     &allEmoji[541],
-	":mountain_railway:":    &allEmoji[541],
-	"🚂":                     &allEmoji[542],
-	":steam_locomotive:":    &allEmoji[542],
-	"🚆":                     &allEmoji[543],
-	":train2:":              &allEmoji[543],
-	"🚇":                     &allEmoji[544],
-	":metro:":               &allEmoji[544],
-	"🚊":                     &allEmoji[545],
-	":tram:":                &allEmoji[545],
-	"🚉":                     &allEmoji[546],
-	":station:":             &allEmoji[546],
-	"🚁":                     &allEmoji[547],
-	":helicopter:":          &allEmoji[547],
-	"🛩":                     &allEmoji[548],
-	":small_airplane:":      &allEmoji[548],
-	"✈️":                    &allEmoji[549],
-	":airplane:":            &allEmoji[549],
-	"🛫":                     &allEmoji[550],
-	":flight_departure:":    &allEmoji[550],
-	"🛬":                     &allEmoji[551],
-	":flight_arrival:":      &allEmoji[551],
-	"⛵️":                    &allEmoji[552],
-	":boat:":                &allEmoji[552],
-	":sailboat:":            &allEmoji[552],
-	"🛥":                     &allEmoji[553],
-	":motor_boat:":          &allEmoji[553],
-	"🚤":                     &allEmoji[554],
-	":speedboat:":           &allEmoji[554],
-	"⛴":                     &allEmoji[555],
-	":ferry:":               &allEmoji[555],
-	"🛳":                     &allEmoji[556],
-	":passenger_ship:":      &allEmoji[556],
-	"🚀":                     &allEmoji[557],
-	":rocket:":              &allEmoji[557],
-	"🛰":                     &allEmoji[558],
-	":artificial_satellite:": &allEmoji[558],
-	"💺":              &allEmoji[559],
-	":seat:":         &allEmoji[559],
-	"⚓️":             &allEmoji[560],
-	":anchor:":       &allEmoji[560],
-	"🚧":              &allEmoji[561],
-	":construction:": &allEmoji[561],
-	"⛽️":             &allEmoji[562],
-	":fuelpump:":     &allEmoji[562],
-	"🚏":              &allEmoji[563],
-	":busstop:":      &allEmoji[563],
-	"🚦":              &allEmoji[564],
-	":vertical_traffic_light:": &allEmoji[564],
-	"🚥":                &allEmoji[565],
-	":traffic_light:":  &allEmoji[565],
-	"🗺":                &allEmoji[566],
-	":world_map:":      &allEmoji[566],
-	"🚢":                &allEmoji[567],
-	":ship:":           &allEmoji[567],
-	"🎡":                &allEmoji[568],
-	":ferris_wheel:":   &allEmoji[568],
-	"🎢":                &allEmoji[569],
-	":roller_coaster:": &allEmoji[569],
-	"🎠":                &allEmoji[570],
-	":carousel_horse:": &allEmoji[570],
-	"🏗":                &allEmoji[571],
-	":building_construction:": &allEmoji[571],
-	"🌁":               &allEmoji[572],
-	":foggy:":         &allEmoji[572],
-	"🗼":               &allEmoji[573],
-	":tokyo_tower:":   &allEmoji[573],
-	"🏭":               &allEmoji[574],
-	":factory:":       &allEmoji[574],
-	"⛲️":              &allEmoji[575],
-	":fountain:":      &allEmoji[575],
-	"🎑":               &allEmoji[576],
-	":rice_scene:":    &allEmoji[576],
-	"⛰":               &allEmoji[577],
-	":mountain:":      &allEmoji[577],
-	"🏔":               &allEmoji[578],
-	":mountain_snow:": &allEmoji[578],
-	"🗻":               &allEmoji[579],
-	":mount_fuji:":    &allEmoji[579],
-	"🌋":               &allEmoji[580],
-	":volcano:":       &allEmoji[580],
-	"🗾":               &allEmoji[581],
-	":japan:":         &allEmoji[581],
-	"🏕":               &allEmoji[582],
-	":camping:":       &allEmoji[582],
-	"⛺️":              &allEmoji[583],
-	":tent:":          &allEmoji[583],
-	"🏞":               &allEmoji[584],
-	":national_park:": &allEmoji[584],
-	"🛣":               &allEmoji[585],
-	":motorway:":      &allEmoji[585],
-	"🛤":               &allEmoji[586],
-	":railway_track:": &allEmoji[586],
-	"🌅":               &allEmoji[587],
-	":sunrise:":       &allEmoji[587],
-	"🌄":               &allEmoji[588],
-	":sunrise_over_mountains:": &allEmoji[588],
-	"🏜":                   &allEmoji[589],
-	":desert:":            &allEmoji[589],
-	"🏖":                   &allEmoji[590],
-	":beach_umbrella:":    &allEmoji[590],
-	"🏝":                   &allEmoji[591],
-	":desert_island:":     &allEmoji[591],
-	"🌇":                   &allEmoji[592],
-	":city_sunrise:":      &allEmoji[592],
-	"🌆":                   &allEmoji[593],
-	":city_sunset:":       &allEmoji[593],
-	"🏙":                   &allEmoji[594],
-	":cityscape:":         &allEmoji[594],
-	"🌃":                   &allEmoji[595],
-	":night_with_stars:":  &allEmoji[595],
-	"🌉":                   &allEmoji[596],
-	":bridge_at_night:":   &allEmoji[596],
-	"🌌":                   &allEmoji[597],
-	":milky_way:":         &allEmoji[597],
-	"🌠":                   &allEmoji[598],
-	":stars:":             &allEmoji[598],
-	"🎇":                   &allEmoji[599],
-	":sparkler:":          &allEmoji[599],
-	"🎆":                   &allEmoji[600],
-	":fireworks:":         &allEmoji[600],
-	"🌈":                   &allEmoji[601],
-	":rainbow:":           &allEmoji[601],
-	"🏘":                   &allEmoji[602],
-	":houses:":            &allEmoji[602],
-	"🏰":                   &allEmoji[603],
-	":european_castle:":   &allEmoji[603],
-	"🏯":                   &allEmoji[604],
-	":japanese_castle:":   &allEmoji[604],
-	"🏟":                   &allEmoji[605],
-	":stadium:":           &allEmoji[605],
-	"🗽":                   &allEmoji[606],
-	":statue_of_liberty:": &allEmoji[606],
-	"🏠":                   &allEmoji[607],
-	":house:":             &allEmoji[607],
-	"🏡":                   &allEmoji[608],
-	":house_with_garden:": &allEmoji[608],
-	"🏚":                   &allEmoji[609],
-	":derelict_house:":    &allEmoji[609],
-	"🏢":                   &allEmoji[610],
-	":office:":            &allEmoji[610],
-	"🏬":                   &allEmoji[611],
-	":department_store:":  &allEmoji[611],
-	"🏣":                   &allEmoji[612],
-	":post_office:":       &allEmoji[612],
-	"🏤":                   &allEmoji[613],
-	":european_post_office:": &allEmoji[613],
-	"🏥":                    &allEmoji[614],
-	":hospital:":           &allEmoji[614],
-	"🏦":                    &allEmoji[615],
-	":bank:":               &allEmoji[615],
-	"🏨":                    &allEmoji[616],
-	":hotel:":              &allEmoji[616],
-	"🏪":                    &allEmoji[617],
-	":convenience_store:":  &allEmoji[617],
-	"🏫":                    &allEmoji[618],
-	":school:":             &allEmoji[618],
-	"🏩":                    &allEmoji[619],
-	":love_hotel:":         &allEmoji[619],
-	"💒":                    &allEmoji[620],
-	":wedding:":            &allEmoji[620],
-	"🏛":                    &allEmoji[621],
-	":classical_building:": &allEmoji[621],
-	"⛪️":                   &allEmoji[622],
-	":church:":             &allEmoji[622],
-	"🕌":                    &allEmoji[623],
-	":mosque:":             &allEmoji[623],
-	"🕍":                    &allEmoji[624],
-	":synagogue:":          &allEmoji[624],
-	"🕋":                    &allEmoji[625],
-	":kaaba:":              &allEmoji[625],
-	"⛩":                    &allEmoji[626],
-	":shinto_shrine:":      &allEmoji[626],
-	"⌚️":                   &allEmoji[627],
-	":watch:":              &allEmoji[627],
-	"📱":                    &allEmoji[628],
-	":iphone:":             &allEmoji[628],
-	"📲":                    &allEmoji[629],
-	":calling:":            &allEmoji[629],
-	"💻":                    &allEmoji[630],
-	":computer:":           &allEmoji[630],
-	"⌨️":                   &allEmoji[631],
-	":keyboard:":           &allEmoji[631],
-	"🖥":                    &allEmoji[632],
-	":desktop_computer:":   &allEmoji[632],
-	"🖨":                    &allEmoji[633],
-	":printer:":            &allEmoji[633],
-	"🖱":                    &allEmoji[634],
-	":computer_mouse:":     &allEmoji[634],
-	"🖲":                    &allEmoji[635],
-	":trackball:":          &allEmoji[635],
-	"🕹":                    &allEmoji[636],
-	":joystick:":           &allEmoji[636],
-	"🗜":                    &allEmoji[637],
-	":clamp:":              &allEmoji[637],
-	"💽":                    &allEmoji[638],
-	":minidisc:":           &allEmoji[638],
-	"💾":                    &allEmoji[639],
-	":floppy_disk:":        &allEmoji[639],
-	"💿":                    &allEmoji[640],
-	":cd:":                 &allEmoji[640],
-	"📀":                    &allEmoji[641],
-	":dvd:":                &allEmoji[641],
-	"📼":                    &allEmoji[642],
-	":vhs:":                &allEmoji[642],
-	"📷":                    &allEmoji[643],
-	":camera:":             &allEmoji[643],
-	"📸":                    &allEmoji[644],
-	":camera_flash:":       &allEmoji[644],
-	"📹":                    &allEmoji[645],
-	":video_camera:":       &allEmoji[645],
-	"🎥":                    &allEmoji[646],
-	":movie_camera:":       &allEmoji[646],
-	"📽":                    &allEmoji[647],
-	":film_projector:":     &allEmoji[647],
-	"🎞":                    &allEmoji[648],
-	":film_strip:":         &allEmoji[648],
-	"📞":                    &allEmoji[649],
-	":telephone_receiver:": &allEmoji[649],
-	"☎️":                   &allEmoji[650],
-	":phone:":              &allEmoji[650],
-	":telephone:":          &allEmoji[650],
-	"📟":                    &allEmoji[651],
-	":pager:":              &allEmoji[651],
-	"📠":                    &allEmoji[652],
-	":fax:":                &allEmoji[652],
-	"📺":                    &allEmoji[653],
-	":tv:":                 &allEmoji[653],
-	"📻":                    &allEmoji[654],
-	":radio:":              &allEmoji[654],
-	"🎙":                    &allEmoji[655],
-	":studio_microphone:":  &allEmoji[655],
-	"🎚":                    &allEmoji[656],
-	":level_slider:":       &allEmoji[656],
-	"🎛":                    &allEmoji[657],
-	":control_knobs:":      &allEmoji[657],
-	"⏱":                    &allEmoji[658],
-	":stopwatch:":          &allEmoji[658],
-	"⏲":                    &allEmoji[659],
-	":timer_clock:":        &allEmoji[659],
-	"⏰":                    &allEmoji[660],
-	":alarm_clock:":        &allEmoji[660],
-	"🕰":                    &allEmoji[661],
-	":mantelpiece_clock:":  &allEmoji[661],
-	"⏳": &allEmoji[662],
-	":hourglass_flowing_sand:": &allEmoji[662],
-	"⌛️":                       &allEmoji[663],
-	":hourglass:":              &allEmoji[663],
-	"📡":                        &allEmoji[664],
-	":satellite:":              &allEmoji[664],
-	"🔋":                        &allEmoji[665],
-	":battery:":                &allEmoji[665],
-	"🔌":                        &allEmoji[666],
-	":electric_plug:":          &allEmoji[666],
-	"💡":                        &allEmoji[667],
-	":bulb:":                   &allEmoji[667],
-	"🔦":                        &allEmoji[668],
-	":flashlight:":             &allEmoji[668],
-	"🕯":                        &allEmoji[669],
-	":candle:":                 &allEmoji[669],
-	"🗑":                        &allEmoji[670],
-	":wastebasket:":            &allEmoji[670],
-	"🛢":                        &allEmoji[671],
-	":oil_drum:":               &allEmoji[671],
-	"💸":                        &allEmoji[672],
-	":money_with_wings:":       &allEmoji[672],
-	"💵":                        &allEmoji[673],
-	":dollar:":                 &allEmoji[673],
-	"💴":                        &allEmoji[674],
-	":yen:":                    &allEmoji[674],
-	"💶":                        &allEmoji[675],
-	":euro:":                   &allEmoji[675],
-	"💷":                        &allEmoji[676],
-	":pound:":                  &allEmoji[676],
-	"💰":                        &allEmoji[677],
-	":moneybag:":               &allEmoji[677],
-	"💳":                        &allEmoji[678],
-	":credit_card:":            &allEmoji[678],
-	"💎":                        &allEmoji[679],
-	":gem:":                    &allEmoji[679],
-	"⚖":                        &allEmoji[680],
-	":balance_scale:":          &allEmoji[680],
-	"🔧":                        &allEmoji[681],
-	":wrench:":                 &allEmoji[681],
-	"🔨":                        &allEmoji[682],
-	":hammer:":                 &allEmoji[682],
-	"⚒":                        &allEmoji[683],
-	":hammer_and_pick:":        &allEmoji[683],
-	"🛠":                        &allEmoji[684],
-	":hammer_and_wrench:":      &allEmoji[684],
-	"⛏":                &allEmoji[685],
-	":pick:":           &allEmoji[685],
-	"🔩":                &allEmoji[686],
-	":nut_and_bolt:":   &allEmoji[686],
-	"⚙":                &allEmoji[687],
-	":gear:":           &allEmoji[687],
-	"⛓":                &allEmoji[688],
-	":chains:":         &allEmoji[688],
-	"🔫":                &allEmoji[689],
-	":gun:":            &allEmoji[689],
-	"💣":                &allEmoji[690],
-	":bomb:":           &allEmoji[690],
-	"🔪":                &allEmoji[691],
-	":hocho:":          &allEmoji[691],
-	":knife:":          &allEmoji[691],
-	"🗡":                &allEmoji[692],
-	":dagger:":         &allEmoji[692],
-	"⚔":                &allEmoji[693],
-	":crossed_swords:": &allEmoji[693],
-	"🛡":                &allEmoji[694],
-	":shield:":         &allEmoji[694],
-	"🚬":                &allEmoji[695],
-	":smoking:":        &allEmoji[695],
-	"⚰":                &allEmoji[696],
-	":coffin:":         &allEmoji[696],
-	"⚱":                &allEmoji[697],
-	":funeral_urn:":    &allEmoji[697],
-	"🏺":                &allEmoji[698],
-	":amphora:":        &allEmoji[698],
-	"🔮":                &allEmoji[699],
-	":crystal_ball:":   &allEmoji[699],
-	"📿":                &allEmoji[700],
-	":prayer_beads:":   &allEmoji[700],
-	"💈":                &allEmoji[701],
-	":barber:":         &allEmoji[701],
-	"⚗":                &allEmoji[702],
-	":alembic:":        &allEmoji[702],
-	"🔭":                &allEmoji[703],
-	":telescope:":      &allEmoji[703],
-	"🔬":                &allEmoji[704],
-	":microscope:":     &allEmoji[704],
-	"🕳":                &allEmoji[705],
-	":hole:":           &allEmoji[705],
-	"💊":                &allEmoji[706],
-	":pill:":           &allEmoji[706],
-	"💉":                &allEmoji[707],
-	":syringe:":        &allEmoji[707],
-	"🌡":                &allEmoji[708],
-	":thermometer:":    &allEmoji[708],
-	"🚽":                &allEmoji[709],
-	":toilet:":         &allEmoji[709],
-	"🚿":                &allEmoji[710],
-	":shower:":         &allEmoji[710],
-	"🛁":                &allEmoji[711],
-	":bathtub:":        &allEmoji[711],
-	"🛎":                &allEmoji[712],
-	":bellhop_bell:":   &allEmoji[712],
-	"🔑":                &allEmoji[713],
-	":key:":            &allEmoji[713],
-	"🗝":                &allEmoji[714],
-	":old_key:":        &allEmoji[714],
-	"🚪":                &allEmoji[715],
-	":door:":           &allEmoji[715],
-	"🛋":                &allEmoji[716],
-	":couch_and_lamp:": &allEmoji[716],
-	"🛌":                &allEmoji[717],
-	":sleeping_bed:":   &allEmoji[717],
-	"🛏":                &allEmoji[718],
-	":bed:":            &allEmoji[718],
-	"🖼":                &allEmoji[719],
-	":framed_picture:": &allEmoji[719],
-	"⛱":                &allEmoji[720],
-	":parasol_on_ground:":   &allEmoji[720],
-	"🗿":                     &allEmoji[721],
-	":moyai:":               &allEmoji[721],
-	"🛍":                     &allEmoji[722],
-	":shopping:":            &allEmoji[722],
-	"🎁":                     &allEmoji[723],
-	":gift:":                &allEmoji[723],
-	"🎈":                     &allEmoji[724],
-	":balloon:":             &allEmoji[724],
-	"🎏":                     &allEmoji[725],
-	":flags:":               &allEmoji[725],
-	"🎀":                     &allEmoji[726],
-	":ribbon:":              &allEmoji[726],
-	"🎊":                     &allEmoji[727],
-	":confetti_ball:":       &allEmoji[727],
-	"🎉":                     &allEmoji[728],
-	":tada:":                &allEmoji[728],
-	"🎐":                     &allEmoji[729],
-	":wind_chime:":          &allEmoji[729],
-	"🏮":                     &allEmoji[730],
-	":izakaya_lantern:":     &allEmoji[730],
-	":lantern:":             &allEmoji[730],
-	"🎎":                     &allEmoji[731],
-	":dolls:":               &allEmoji[731],
-	"✉️":                    &allEmoji[732],
-	":email:":               &allEmoji[732],
-	":envelope:":            &allEmoji[732],
-	"📩":                     &allEmoji[733],
-	":envelope_with_arrow:": &allEmoji[733],
-	"📨":                     &allEmoji[734],
-	":incoming_envelope:":   &allEmoji[734],
-	"📧":                     &allEmoji[735],
-	":e-mail:":              &allEmoji[735],
-	"💌":                     &allEmoji[736],
-	":love_letter:":         &allEmoji[736],
-	"📥":                     &allEmoji[737],
-	":inbox_tray:":          &allEmoji[737],
-	"📤":                     &allEmoji[738],
-	":outbox_tray:":         &allEmoji[738],
-	"📦":                     &allEmoji[739],
-	":package:":             &allEmoji[739],
-	"🏷":                     &allEmoji[740],
-	":label:":               &allEmoji[740],
-	"🔖":                     &allEmoji[741],
-	":bookmark:":            &allEmoji[741],
-	"📪":                     &allEmoji[742],
-	":mailbox_closed:":      &allEmoji[742],
-	"📫":                     &allEmoji[743],
-	":mailbox:":             &allEmoji[743],
-	"📬":                     &allEmoji[744],
-	":mailbox_with_mail:":   &allEmoji[744],
-	"📭":                     &allEmoji[745],
-	":mailbox_with_no_mail:": &allEmoji[745],
-	"📮":                &allEmoji[746],
-	":postbox:":        &allEmoji[746],
-	"📯":                &allEmoji[747],
-	":postal_horn:":    &allEmoji[747],
-	"📜":                &allEmoji[748],
-	":scroll:":         &allEmoji[748],
-	"📃":                &allEmoji[749],
-	":page_with_curl:": &allEmoji[749],
-	"📄":                &allEmoji[750],
-	":page_facing_up:": &allEmoji[750],
-	"📑":                &allEmoji[751],
-	":bookmark_tabs:":  &allEmoji[751],
-	"📊":                &allEmoji[752],
-	":bar_chart:":      &allEmoji[752],
-	"📈":                &allEmoji[753],
-	":chart_with_upwards_trend:": &allEmoji[753],
-	"📉": &allEmoji[754],
-	":chart_with_downwards_trend:": &allEmoji[754],
-	"🗒":                     &allEmoji[755],
-	":spiral_notepad:":      &allEmoji[755],
-	"🗓":                     &allEmoji[756],
-	":spiral_calendar:":     &allEmoji[756],
-	"📆":                     &allEmoji[757],
-	":calendar:":            &allEmoji[757],
-	"📅":                     &allEmoji[758],
-	":date:":                &allEmoji[758],
-	"📇":                     &allEmoji[759],
-	":card_index:":          &allEmoji[759],
-	"🗃":                     &allEmoji[760],
-	":card_file_box:":       &allEmoji[760],
-	"🗳":                     &allEmoji[761],
-	":ballot_box:":          &allEmoji[761],
-	"🗄":                     &allEmoji[762],
-	":file_cabinet:":        &allEmoji[762],
-	"📋":                     &allEmoji[763],
-	":clipboard:":           &allEmoji[763],
-	"📁":                     &allEmoji[764],
-	":file_folder:":         &allEmoji[764],
-	"📂":                     &allEmoji[765],
-	":open_file_folder:":    &allEmoji[765],
-	"🗂":                     &allEmoji[766],
-	":card_index_dividers:": &allEmoji[766],
-	"🗞":                     &allEmoji[767],
-	":newspaper_roll:":      &allEmoji[767],
-	"📰":                     &allEmoji[768],
-	":newspaper:":           &allEmoji[768],
-	"📓":                     &allEmoji[769],
-	":notebook:":            &allEmoji[769],
-	"📔":                     &allEmoji[770],
-	":notebook_with_decorative_cover:": &allEmoji[770],
-	"📒":                  &allEmoji[771],
-	":ledger:":           &allEmoji[771],
-	"📕":                  &allEmoji[772],
-	":closed_book:":      &allEmoji[772],
-	"📗":                  &allEmoji[773],
-	":green_book:":       &allEmoji[773],
-	"📘":                  &allEmoji[774],
-	":blue_book:":        &allEmoji[774],
-	"📙":                  &allEmoji[775],
-	":orange_book:":      &allEmoji[775],
-	"📚":                  &allEmoji[776],
-	":books:":            &allEmoji[776],
-	"📖":                  &allEmoji[777],
-	":book:":             &allEmoji[777],
-	":open_book:":        &allEmoji[777],
-	"🔗":                  &allEmoji[778],
-	":link:":             &allEmoji[778],
-	"📎":                  &allEmoji[779],
-	":paperclip:":        &allEmoji[779],
-	"🖇":                  &allEmoji[780],
-	":paperclips:":       &allEmoji[780],
-	"📐":                  &allEmoji[781],
-	":triangular_ruler:": &allEmoji[781],
-	"📏":                  &allEmoji[782],
-	":straight_ruler:":   &allEmoji[782],
-	"✂️":                 &allEmoji[783],
-	":scissors:":         &allEmoji[783],
-	"📌":                  &allEmoji[784],
-	":pushpin:":          &allEmoji[784],
-	"📍":                  &allEmoji[785],
-	":round_pushpin:":    &allEmoji[785],
-	"🚩":                  &allEmoji[786],
-	":triangular_flag_on_post:": &allEmoji[786],
-	"🎌":                   &allEmoji[787],
-	":crossed_flags:":     &allEmoji[787],
-	"🏳️":                  &allEmoji[788],
-	":white_flag:":        &allEmoji[788],
-	"🏴":                   &allEmoji[789],
-	":black_flag:":        &allEmoji[789],
-	"🏁":                   &allEmoji[790],
-	":checkered_flag:":    &allEmoji[790],
-	"🏳️\u200d🌈":           &allEmoji[791],
-	":rainbow_flag:":      &allEmoji[791],
-	"🖌":                   &allEmoji[792],
-	":paintbrush:":        &allEmoji[792],
-	"🖍":                   &allEmoji[793],
-	":crayon:":            &allEmoji[793],
-	"🖊":                   &allEmoji[794],
-	":pen:":               &allEmoji[794],
-	"🖋":                   &allEmoji[795],
-	":fountain_pen:":      &allEmoji[795],
-	"✒️":                  &allEmoji[796],
-	":black_nib:":         &allEmoji[796],
-	"📝":                   &allEmoji[797],
-	":memo:":              &allEmoji[797],
-	":pencil:":            &allEmoji[797],
-	"✏️":                  &allEmoji[798],
-	":pencil2:":           &allEmoji[798],
-	"🔏":                   &allEmoji[799],
-	":lock_with_ink_pen:": &allEmoji[799],
-	"🔐":                   &allEmoji[800],
-	":closed_lock_with_key:": &allEmoji[800],
-	"🔒":                         &allEmoji[801],
-	":lock:":                    &allEmoji[801],
-	"🔓":                         &allEmoji[802],
-	":unlock:":                  &allEmoji[802],
-	"🔍":                         &allEmoji[803],
-	":mag:":                     &allEmoji[803],
-	"🔎":                         &allEmoji[804],
-	":mag_right:":               &allEmoji[804],
-	"❤️":                        &allEmoji[805],
-	":heart:":                   &allEmoji[805],
-	"💛":                         &allEmoji[806],
-	":yellow_heart:":            &allEmoji[806],
-	"💚":                         &allEmoji[807],
-	":green_heart:":             &allEmoji[807],
-	"💙":                         &allEmoji[808],
-	":blue_heart:":              &allEmoji[808],
-	"💜":                         &allEmoji[809],
-	":purple_heart:":            &allEmoji[809],
-	"💔":                         &allEmoji[810],
-	":broken_heart:":            &allEmoji[810],
-	"❣️":                        &allEmoji[811],
-	":heavy_heart_exclamation:": &allEmoji[811],
-	"💕":                          &allEmoji[812],
-	":two_hearts:":               &allEmoji[812],
-	"💞":                          &allEmoji[813],
-	":revolving_hearts:":         &allEmoji[813],
-	"💓":                          &allEmoji[814],
-	":heartbeat:":                &allEmoji[814],
-	"💗":                          &allEmoji[815],
-	":heartpulse:":               &allEmoji[815],
-	"💖":                          &allEmoji[816],
-	":sparkling_heart:":          &allEmoji[816],
-	"💘":                          &allEmoji[817],
-	":cupid:":                    &allEmoji[817],
-	"💝":                          &allEmoji[818],
-	":gift_heart:":               &allEmoji[818],
-	"💟":                          &allEmoji[819],
-	":heart_decoration:":         &allEmoji[819],
-	"☮️":                         &allEmoji[820],
-	":peace_symbol:":             &allEmoji[820],
-	"✝️":                         &allEmoji[821],
-	":latin_cross:":              &allEmoji[821],
-	"☪️":                         &allEmoji[822],
-	":star_and_crescent:":        &allEmoji[822],
-	"🕉":                          &allEmoji[823],
-	":om:":                       &allEmoji[823],
-	"☸️":                         &allEmoji[824],
-	":wheel_of_dharma:":          &allEmoji[824],
-	"✡️":                         &allEmoji[825],
-	":star_of_david:":            &allEmoji[825],
-	"🔯":                          &allEmoji[826],
-	":six_pointed_star:":         &allEmoji[826],
-	"🕎":                          &allEmoji[827],
-	":menorah:":                  &allEmoji[827],
-	"☯️":                         &allEmoji[828],
-	":yin_yang:":                 &allEmoji[828],
-	"☦️":                         &allEmoji[829],
-	":orthodox_cross:":           &allEmoji[829],
-	"🛐":                          &allEmoji[830],
-	":place_of_worship:":         &allEmoji[830],
-	"⛎":                          &allEmoji[831],
-	":ophiuchus:":                &allEmoji[831],
-	"♈️":                         &allEmoji[832],
-	":aries:":                    &allEmoji[832],
-	"♉️":                         &allEmoji[833],
-	":taurus:":                   &allEmoji[833],
-	"♊️":                         &allEmoji[834],
-	":gemini:":                   &allEmoji[834],
-	"♋️":                         &allEmoji[835],
-	":cancer:":                   &allEmoji[835],
-	"♌️":                         &allEmoji[836],
-	":leo:":                      &allEmoji[836],
-	"♍️":                         &allEmoji[837],
-	":virgo:":                    &allEmoji[837],
-	"♎️":                         &allEmoji[838],
-	":libra:":                    &allEmoji[838],
-	"♏️":                         &allEmoji[839],
-	":scorpius:":                 &allEmoji[839],
-	"♐️":                         &allEmoji[840],
-	":sagittarius:":              &allEmoji[840],
-	"♑️":                         &allEmoji[841],
-	":capricorn:":                &allEmoji[841],
-	"♒️":                         &allEmoji[842],
-	":aquarius:":                 &allEmoji[842],
-	"♓️":                         &allEmoji[843],
-	":pisces:":                   &allEmoji[843],
-	"🆔":                          &allEmoji[844],
-	":id:":                       &allEmoji[844],
-	"⚛":                          &allEmoji[845],
-	":atom_symbol:":              &allEmoji[845],
-	"🈳":                          &allEmoji[846],
-	":u7a7a:":                    &allEmoji[846],
-	"🈹":                          &allEmoji[847],
-	":u5272:":                    &allEmoji[847],
-	"☢️":                         &allEmoji[848],
-	":radioactive:":              &allEmoji[848],
-	"☣️":                         &allEmoji[849],
-	":biohazard:":                &allEmoji[849],
-	"📴":                          &allEmoji[850],
-	":mobile_phone_off:":         &allEmoji[850],
-	"📳":                          &allEmoji[851],
-	":vibration_mode:":           &allEmoji[851],
-	"🈶":                          &allEmoji[852],
-	":u6709:":                    &allEmoji[852],
-	"🈚️":                         &allEmoji[853],
-	":u7121:":                    &allEmoji[853],
-	"🈸":                          &allEmoji[854],
-	":u7533:":                    &allEmoji[854],
-	"🈺":                          &allEmoji[855],
-	":u55b6:":                    &allEmoji[855],
-	"🈷️":                         &allEmoji[856],
-	":u6708:":                    &allEmoji[856],
-	"✴️":                         &allEmoji[857],
-	":eight_pointed_black_star:": &allEmoji[857],
-	"🆚":                        &allEmoji[858],
-	":vs:":                     &allEmoji[858],
-	"🉑":                        &allEmoji[859],
-	":accept:":                 &allEmoji[859],
-	"💮":                        &allEmoji[860],
-	":white_flower:":           &allEmoji[860],
-	"🉐":                        &allEmoji[861],
-	":ideograph_advantage:":    &allEmoji[861],
-	"㊙️":                       &allEmoji[862],
-	":secret:":                 &allEmoji[862],
-	"㊗️":                       &allEmoji[863],
-	":congratulations:":        &allEmoji[863],
-	"🈴":                        &allEmoji[864],
-	":u5408:":                  &allEmoji[864],
-	"🈵":                        &allEmoji[865],
-	":u6e80:":                  &allEmoji[865],
-	"🈲":                        &allEmoji[866],
-	":u7981:":                  &allEmoji[866],
-	"🅰️":                       &allEmoji[867],
-	":a:":                      &allEmoji[867],
-	"🅱️":                       &allEmoji[868],
-	":b:":                      &allEmoji[868],
-	"🆎":                        &allEmoji[869],
-	":ab:":                     &allEmoji[869],
-	"🆑":                        &allEmoji[870],
-	":cl:":                     &allEmoji[870],
-	"🅾️":                       &allEmoji[871],
-	":o2:":                     &allEmoji[871],
-	"🆘":                        &allEmoji[872],
-	":sos:":                    &allEmoji[872],
-	"⛔️":                       &allEmoji[873],
-	":no_entry:":               &allEmoji[873],
-	"📛":                        &allEmoji[874],
-	":name_badge:":             &allEmoji[874],
-	"🚫":                        &allEmoji[875],
-	":no_entry_sign:":          &allEmoji[875],
-	"❌":                        &allEmoji[876],
-	":x:":                      &allEmoji[876],
-	"⭕️":                       &allEmoji[877],
-	":o:":                      &allEmoji[877],
-	"💢":                        &allEmoji[878],
-	":anger:":                  &allEmoji[878],
-	"♨️":                       &allEmoji[879],
-	":hotsprings:":             &allEmoji[879],
-	"🚷":                        &allEmoji[880],
-	":no_pedestrians:":         &allEmoji[880],
-	"🚯":                        &allEmoji[881],
-	":do_not_litter:":          &allEmoji[881],
-	"🚳":                        &allEmoji[882],
-	":no_bicycles:":            &allEmoji[882],
-	"🚱":                        &allEmoji[883],
-	":non-potable_water:":      &allEmoji[883],
-	"🔞":                        &allEmoji[884],
-	":underage:":               &allEmoji[884],
-	"📵":                        &allEmoji[885],
-	":no_mobile_phones:":       &allEmoji[885],
-	"❗️":                       &allEmoji[886],
-	":exclamation:":            &allEmoji[886],
-	":heavy_exclamation_mark:": &allEmoji[886],
-	"❕":                       &allEmoji[887],
-	":grey_exclamation:":      &allEmoji[887],
-	"❓":                       &allEmoji[888],
-	":question:":              &allEmoji[888],
-	"❔":                       &allEmoji[889],
-	":grey_question:":         &allEmoji[889],
-	"‼️":                      &allEmoji[890],
-	":bangbang:":              &allEmoji[890],
-	"⁉️":                      &allEmoji[891],
-	":interrobang:":           &allEmoji[891],
-	"💯":                       &allEmoji[892],
-	":100:":                   &allEmoji[892],
-	"🔅":                       &allEmoji[893],
-	":low_brightness:":        &allEmoji[893],
-	"🔆":                       &allEmoji[894],
-	":high_brightness:":       &allEmoji[894],
-	"🔱":                       &allEmoji[895],
-	":trident:":               &allEmoji[895],
-	"⚜":                       &allEmoji[896],
-	":fleur_de_lis:":          &allEmoji[896],
-	"〽️":                      &allEmoji[897],
-	":part_alternation_mark:": &allEmoji[897],
-	"⚠️":                      &allEmoji[898],
-	":warning:":               &allEmoji[898],
-	"🚸":                       &allEmoji[899],
-	":children_crossing:":     &allEmoji[899],
-	"🔰":                       &allEmoji[900],
-	":beginner:":              &allEmoji[900],
-	"♻️":                      &allEmoji[901],
-	":recycle:":               &allEmoji[901],
-	"🈯️":                      &allEmoji[902],
-	":u6307:":                 &allEmoji[902],
-	"💹":                       &allEmoji[903],
-	":chart:":                 &allEmoji[903],
-	"❇️":                      &allEmoji[904],
-	":sparkle:":               &allEmoji[904],
-	"✳️":                      &allEmoji[905],
-	":eight_spoked_asterisk:": &allEmoji[905],
-	"❎": &allEmoji[906],
-	":negative_squared_cross_mark:": &allEmoji[906],
-	"✅":                  &allEmoji[907],
-	":white_check_mark:": &allEmoji[907],
-	"🌐":                  &allEmoji[908],
-	":globe_with_meridians:": &allEmoji[908],
-	"Ⓜ️":                     &allEmoji[909],
-	":m:":                    &allEmoji[909],
-	"💠":                      &allEmoji[910],
-	":diamond_shape_with_a_dot_inside:": &allEmoji[910],
-	"🌀":                  &allEmoji[911],
-	":cyclone:":          &allEmoji[911],
-	"➿":                  &allEmoji[912],
-	":loop:":             &allEmoji[912],
-	"🏧":                  &allEmoji[913],
-	":atm:":              &allEmoji[913],
-	"🈂️":                 &allEmoji[914],
-	":sa:":               &allEmoji[914],
-	"🛂":                  &allEmoji[915],
-	":passport_control:": &allEmoji[915],
-	"🛃":                  &allEmoji[916],
-	":customs:":          &allEmoji[916],
-	"🛄":                  &allEmoji[917],
-	":baggage_claim:":    &allEmoji[917],
-	"🛅":                  &allEmoji[918],
-	":left_luggage:":     &allEmoji[918],
-	"♿️":                 &allEmoji[919],
-	":wheelchair:":       &allEmoji[919],
-	"🚭":                  &allEmoji[920],
-	":no_smoking:":       &allEmoji[920],
-	"🚾":                  &allEmoji[921],
-	":wc:":               &allEmoji[921],
-	"🅿️":                 &allEmoji[922],
-	":parking:":          &allEmoji[922],
-	"🚰":                  &allEmoji[923],
-	":potable_water:":    &allEmoji[923],
-	"🚹":                  &allEmoji[924],
-	":mens:":             &allEmoji[924],
-	"🚺":                  &allEmoji[925],
-	":womens:":           &allEmoji[925],
-	"🚼":                  &allEmoji[926],
-	":baby_symbol:":      &allEmoji[926],
-	"🚻":                  &allEmoji[927],
-	":restroom:":         &allEmoji[927],
-	"🚮":                  &allEmoji[928],
-	":put_litter_in_its_place:": &allEmoji[928],
-	"🎦":                    &allEmoji[929],
-	":cinema:":             &allEmoji[929],
-	"📶":                    &allEmoji[930],
-	":signal_strength:":    &allEmoji[930],
-	"🈁":                    &allEmoji[931],
-	":koko:":               &allEmoji[931],
-	"🔤":                    &allEmoji[932],
-	":abc:":                &allEmoji[932],
-	"🔡":                    &allEmoji[933],
-	":abcd:":               &allEmoji[933],
-	"🔠":                    &allEmoji[934],
-	":capital_abcd:":       &allEmoji[934],
-	"🔣":                    &allEmoji[935],
-	":symbols:":            &allEmoji[935],
-	"ℹ️":                   &allEmoji[936],
-	":information_source:": &allEmoji[936],
-	"🆖":                    &allEmoji[937],
-	":ng:":                 &allEmoji[937],
-	"🆗":                    &allEmoji[938],
-	":ok:":                 &allEmoji[938],
-	"🆙":                    &allEmoji[939],
-	":up:":                 &allEmoji[939],
-	"🆒":                    &allEmoji[940],
-	":cool:":               &allEmoji[940],
-	"🆕":                    &allEmoji[941],
-	":new:":                &allEmoji[941],
-	"🆓":                    &allEmoji[942],
-	":free:":               &allEmoji[942],
-	"0️⃣":                  &allEmoji[943],
-	":zero:":               &allEmoji[943],
-	"1️⃣":                  &allEmoji[944],
-	":one:":                &allEmoji[944],
-	"2️⃣":                  &allEmoji[945],
-	":two:":                &allEmoji[945],
-	"3️⃣":                  &allEmoji[946],
-	":three:":              &allEmoji[946],
-	"4️⃣":                  &allEmoji[947],
-	":four:":               &allEmoji[947],
-	"5️⃣":                  &allEmoji[948],
-	":five:":               &allEmoji[948],
-	"6️⃣":                  &allEmoji[949],
-	":six:":                &allEmoji[949],
-	"7️⃣":                  &allEmoji[950],
-	":seven:":              &allEmoji[950],
-	"8️⃣":                  &allEmoji[951],
-	":eight:":              &allEmoji[951],
-	"9️⃣":                  &allEmoji[952],
-	":nine:":               &allEmoji[952],
-	"🔟":                    &allEmoji[953],
-	":keycap_ten:":         &allEmoji[953],
-	"🔢":                    &allEmoji[954],
-	":1234:":               &allEmoji[954],
-	"#️⃣":                  &allEmoji[955],
-	":hash:":               &allEmoji[955],
-	"*️⃣":                  &allEmoji[956],
-	":asterisk:":           &allEmoji[956],
-	"▶️":                   &allEmoji[957],
-	":arrow_forward:":      &allEmoji[957],
-	"⏸":                    &allEmoji[958],
-	":pause_button:":       &allEmoji[958],
-	"⏯":                    &allEmoji[959],
-	":play_or_pause_button:": &allEmoji[959],
-	"⏹":               &allEmoji[960],
-	":stop_button:":   &allEmoji[960],
-	"⏺":               &allEmoji[961],
-	":record_button:": &allEmoji[961],
-	"⏭":               &allEmoji[962],
-	":next_track_button:": &allEmoji[962],
-	"⏮": &allEmoji[963],
-	":previous_track_button:": &allEmoji[963],
-	"⏩":                 &allEmoji[964],
-	":fast_forward:":    &allEmoji[964],
-	"⏪":                 &allEmoji[965],
-	":rewind:":          &allEmoji[965],
-	"⏫":                 &allEmoji[966],
-	":arrow_double_up:": &allEmoji[966],
-	"⏬":                 &allEmoji[967],
-	":arrow_double_down:":         &allEmoji[967],
-	"◀️":                          &allEmoji[968],
-	":arrow_backward:":            &allEmoji[968],
-	"🔼":                           &allEmoji[969],
-	":arrow_up_small:":            &allEmoji[969],
-	"🔽":                           &allEmoji[970],
-	":arrow_down_small:":          &allEmoji[970],
-	"➡️":                          &allEmoji[971],
-	":arrow_right:":               &allEmoji[971],
-	"⬅️":                          &allEmoji[972],
-	":arrow_left:":                &allEmoji[972],
-	"⬆️":                          &allEmoji[973],
-	":arrow_up:":                  &allEmoji[973],
-	"⬇️":                          &allEmoji[974],
-	":arrow_down:":                &allEmoji[974],
-	"↗️":                          &allEmoji[975],
-	":arrow_upper_right:":         &allEmoji[975],
-	"↘️":                          &allEmoji[976],
-	":arrow_lower_right:":         &allEmoji[976],
-	"↙️":                          &allEmoji[977],
-	":arrow_lower_left:":          &allEmoji[977],
-	"↖️":                          &allEmoji[978],
-	":arrow_upper_left:":          &allEmoji[978],
-	"↕️":                          &allEmoji[979],
-	":arrow_up_down:":             &allEmoji[979],
-	"↔️":                          &allEmoji[980],
-	":left_right_arrow:":          &allEmoji[980],
-	"↪️":                          &allEmoji[981],
-	":arrow_right_hook:":          &allEmoji[981],
-	"↩️":                          &allEmoji[982],
-	":leftwards_arrow_with_hook:": &allEmoji[982],
-	"⤴️":                          &allEmoji[983],
-	":arrow_heading_up:":          &allEmoji[983],
-	"⤵️":                          &allEmoji[984],
-	":arrow_heading_down:":        &allEmoji[984],
-	"🔀":                           &allEmoji[985],
-	":twisted_rightwards_arrows:": &allEmoji[985],
-	"🔁":            &allEmoji[986],
-	":repeat:":     &allEmoji[986],
-	"🔂":            &allEmoji[987],
-	":repeat_one:": &allEmoji[987],
-	"🔄":            &allEmoji[988],
-	":arrows_counterclockwise:": &allEmoji[988],
-	"🔃":                  &allEmoji[989],
-	":arrows_clockwise:": &allEmoji[989],
-	"🎵":                  &allEmoji[990],
-	":musical_note:":     &allEmoji[990],
-	"🎶":                  &allEmoji[991],
-	":notes:":            &allEmoji[991],
-	"〰️":                 &allEmoji[992],
-	":wavy_dash:":        &allEmoji[992],
-	"➰":                  &allEmoji[993],
-	":curly_loop:":       &allEmoji[993],
-	"✔️":                 &allEmoji[994],
-	":heavy_check_mark:": &allEmoji[994],
-	"➕":                  &allEmoji[995],
-	":heavy_plus_sign:":  &allEmoji[995],
-	"➖":                  &allEmoji[996],
-	":heavy_minus_sign:": &allEmoji[996],
-	"➗":                  &allEmoji[997],
-	":heavy_division_sign:":    &allEmoji[997],
-	"✖️":                       &allEmoji[998],
-	":heavy_multiplication_x:": &allEmoji[998],
-	"💲":                       &allEmoji[999],
-	":heavy_dollar_sign:":     &allEmoji[999],
-	"💱":                       &allEmoji[1000],
-	":currency_exchange:":     &allEmoji[1000],
-	"™️":                      &allEmoji[1001],
-	":tm:":                    &allEmoji[1001],
-	"©️":                      &allEmoji[1002],
-	":copyright:":             &allEmoji[1002],
-	"®️":                      &allEmoji[1003],
-	":registered:":            &allEmoji[1003],
-	"🔚":                       &allEmoji[1004],
-	":end:":                   &allEmoji[1004],
-	"🔙":                       &allEmoji[1005],
-	":back:":                  &allEmoji[1005],
-	"🔛":                       &allEmoji[1006],
-	":on:":                    &allEmoji[1006],
-	"🔝":                       &allEmoji[1007],
-	":top:":                   &allEmoji[1007],
-	"🔜":                       &allEmoji[1008],
-	":soon:":                  &allEmoji[1008],
-	"☑️":                      &allEmoji[1009],
-	":ballot_box_with_check:": &allEmoji[1009],
-	"🔘":                    &allEmoji[1010],
-	":radio_button:":       &allEmoji[1010],
-	"⚪️":                   &allEmoji[1011],
-	":white_circle:":       &allEmoji[1011],
-	"⚫️":                   &allEmoji[1012],
-	":black_circle:":       &allEmoji[1012],
-	"🔴":                    &allEmoji[1013],
-	":red_circle:":         &allEmoji[1013],
-	"🔵":                    &allEmoji[1014],
-	":large_blue_circle:":  &allEmoji[1014],
-	"🔺":                    &allEmoji[1015],
-	":small_red_triangle:": &allEmoji[1015],
-	"🔻":                    &allEmoji[1016],
-	":small_red_triangle_down:": &allEmoji[1016],
-	"🔸": &allEmoji[1017],
-	":small_orange_diamond:": &allEmoji[1017],
-	"🔹":                    &allEmoji[1018],
-	":small_blue_diamond:": &allEmoji[1018],
-	"🔶":                    &allEmoji[1019],
-	":large_orange_diamond:": &allEmoji[1019],
-	"🔷":                           &allEmoji[1020],
-	":large_blue_diamond:":        &allEmoji[1020],
-	"🔳":                           &allEmoji[1021],
-	":white_square_button:":       &allEmoji[1021],
-	"🔲":                           &allEmoji[1022],
-	":black_square_button:":       &allEmoji[1022],
-	"▪️":                          &allEmoji[1023],
-	":black_small_square:":        &allEmoji[1023],
-	"▫️":                          &allEmoji[1024],
-	":white_small_square:":        &allEmoji[1024],
-	"◾️":                          &allEmoji[1025],
-	":black_medium_small_square:": &allEmoji[1025],
-	"◽️":                          &allEmoji[1026],
-	":white_medium_small_square:": &allEmoji[1026],
-	"◼️":                          &allEmoji[1027],
-	":black_medium_square:":       &allEmoji[1027],
-	"◻️":                          &allEmoji[1028],
-	":white_medium_square:":       &allEmoji[1028],
-	"⬛️":                          &allEmoji[1029],
-	":black_large_square:":        &allEmoji[1029],
-	"⬜️":                          &allEmoji[1030],
-	":white_large_square:":        &allEmoji[1030],
-	"🔇":                           &allEmoji[1031],
-	":mute:":                      &allEmoji[1031],
-	"🔈":                           &allEmoji[1032],
-	":speaker:":                   &allEmoji[1032],
-	"🔉":                           &allEmoji[1033],
-	":sound:":                     &allEmoji[1033],
-	"🔊":                           &allEmoji[1034],
-	":loud_sound:":                &allEmoji[1034],
-	"🔕":                           &allEmoji[1035],
-	":no_bell:":                   &allEmoji[1035],
-	"🔔":                           &allEmoji[1036],
-	":bell:":                      &allEmoji[1036],
-	"📣":                           &allEmoji[1037],
-	":mega:":                      &allEmoji[1037],
-	"📢":                           &allEmoji[1038],
-	":loudspeaker:":               &allEmoji[1038],
-	"👁\u200d🗨":                    &allEmoji[1039],
-	":eye_speech_bubble:":         &allEmoji[1039],
-	"💬":                           &allEmoji[1040],
-	":speech_balloon:":            &allEmoji[1040],
-	"💭":                           &allEmoji[1041],
-	":thought_balloon:":           &allEmoji[1041],
-	"🗯":                           &allEmoji[1042],
-	":right_anger_bubble:":        &allEmoji[1042],
-	"🃏":                           &allEmoji[1043],
-	":black_joker:":               &allEmoji[1043],
-	"🀄️":                          &allEmoji[1044],
-	":mahjong:":                   &allEmoji[1044],
-	"🎴":                           &allEmoji[1045],
-	":flower_playing_cards:":           &allEmoji[1045],
-	"♠️":                               &allEmoji[1046],
-	":spades:":                         &allEmoji[1046],
-	"♣️":                               &allEmoji[1047],
-	":clubs:":                          &allEmoji[1047],
-	"♥️":                               &allEmoji[1048],
-	":hearts:":                         &allEmoji[1048],
-	"♦️":                               &allEmoji[1049],
-	":diamonds:":                       &allEmoji[1049],
-	"🕐":                                &allEmoji[1050],
-	":clock1:":                         &allEmoji[1050],
-	"🕑":                                &allEmoji[1051],
-	":clock2:":                         &allEmoji[1051],
-	"🕒":                                &allEmoji[1052],
-	":clock3:":                         &allEmoji[1052],
-	"🕓":                                &allEmoji[1053],
-	":clock4:":                         &allEmoji[1053],
-	"🕔":                                &allEmoji[1054],
-	":clock5:":                         &allEmoji[1054],
-	"🕕":                                &allEmoji[1055],
-	":clock6:":                         &allEmoji[1055],
-	"🕖":                                &allEmoji[1056],
-	":clock7:":                         &allEmoji[1056],
-	"🕗":                                &allEmoji[1057],
-	":clock8:":                         &allEmoji[1057],
-	"🕘":                                &allEmoji[1058],
-	":clock9:":                         &allEmoji[1058],
-	"🕙":                                &allEmoji[1059],
-	":clock10:":                        &allEmoji[1059],
-	"🕚":                                &allEmoji[1060],
-	":clock11:":                        &allEmoji[1060],
-	"🕛":                                &allEmoji[1061],
-	":clock12:":                        &allEmoji[1061],
-	"🕜":                                &allEmoji[1062],
-	":clock130:":                       &allEmoji[1062],
-	"🕝":                                &allEmoji[1063],
-	":clock230:":                       &allEmoji[1063],
-	"🕞":                                &allEmoji[1064],
-	":clock330:":                       &allEmoji[1064],
-	"🕟":                                &allEmoji[1065],
-	":clock430:":                       &allEmoji[1065],
-	"🕠":                                &allEmoji[1066],
-	":clock530:":                       &allEmoji[1066],
-	"🕡":                                &allEmoji[1067],
-	":clock630:":                       &allEmoji[1067],
-	"🕢":                                &allEmoji[1068],
-	":clock730:":                       &allEmoji[1068],
-	"🕣":                                &allEmoji[1069],
-	":clock830:":                       &allEmoji[1069],
-	"🕤":                                &allEmoji[1070],
-	":clock930:":                       &allEmoji[1070],
-	"🕥":                                &allEmoji[1071],
-	":clock1030:":                      &allEmoji[1071],
-	"🕦":                                &allEmoji[1072],
-	":clock1130:":                      &allEmoji[1072],
-	"🕧":                                &allEmoji[1073],
-	":clock1230:":                      &allEmoji[1073],
-	"🇦🇫":                               &allEmoji[1074],
-	":afghanistan:":                    &allEmoji[1074],
-	"🇦🇽":                               &allEmoji[1075],
-	":aland_islands:":                  &allEmoji[1075],
-	"🇦🇱":                               &allEmoji[1076],
-	":albania:":                        &allEmoji[1076],
-	"🇩🇿":                               &allEmoji[1077],
-	":algeria:":                        &allEmoji[1077],
-	"🇦🇸":                               &allEmoji[1078],
-	":american_samoa:":                 &allEmoji[1078],
-	"🇦🇩":                               &allEmoji[1079],
-	":andorra:":                        &allEmoji[1079],
-	"🇦🇴":                               &allEmoji[1080],
-	":angola:":                         &allEmoji[1080],
-	"🇦🇮":                               &allEmoji[1081],
-	":anguilla:":                       &allEmoji[1081],
-	"🇦🇶":                               &allEmoji[1082],
-	":antarctica:":                     &allEmoji[1082],
-	"🇦🇬":                               &allEmoji[1083],
-	":antigua_barbuda:":                &allEmoji[1083],
-	"🇦🇷":                               &allEmoji[1084],
-	":argentina:":                      &allEmoji[1084],
-	"🇦🇲":                               &allEmoji[1085],
-	":armenia:":                        &allEmoji[1085],
-	"🇦🇼":                               &allEmoji[1086],
-	":aruba:":                          &allEmoji[1086],
-	"🇦🇺":                               &allEmoji[1087],
-	":australia:":                      &allEmoji[1087],
-	"🇦🇹":                               &allEmoji[1088],
-	":austria:":                        &allEmoji[1088],
-	"🇦🇿":                               &allEmoji[1089],
-	":azerbaijan:":                     &allEmoji[1089],
-	"🇧🇸":                               &allEmoji[1090],
-	":bahamas:":                        &allEmoji[1090],
-	"🇧🇭":                               &allEmoji[1091],
-	":bahrain:":                        &allEmoji[1091],
-	"🇧🇩":                               &allEmoji[1092],
-	":bangladesh:":                     &allEmoji[1092],
-	"🇧🇧":                               &allEmoji[1093],
-	":barbados:":                       &allEmoji[1093],
-	"🇧🇾":                               &allEmoji[1094],
-	":belarus:":                        &allEmoji[1094],
-	"🇧🇪":                               &allEmoji[1095],
-	":belgium:":                        &allEmoji[1095],
-	"🇧🇿":                               &allEmoji[1096],
-	":belize:":                         &allEmoji[1096],
-	"🇧🇯":                               &allEmoji[1097],
-	":benin:":                          &allEmoji[1097],
-	"🇧🇲":                               &allEmoji[1098],
-	":bermuda:":                        &allEmoji[1098],
-	"🇧🇹":                               &allEmoji[1099],
-	":bhutan:":                         &allEmoji[1099],
-	"🇧🇴":                               &allEmoji[1100],
-	":bolivia:":                        &allEmoji[1100],
-	"🇧🇶":                               &allEmoji[1101],
-	":caribbean_netherlands:":          &allEmoji[1101],
-	"🇧🇦":                               &allEmoji[1102],
-	":bosnia_herzegovina:":             &allEmoji[1102],
-	"🇧🇼":                               &allEmoji[1103],
-	":botswana:":                       &allEmoji[1103],
-	"🇧🇷":                               &allEmoji[1104],
-	":brazil:":                         &allEmoji[1104],
-	"🇮🇴":                               &allEmoji[1105],
-	":british_indian_ocean_territory:": &allEmoji[1105],
-	"🇻🇬":                               &allEmoji[1106],
-	":british_virgin_islands:":         &allEmoji[1106],
-	"🇧🇳":                               &allEmoji[1107],
-	":brunei:":                         &allEmoji[1107],
-	"🇧🇬":                               &allEmoji[1108],
-	":bulgaria:":                       &allEmoji[1108],
-	"🇧🇫":                               &allEmoji[1109],
-	":burkina_faso:":                   &allEmoji[1109],
-	"🇧🇮":                               &allEmoji[1110],
-	":burundi:":                        &allEmoji[1110],
-	"🇨🇻":                               &allEmoji[1111],
-	":cape_verde:":                     &allEmoji[1111],
-	"🇰🇭":                               &allEmoji[1112],
-	":cambodia:":                       &allEmoji[1112],
-	"🇨🇲":                               &allEmoji[1113],
-	":cameroon:":                       &allEmoji[1113],
-	"🇨🇦":                               &allEmoji[1114],
-	":canada:":                         &allEmoji[1114],
-	"🇮🇨":                               &allEmoji[1115],
-	":canary_islands:":                 &allEmoji[1115],
-	"🇰🇾":                               &allEmoji[1116],
-	":cayman_islands:":                 &allEmoji[1116],
-	"🇨🇫":                               &allEmoji[1117],
-	":central_african_republic:":       &allEmoji[1117],
-	"🇹🇩":                               &allEmoji[1118],
-	":chad:":                           &allEmoji[1118],
-	"🇨🇱":                               &allEmoji[1119],
-	":chile:":                          &allEmoji[1119],
-	"🇨🇳":                               &allEmoji[1120],
-	":cn:":                             &allEmoji[1120],
-	"🇨🇽":                               &allEmoji[1121],
-	":christmas_island:":               &allEmoji[1121],
-	"🇨🇨":                               &allEmoji[1122],
-	":cocos_islands:":                  &allEmoji[1122],
-	"🇨🇴":                               &allEmoji[1123],
-	":colombia:":                       &allEmoji[1123],
-	"🇰🇲":                               &allEmoji[1124],
-	":comoros:":                        &allEmoji[1124],
-	"🇨🇬":                               &allEmoji[1125],
-	":congo_brazzaville:":              &allEmoji[1125],
-	"🇨🇩":                               &allEmoji[1126],
-	":congo_kinshasa:":                 &allEmoji[1126],
-	"🇨🇰":                               &allEmoji[1127],
-	":cook_islands:":                   &allEmoji[1127],
-	"🇨🇷":                               &allEmoji[1128],
-	":costa_rica:":                     &allEmoji[1128],
-	"🇭🇷":                               &allEmoji[1129],
-	":croatia:":                        &allEmoji[1129],
-	"🇨🇺":                               &allEmoji[1130],
-	":cuba:":                           &allEmoji[1130],
-	"🇨🇼":                               &allEmoji[1131],
-	":curacao:":                        &allEmoji[1131],
-	"🇨🇾":                               &allEmoji[1132],
-	":cyprus:":                         &allEmoji[1132],
-	"🇨🇿":                               &allEmoji[1133],
-	":czech_republic:":                 &allEmoji[1133],
-	"🇩🇰":                               &allEmoji[1134],
-	":denmark:":                        &allEmoji[1134],
-	"🇩🇯":                               &allEmoji[1135],
-	":djibouti:":                       &allEmoji[1135],
-	"🇩🇲":                               &allEmoji[1136],
-	":dominica:":                       &allEmoji[1136],
-	"🇩🇴":                               &allEmoji[1137],
-	":dominican_republic:":             &allEmoji[1137],
-	"🇪🇨":                               &allEmoji[1138],
-	":ecuador:":                        &allEmoji[1138],
-	"🇪🇬":                               &allEmoji[1139],
-	":egypt:":                          &allEmoji[1139],
-	"🇸🇻":                               &allEmoji[1140],
-	":el_salvador:":                    &allEmoji[1140],
-	"🇬🇶":                               &allEmoji[1141],
-	":equatorial_guinea:":              &allEmoji[1141],
-	"🇪🇷":                               &allEmoji[1142],
-	":eritrea:":                        &allEmoji[1142],
-	"🇪🇪":                               &allEmoji[1143],
-	":estonia:":                        &allEmoji[1143],
-	"🇪🇹":                               &allEmoji[1144],
-	":ethiopia:":                       &allEmoji[1144],
-	"🇪🇺":                               &allEmoji[1145],
-	":eu:":                             &allEmoji[1145],
-	":european_union:":                 &allEmoji[1145],
-	"🇫🇰":                               &allEmoji[1146],
-	":falkland_islands:":               &allEmoji[1146],
-	"🇫🇴":                               &allEmoji[1147],
-	":faroe_islands:":                  &allEmoji[1147],
-	"🇫🇯":                               &allEmoji[1148],
-	":fiji:":                           &allEmoji[1148],
-	"🇫🇮":                               &allEmoji[1149],
-	":finland:":                        &allEmoji[1149],
-	"🇫🇷":                               &allEmoji[1150],
-	":fr:":                             &allEmoji[1150],
-	"🇬🇫":                               &allEmoji[1151],
-	":french_guiana:":                  &allEmoji[1151],
-	"🇵🇫":                               &allEmoji[1152],
-	":french_polynesia:":               &allEmoji[1152],
-	"🇹🇫":                               &allEmoji[1153],
-	":french_southern_territories:":    &allEmoji[1153],
-	"🇬🇦":                               &allEmoji[1154],
-	":gabon:":                          &allEmoji[1154],
-	"🇬🇲":                               &allEmoji[1155],
-	":gambia:":                         &allEmoji[1155],
-	"🇬🇪":                               &allEmoji[1156],
-	":georgia:":                        &allEmoji[1156],
-	"🇩🇪":                               &allEmoji[1157],
-	":de:":                             &allEmoji[1157],
-	"🇬🇭":                               &allEmoji[1158],
-	":ghana:":                          &allEmoji[1158],
-	"🇬🇮":                               &allEmoji[1159],
-	":gibraltar:":                      &allEmoji[1159],
-	"🇬🇷":                               &allEmoji[1160],
-	":greece:":                         &allEmoji[1160],
-	"🇬🇱":                               &allEmoji[1161],
-	":greenland:":                      &allEmoji[1161],
-	"🇬🇩":                               &allEmoji[1162],
-	":grenada:":                        &allEmoji[1162],
-	"🇬🇵":                               &allEmoji[1163],
-	":guadeloupe:":                     &allEmoji[1163],
-	"🇬🇺":                               &allEmoji[1164],
-	":guam:":                           &allEmoji[1164],
-	"🇬🇹":                               &allEmoji[1165],
-	":guatemala:":                      &allEmoji[1165],
-	"🇬🇬":                               &allEmoji[1166],
-	":guernsey:":                       &allEmoji[1166],
-	"🇬🇳":                               &allEmoji[1167],
-	":guinea:":                         &allEmoji[1167],
-	"🇬🇼":                               &allEmoji[1168],
-	":guinea_bissau:":                  &allEmoji[1168],
-	"🇬🇾":                               &allEmoji[1169],
-	":guyana:":                         &allEmoji[1169],
-	"🇭🇹":                               &allEmoji[1170],
-	":haiti:":                          &allEmoji[1170],
-	"🇭🇳":                               &allEmoji[1171],
-	":honduras:":                       &allEmoji[1171],
-	"🇭🇰":                               &allEmoji[1172],
-	":hong_kong:":                      &allEmoji[1172],
-	"🇭🇺":                               &allEmoji[1173],
-	":hungary:":                        &allEmoji[1173],
-	"🇮🇸":                               &allEmoji[1174],
-	":iceland:":                        &allEmoji[1174],
-	"🇮🇳":                               &allEmoji[1175],
-	":india:":                          &allEmoji[1175],
-	"🇮🇩":                               &allEmoji[1176],
-	":indonesia:":                      &allEmoji[1176],
-	"🇮🇷":                               &allEmoji[1177],
-	":iran:":                           &allEmoji[1177],
-	"🇮🇶":                               &allEmoji[1178],
-	":iraq:":                           &allEmoji[1178],
-	"🇮🇪":                               &allEmoji[1179],
-	":ireland:":                        &allEmoji[1179],
-	"🇮🇲":                               &allEmoji[1180],
-	":isle_of_man:":                    &allEmoji[1180],
-	"🇮🇱":                               &allEmoji[1181],
-	":israel:":                         &allEmoji[1181],
-	"🇮🇹":                               &allEmoji[1182],
-	":it:":                             &allEmoji[1182],
-	"🇨🇮":                               &allEmoji[1183],
-	":cote_divoire:":                   &allEmoji[1183],
-	"🇯🇲":                               &allEmoji[1184],
-	":jamaica:":                        &allEmoji[1184],
-	"🇯🇵":                               &allEmoji[1185],
-	":jp:":                             &allEmoji[1185],
-	"🇯🇪":                               &allEmoji[1186],
-	":jersey:":                         &allEmoji[1186],
-	"🇯🇴":                               &allEmoji[1187],
-	":jordan:":                         &allEmoji[1187],
-	"🇰🇿":                               &allEmoji[1188],
-	":kazakhstan:":                     &allEmoji[1188],
-	"🇰🇪":                               &allEmoji[1189],
-	":kenya:":                          &allEmoji[1189],
-	"🇰🇮":                               &allEmoji[1190],
-	":kiribati:":                       &allEmoji[1190],
-	"🇽🇰":                               &allEmoji[1191],
-	":kosovo:":                         &allEmoji[1191],
-	"🇰🇼":                               &allEmoji[1192],
-	":kuwait:":                         &allEmoji[1192],
-	"🇰🇬":                               &allEmoji[1193],
-	":kyrgyzstan:":                     &allEmoji[1193],
-	"🇱🇦":                               &allEmoji[1194],
-	":laos:":                           &allEmoji[1194],
-	"🇱🇻":                               &allEmoji[1195],
-	":latvia:":                         &allEmoji[1195],
-	"🇱🇧":                               &allEmoji[1196],
-	":lebanon:":                        &allEmoji[1196],
-	"🇱🇸":                               &allEmoji[1197],
-	":lesotho:":                        &allEmoji[1197],
-	"🇱🇷":                               &allEmoji[1198],
-	":liberia:":                        &allEmoji[1198],
-	"🇱🇾":                               &allEmoji[1199],
-	":libya:":                          &allEmoji[1199],
-	"🇱🇮":                               &allEmoji[1200],
-	":liechtenstein:":                  &allEmoji[1200],
-	"🇱🇹":                               &allEmoji[1201],
-	":lithuania:":                      &allEmoji[1201],
-	"🇱🇺":                               &allEmoji[1202],
-	":luxembourg:":                     &allEmoji[1202],
-	"🇲🇴":                               &allEmoji[1203],
-	":macau:":                          &allEmoji[1203],
-	"🇲🇰":                               &allEmoji[1204],
-	":macedonia:":                      &allEmoji[1204],
-	"🇲🇬":                               &allEmoji[1205],
-	":madagascar:":                     &allEmoji[1205],
-	"🇲🇼":                               &allEmoji[1206],
-	":malawi:":                         &allEmoji[1206],
-	"🇲🇾":                               &allEmoji[1207],
-	":malaysia:":                       &allEmoji[1207],
-	"🇲🇻":                               &allEmoji[1208],
-	":maldives:":                       &allEmoji[1208],
-	"🇲🇱":                               &allEmoji[1209],
-	":mali:":                           &allEmoji[1209],
-	"🇲🇹":                               &allEmoji[1210],
-	":malta:":                          &allEmoji[1210],
-	"🇲🇭":                               &allEmoji[1211],
-	":marshall_islands:":               &allEmoji[1211],
-	"🇲🇶":                               &allEmoji[1212],
-	":martinique:":                     &allEmoji[1212],
-	"🇲🇷":                               &allEmoji[1213],
-	":mauritania:":                     &allEmoji[1213],
-	"🇲🇺":                               &allEmoji[1214],
-	":mauritius:":                      &allEmoji[1214],
-	"🇾🇹":                               &allEmoji[1215],
-	":mayotte:":                        &allEmoji[1215],
-	"🇲🇽":                               &allEmoji[1216],
-	":mexico:":                         &allEmoji[1216],
-	"🇫🇲":                               &allEmoji[1217],
-	":micronesia:":                     &allEmoji[1217],
-	"🇲🇩":                               &allEmoji[1218],
-	":moldova:":                        &allEmoji[1218],
-	"🇲🇨":                               &allEmoji[1219],
-	":monaco:":                         &allEmoji[1219],
-	"🇲🇳":                               &allEmoji[1220],
-	":mongolia:":                       &allEmoji[1220],
-	"🇲🇪":                               &allEmoji[1221],
-	":montenegro:":                     &allEmoji[1221],
-	"🇲🇸":                               &allEmoji[1222],
-	":montserrat:":                     &allEmoji[1222],
-	"🇲🇦":                               &allEmoji[1223],
-	":morocco:":                        &allEmoji[1223],
-	"🇲🇿":                               &allEmoji[1224],
-	":mozambique:":                     &allEmoji[1224],
-	"🇲🇲":                               &allEmoji[1225],
-	":myanmar:":                        &allEmoji[1225],
-	"🇳🇦":                               &allEmoji[1226],
-	":namibia:":                        &allEmoji[1226],
-	"🇳🇷":                               &allEmoji[1227],
-	":nauru:":                          &allEmoji[1227],
-	"🇳🇵":                               &allEmoji[1228],
-	":nepal:":                          &allEmoji[1228],
-	"🇳🇱":                               &allEmoji[1229],
-	":netherlands:":                    &allEmoji[1229],
-	"🇳🇨":                               &allEmoji[1230],
-	":new_caledonia:":                  &allEmoji[1230],
-	"🇳🇿":                               &allEmoji[1231],
-	":new_zealand:":                    &allEmoji[1231],
-	"🇳🇮":                               &allEmoji[1232],
-	":nicaragua:":                      &allEmoji[1232],
-	"🇳🇪":                               &allEmoji[1233],
-	":niger:":                          &allEmoji[1233],
-	"🇳🇬":                               &allEmoji[1234],
-	":nigeria:":                        &allEmoji[1234],
-	"🇳🇺":                               &allEmoji[1235],
-	":niue:":                           &allEmoji[1235],
-	"🇳🇫":                               &allEmoji[1236],
-	":norfolk_island:":                 &allEmoji[1236],
-	"🇲🇵":                               &allEmoji[1237],
-	":northern_mariana_islands:":       &allEmoji[1237],
-	"🇰🇵":                               &allEmoji[1238],
-	":north_korea:":                    &allEmoji[1238],
-	"🇳🇴":                               &allEmoji[1239],
-	":norway:":                         &allEmoji[1239],
-	"🇴🇲":                               &allEmoji[1240],
-	":oman:":                           &allEmoji[1240],
-	"🇵🇰":                               &allEmoji[1241],
-	":pakistan:":                       &allEmoji[1241],
-	"🇵🇼":                               &allEmoji[1242],
-	":palau:":                          &allEmoji[1242],
-	"🇵🇸":                               &allEmoji[1243],
-	":palestinian_territories:":        &allEmoji[1243],
-	"🇵🇦":                               &allEmoji[1244],
-	":panama:":                         &allEmoji[1244],
-	"🇵🇬":                               &allEmoji[1245],
-	":papua_new_guinea:":               &allEmoji[1245],
-	"🇵🇾":                               &allEmoji[1246],
-	":paraguay:":                       &allEmoji[1246],
-	"🇵🇪":                               &allEmoji[1247],
-	":peru:":                           &allEmoji[1247],
-	"🇵🇭":                               &allEmoji[1248],
-	":philippines:":                    &allEmoji[1248],
-	"🇵🇳":                               &allEmoji[1249],
-	":pitcairn_islands:":               &allEmoji[1249],
-	"🇵🇱":                               &allEmoji[1250],
-	":poland:":                         &allEmoji[1250],
-	"🇵🇹":                               &allEmoji[1251],
-	":portugal:":                       &allEmoji[1251],
-	"🇵🇷":                               &allEmoji[1252],
-	":puerto_rico:":                    &allEmoji[1252],
-	"🇶🇦":                               &allEmoji[1253],
-	":qatar:":                          &allEmoji[1253],
-	"🇷🇪":                               &allEmoji[1254],
-	":reunion:":                        &allEmoji[1254],
-	"🇷🇴":                               &allEmoji[1255],
-	":romania:":                        &allEmoji[1255],
-	"🇷🇺":                               &allEmoji[1256],
-	":ru:":                             &allEmoji[1256],
-	"🇷🇼":                               &allEmoji[1257],
-	":rwanda:":                         &allEmoji[1257],
-	"🇧🇱":                               &allEmoji[1258],
-	":st_barthelemy:":                  &allEmoji[1258],
-	"🇸🇭":                               &allEmoji[1259],
-	":st_helena:":                      &allEmoji[1259],
-	"🇰🇳":                               &allEmoji[1260],
-	":st_kitts_nevis:":                 &allEmoji[1260],
-	"🇱🇨":                               &allEmoji[1261],
-	":st_lucia:":                       &allEmoji[1261],
-	"🇵🇲":                               &allEmoji[1262],
-	":st_pierre_miquelon:":             &allEmoji[1262],
-	"🇻🇨":                               &allEmoji[1263],
-	":st_vincent_grenadines:":          &allEmoji[1263],
-	"🇼🇸":                               &allEmoji[1264],
-	":samoa:":                          &allEmoji[1264],
-	"🇸🇲":                               &allEmoji[1265],
-	":san_marino:":                     &allEmoji[1265],
-	"🇸🇹":                               &allEmoji[1266],
-	":sao_tome_principe:":              &allEmoji[1266],
-	"🇸🇦":                               &allEmoji[1267],
-	":saudi_arabia:":                   &allEmoji[1267],
-	"🇸🇳":                               &allEmoji[1268],
-	":senegal:":                        &allEmoji[1268],
-	"🇷🇸":                               &allEmoji[1269],
-	":serbia:":                         &allEmoji[1269],
-	"🇸🇨":                               &allEmoji[1270],
-	":seychelles:":                     &allEmoji[1270],
-	"🇸🇱":                               &allEmoji[1271],
-	":sierra_leone:":                   &allEmoji[1271],
-	"🇸🇬":                               &allEmoji[1272],
-	":singapore:":                      &allEmoji[1272],
-	"🇸🇽":                               &allEmoji[1273],
-	":sint_maarten:":                   &allEmoji[1273],
-	"🇸🇰":                               &allEmoji[1274],
-	":slovakia:":                       &allEmoji[1274],
-	"🇸🇮":                               &allEmoji[1275],
-	":slovenia:":                       &allEmoji[1275],
-	"🇸🇧":                               &allEmoji[1276],
-	":solomon_islands:":                &allEmoji[1276],
-	"🇸🇴":                               &allEmoji[1277],
-	":somalia:":                        &allEmoji[1277],
-	"🇿🇦":                               &allEmoji[1278],
-	":south_africa:":                   &allEmoji[1278],
-	"🇬🇸":                               &allEmoji[1279],
-	":south_georgia_south_sandwich_islands:": &allEmoji[1279],
-	"🇰🇷":                     &allEmoji[1280],
-	":kr:":                   &allEmoji[1280],
-	"🇸🇸":                     &allEmoji[1281],
-	":south_sudan:":          &allEmoji[1281],
-	"🇪🇸":                     &allEmoji[1282],
-	":es:":                   &allEmoji[1282],
-	"🇱🇰":                     &allEmoji[1283],
-	":sri_lanka:":            &allEmoji[1283],
-	"🇸🇩":                     &allEmoji[1284],
-	":sudan:":                &allEmoji[1284],
-	"🇸🇷":                     &allEmoji[1285],
-	":suriname:":             &allEmoji[1285],
-	"🇸🇿":                     &allEmoji[1286],
-	":swaziland:":            &allEmoji[1286],
-	"🇸🇪":                     &allEmoji[1287],
-	":sweden:":               &allEmoji[1287],
-	"🇨🇭":                     &allEmoji[1288],
-	":switzerland:":          &allEmoji[1288],
-	"🇸🇾":                     &allEmoji[1289],
-	":syria:":                &allEmoji[1289],
-	"🇹🇼":                     &allEmoji[1290],
-	":taiwan:":               &allEmoji[1290],
-	"🇹🇯":                     &allEmoji[1291],
-	":tajikistan:":           &allEmoji[1291],
-	"🇹🇿":                     &allEmoji[1292],
-	":tanzania:":             &allEmoji[1292],
-	"🇹🇭":                     &allEmoji[1293],
-	":thailand:":             &allEmoji[1293],
-	"🇹🇱":                     &allEmoji[1294],
-	":timor_leste:":          &allEmoji[1294],
-	"🇹🇬":                     &allEmoji[1295],
-	":togo:":                 &allEmoji[1295],
-	"🇹🇰":                     &allEmoji[1296],
-	":tokelau:":              &allEmoji[1296],
-	"🇹🇴":                     &allEmoji[1297],
-	":tonga:":                &allEmoji[1297],
-	"🇹🇹":                     &allEmoji[1298],
-	":trinidad_tobago:":      &allEmoji[1298],
-	"🇹🇳":                     &allEmoji[1299],
-	":tunisia:":              &allEmoji[1299],
-	"🇹🇷":                     &allEmoji[1300],
-	":tr:":                   &allEmoji[1300],
-	"🇹🇲":                     &allEmoji[1301],
-	":turkmenistan:":         &allEmoji[1301],
-	"🇹🇨":                     &allEmoji[1302],
-	":turks_caicos_islands:": &allEmoji[1302],
-	"🇹🇻":                     &allEmoji[1303],
-	":tuvalu:":               &allEmoji[1303],
-	"🇺🇬":                     &allEmoji[1304],
-	":uganda:":               &allEmoji[1304],
-	"🇺🇦":                     &allEmoji[1305],
-	":ukraine:":              &allEmoji[1305],
-	"🇦🇪":                     &allEmoji[1306],
-	":united_arab_emirates:": &allEmoji[1306],
-	"🇬🇧":                     &allEmoji[1307],
-	":gb:":                   &allEmoji[1307],
-	":uk:":                   &allEmoji[1307],
-	"🇺🇸":                     &allEmoji[1308],
-	":us:":                   &allEmoji[1308],
-	"🇻🇮":                     &allEmoji[1309],
-	":us_virgin_islands:":    &allEmoji[1309],
-	"🇺🇾":                     &allEmoji[1310],
-	":uruguay:":              &allEmoji[1310],
-	"🇺🇿":                     &allEmoji[1311],
-	":uzbekistan:":           &allEmoji[1311],
-	"🇻🇺":                     &allEmoji[1312],
-	":vanuatu:":              &allEmoji[1312],
-	"🇻🇦":                     &allEmoji[1313],
-	":vatican_city:":         &allEmoji[1313],
-	"🇻🇪":                     &allEmoji[1314],
-	":venezuela:":            &allEmoji[1314],
-	"🇻🇳":                     &allEmoji[1315],
-	":vietnam:":              &allEmoji[1315],
-	"🇼🇫":                     &allEmoji[1316],
-	":wallis_futuna:":        &allEmoji[1316],
-	"🇪🇭":                     &allEmoji[1317],
-	":western_sahara:":       &allEmoji[1317],
-	"🇾🇪":                     &allEmoji[1318],
-	":yemen:":                &allEmoji[1318],
-	"🇿🇲":                     &allEmoji[1319],
-	":zambia:":               &allEmoji[1319],
-	"🇿🇼":                     &allEmoji[1320],
-	":zimbabwe:":             &allEmoji[1320],
-}
+// emojiData is the default emoji set, in the format read by decodeData.
+const emojiData = "" +
+	"\xa9\n" +
+	"\x04😀\rgrinning face\x01\bgrinning\x00" +
+	"\x04😬\x0egrimacing face\x01\tgrimacing\x00" +
+	"\x04😁\x1fgrinning face with smiling eyes\x01\x04grin\x00" +
+	"\x04😂\x16face with tears of joy\x01\x03joy\x00" +
+	"\x04😃\x1csmiling face with open mouth\x01\x06smiley\x00" +
+	"\x04😄+smiling face with open mouth & smiling eyes\x01\x05smile\x00" +
+	"\x04😅)smiling face with open mouth & cold sweat\x01\vsweat_smile\x00" +
+	"\x04😆*smiling face with open mouth & closed eyes\x02\blaughing\tsatisfied\x00" +
+	"\x04😇\x16smiling face with halo\x01\binnocent\x00" +
+	"\x04😉\fwinking face\x01\x04wink\x00" +
+	"\x04😊\x1esmiling face with smiling eyes\x01\x05blush\x00" +
+	"\x04🙂\x15slightly smiling face\x01\x15slightly_smiling_face\x00" +
+	"\x04🙃\x10upside-down face\x01\x10upside_down_face\x00" +
+	"\x06☺️\fsmiling face\x01\arelaxed\x00" +
+	"\x04😋\x1dface savouring delicious food\x01\x03yum\x00" +
+	"\x04😌\rrelieved face\x01\brelieved\x00" +
+	"\x04😍\x1csmiling face with heart-eyes\x01\nheart_eyes\x00" +
+	"\x04😘\x13face blowing a kiss\x01\rkissing_heart\x00" +
+	"\x04😗\fkissing face\x01\akissing\x00" +
+	"\x04😙\x1ekissing face with smiling eyes\x01\x14kissing_smiling_eyes\x00" +
+	"\x04😚\x1dkissing face with closed eyes\x01\x13kissing_closed_eyes\x00" +
+	"\x04😜(face with stuck-out tongue & winking eye\x01\x1cstuck_out_tongue_winking_eye\x00" +
+	"\x04😝(face with stuck-out tongue & closed eyes\x01\x1cstuck_out_tongue_closed_eyes\x00" +
+	"\x04😛\x1aface with stuck-out tongue\x01\x10stuck_out_tongue\x00" +
+	"\x04🤑\x10money-mouth face\x01\x10money_mouth_face\x00" +
+	"\x04🤓\tnerd face\x01\tnerd_face\x00" +
+	"\x04😎\x1csmiling face with sunglasses\x01\nsunglasses\x00" +
+	"\x04🤗\fhugging face\x01\x04hugs\x00" +
+	"\x04😏\rsmirking face\x01\x05smirk\x00" +
+	"\x04😶\x12face without mouth\x01\bno_mouth\x00" +
+	"\x04😐\fneutral face\x01\fneutral_face\x00" +
+	"\x04😑\x13expressionless face\x01\x0eexpressionless\x00" +
+	"\x04😒\runamused face\x01\bunamused\x00" +
+	"\x04🙄\x16face with rolling eyes\x01\troll_eyes\x00" +
+	"\x04🤔\rthinking face\x01\bthinking\x00" +
+	"\x04😳\fflushed face\x01\aflushed\x00" +
+	"\x04😞\x11disappointed face\x01\fdisappointed\x00" +
+	"\x04😟\fworried face\x01\aworried\x00" +
+	"\x04😠\nangry face\x01\x05angry\x00" +
+	"\x04😡\fpouting face\x02\x04rage\x04pout\x00" +
+	"\x04😔\fpensive face\x01\apensive\x00" +
+	"\x04😕\rconfused face\x01\bconfused\x00" +
+	"\x04🙁\x16slightly frowning face\x01\x16slightly_frowning_face\x00" +
+	"\x06☹️\rfrowning face\x01\rfrowning_face\x00" +
+	"\x04😣\x10persevering face\x01\tpersevere\x00" +
+	"\x04😖\x0fconfounded face\x01\nconfounded\x00" +
+	"\x04😫\ntired face\x01\ntired_face\x00" +
+	"\x04😩\nweary face\x01\x05weary\x00" +
+	"\x04😤\x19face with steam from nose\x01\atriumph\x00" +
+	"\x04😮\x14face with open mouth\x01\nopen_mouth\x00" +
+	"\x04😱\x16face screaming in fear\x01\x06scream\x00" +
+	"\x04😨\ffearful face\x01\afearful\x00" +
+	"\x04😰!face with open mouth & cold sweat\x01\ncold_sweat\x00" +
+	"\x04😯\vhushed face\x01\x06hushed\x00" +
+	"\x04😦\x1dfrowning face with open mouth\x01\bfrowning\x00" +
+	"\x04😧\x0eanguished face\x01\tanguished\x00" +
+	"\x04😢\vcrying face\x01\x03cry\x00" +
+	"\x04😥\x1edisappointed but relieved face\x01\x15disappointed_relieved\x00" +
+	"\x04😪\vsleepy face\x01\x06sleepy\x00" +
+	"\x04😓\x14face with cold sweat\x01\x05sweat\x00" +
+	"\x04😭\x12loudly crying face\x01\x03sob\x00" +
+	"\x04😵\ndizzy face\x01\ndizzy_face\x00" +
+	"\x04😲\x0fastonished face\x01\nastonished\x00" +
+	"\x04🤐\x11zipper-mouth face\x01\x11zipper_mouth_face\x00" +
+	"\x04😷\x16face with medical mask\x01\x04mask\x00" +
+	"\x04🤒\x15face with thermometer\x01\x15face_with_thermometer\x00" +
+	"\x04🤕\x16face with head-bandage\x01\x16face_with_head_bandage\x00" +
+	"\x04😴\rsleeping face\x01\bsleeping\x00" +
+	"\x04💤\x03zzz\x01\x03zzz\x00" +
+	"\x04💩\vpile of poo\x03\x06hankey\x04poop\x04shit\x00" +
+	"\x04😈\x17smiling face with horns\x01\vsmiling_imp\x00" +
+	"\x04👿\x15angry face with horns\x01\x03imp\x00" +
+	"\x04👹\x04ogre\x01\rjapanese_ogre\x00" +
+	"\x04👺\x06goblin\x01\x0fjapanese_goblin\x00" +
+	"\x04👻\x05ghost\x01\x05ghost\x00" +
+	"\x04💀\x05skull\x01\x05skull\x00" +
+	"\x06☠️\x14skull and crossbones\x01\x14skull_and_crossbones\x00" +
+	"\x04👽\x05alien\x01\x05alien\x00" +
+	"\x04👾\ralien monster\x01\rspace_invader\x00" +
+	"\x04🤖\nrobot face\x01\x05robot\x00" +
+	"\x04😺 smiling cat face with open mouth\x01\nsmiley_cat\x00" +
+	"\x04😸#grinning cat face with smiling eyes\x01\tsmile_cat\x00" +
+	"\x04😹\x1acat face with tears of joy\x01\ajoy_cat\x00" +
+	"\x04😻 smiling cat face with heart-eyes\x01\x0eheart_eyes_cat\x00" +
+	"\x04😼\x17cat face with wry smile\x01\tsmirk_cat\x00" +
+	"\x04😽!kissing cat face with closed eyes\x01\vkissing_cat\x00" +
+	"\x04🙀\x0eweary cat face\x01\nscream_cat\x00" +
+	"\x04😿\x0fcrying cat face\x01\x0fcrying_cat_face\x00" +
+	"\x04😾\x10pouting cat face\x01\vpouting_cat\x00" +
+	"\x04🙌\rraising hands\x01\fraised_hands\x00" +
+	"\x04👏\x0eclapping hands\x01\x04clap\x00" +
+	"\x04👍\tthumbs up\x02\x02+1\bthumbsup\x00" +
+	"\x04👎\vthumbs down\x02\x02-1\nthumbsdown\x00" +
+	"\x04👊\roncoming fist\x02\tfacepunch\x05punch\x00" +
+	"\x03✊\vraised fist\x01\x04fist\x00" +
+	"\x04👋\vwaving hand\x01\x04wave\x00" +
+	"\x04👈\x1cbackhand index pointing left\x01\npoint_left\x00" +
+	"\x04👉\x1dbackhand index pointing right\x01\vpoint_right\x00" +
+	"\x04👆\x1abackhand index pointing up\x01\npoint_up_2\x00" +
+	"\x04👇\x1cbackhand index pointing down\x01\npoint_down\x00" +
+	"\x04👌\aOK hand\x01\aok_hand\x00" +
+	"\x06☝️\x11index pointing up\x01\bpoint_up\x00" +
+	"\x06✌️\fvictory hand\x01\x01v\x00" +
+	"\x03✋\vraised hand\x02\x04hand\vraised_hand\x00" +
+	"\x04🖐 raised hand with fingers splayed\x01 raised_hand_with_fingers_splayed\x00" +
+	"\x04👐\nopen hands\x01\nopen_hands\x00" +
+	"\x04💪\rflexed biceps\x01\x06muscle\x00" +
+	"\x04🙏\ffolded hands\x01\x04pray\x00" +
+	"\x04🖖\rvulcan salute\x01\rvulcan_salute\x00" +
+	"\x04🤘\x11sign of the horns\x01\x05metal\x00" +
+	"\x04🖕\rmiddle finger\x02\rmiddle_finger\x02fu\x00" +
+	"\x06✍️\fwriting hand\x01\fwriting_hand\x00" +
+	"\x04💅\vnail polish\x01\tnail_care\x00" +
+	"\x04👄\x05mouth\x01\x04lips\x00" +
+	"\x04👅\x06tongue\x01\x06tongue\x00" +
+	"\x04👂\x03ear\x01\x03ear\x00" +
+	"\x04👃\x04nose\x01\x04nose\x00" +
+	"\x04👁\x03eye\x01\x03eye\x00" +
+	"\x04👀\x04eyes\x01\x04eyes\x00" +
+	"\x04🗣\rspeaking head\x01\rspeaking_head\x00" +
+	"\x04👤\x12bust in silhouette\x01\x12bust_in_silhouette\x00" +
+	"\x04👥\x13busts in silhouette\x01\x13busts_in_silhouette\x00" +
+	"\x04👶\x04baby\x01\x04baby\x00" +
+	"\x04👦\x03boy\x01\x03boy\x00" +
+	"\x04👧\x04girl\x01\x04girl\x00" +
+	"\x04👨\x03man\x01\x03man\x00" +
+	"\x04👩\x05woman\x01\x05woman\x00" +
+	"\r👱\u200d♀️\x12blond-haired woman\x01\fblonde_woman\x00" +
+	"\x04👱\x13blond-haired person\x02\nblonde_man\x16person_with_blond_hair\x00" +
+	"\x04👴\aold man\x01\tolder_man\x00" +
+	"\x04👵\told woman\x01\volder_woman\x00" +
+	"\x04👲\x14man with Chinese cap\x01\x13man_with_gua_pi_mao\x00" +
+	"\r👳\u200d♀️\x14woman wearing turban\x01\x11woman_with_turban\x00" +
+	"\x04👳\x15person wearing turban\x01\x0fman_with_turban\x00" +
+	"\r👮\u200d♀️\x14woman police officer\x01\vpolicewoman\x00" +
+	"\x04👮\x0epolice officer\x02\tpoliceman\x03cop\x00" +
+	"\r👷\u200d♀️\x19woman construction worker\x01\x19construction_worker_woman\x00" +
+	"\x04👷\x13construction worker\x02\x17construction_worker_man\x13construction_worker\x00" +
+	"\r💂\u200d♀️\vwoman guard\x01\vguardswoman\x00" +
+	"\x04💂\x05guard\x01\tguardsman\x00" +
+	"\x10🕵️\u200d♀️\x0fwoman detective\x01\x10female_detective\x00" +
+	"\a🕵️\tdetective\x02\x0emale_detective\tdetective\x00" +
+	"\x04🎅\vSanta Claus\x01\x05santa\x00" +
+	"\x04👸\bprincess\x01\bprincess\x00" +
+	"\x04👰\x0fbride with veil\x01\x0fbride_with_veil\x00" +
+	"\x04👼\nbaby angel\x01\x05angel\x00" +
+	"\r🙇\u200d♀️\fwoman bowing\x01\fbowing_woman\x00" +
+	"\x04🙇\rperson bowing\x02\nbowing_man\x03bow\x00" +
+	"\x04💁\x13person tipping hand\x02\x12tipping_hand_woman\x17information_desk_person\x00" +
+	"\r💁\u200d♂️\x10man tipping hand\x01\x10tipping_hand_man\x00" +
+	"\x04🙅\x13person gesturing NO\x03\rno_good_woman\ano_good\bng_woman\x00" +
+	"\r🙅\u200d♂️\x10man gesturing NO\x02\vno_good_man\x06ng_man\x00" +
+	"\x04🙆\x13person gesturing OK\x01\bok_woman\x00" +
+	"\r🙆\u200d♂️\x10man gesturing OK\x01\x06ok_man\x00" +
+	"\x04🙋\x13person raising hand\x02\x12raising_hand_woman\fraising_hand\x00" +
+	"\r🙋\u200d♂️\x10man raising hand\x01\x10raising_hand_man\x00" +
+	"\x04🙎\x0eperson pouting\x02\rpouting_woman\x18person_with_pouting_face\x00" +
+	"\r🙎\u200d♂️\vman pouting\x01\vpouting_man\x00" +
+	"\x04🙍\x0fperson frowning\x02\x0efrowning_woman\x0fperson_frowning\x00" +
+	"\r🙍\u200d♂️\fman frowning\x01\ffrowning_man\x00" +
+	"\x04💇\x16person getting haircut\x02\rhaircut_woman\ahaircut\x00" +
+	"\r💇\u200d♂️\x13man getting haircut\x01\vhaircut_man\x00" +
+	"\x04💆\x16person getting massage\x02\rmassage_woman\amassage\x00" +
+	"\r💆\u200d♂️\x13man getting massage\x01\vmassage_man\x00" +
+	"\x04💃\rwoman dancing\x01\x06dancer\x00" +
+	"\x04👯\x1fpeople with bunny ears partying\x02\rdancing_women\adancers\x00" +
+	"\r👯\u200d♂️\x1cmen with bunny ears partying\x01\vdancing_men\x00" +
+	"\r🚶\u200d♀️\rwoman walking\x01\rwalking_woman\x00" +
+	"\x04🚶\x0eperson walking\x02\vwalking_man\awalking\x00" +
+	"\r🏃\u200d♀️\rwoman running\x01\rrunning_woman\x00" +
+	"\x04🏃\x0eperson running\x03\vrunning_man\x06runner\arunning\x00" +
+	"\x04👫\x1bman and woman holding hands\x01\x06couple\x00" +
+	"\x04👭\x17two women holding hands\x01\x17two_women_holding_hands\x00" +
+	"\x04👬\x15two men holding hands\x01\x15two_men_holding_hands\x00" +
+	"\x04💑\x11couple with heart\x02\x1bcouple_with_heart_woman_man\x11couple_with_heart\x00" +
+	"\x14👩\u200d❤️\u200d👩\x1fcouple with heart: woman, woman\x01\x1dcouple_with_heart_woman_woman\x00" +
+	"\x14👨\u200d❤️\u200d👨\x1bcouple with heart: man, man\x01\x19couple_with_heart_man_man\x00" +
+	"\x04💏\x04kiss\x01\x14couplekiss_man_woman\x00" +
+	"\x1b👩\u200d❤️\u200d💋\u200d👩\x12kiss: woman, woman\x01\x16couplekiss_woman_woman\x00" +
+	"\x1b👨\u200d❤️\u200d💋\u200d👨\x0ekiss: man, man\x01\x12couplekiss_man_man\x00" +
+	"\x04👪\x06family\x02\x14family_man_woman_boy\x06family\x00" +
+	"\x12👨\u200d👩\u200d👧\x18family: man, woman, girl\x01\x15family_man_woman_girl\x00" +
+	"\x19👨\u200d👩\u200d👧\u200d👦\x1dfamily: man, woman, girl, boy\x01\x19family_man_woman_girl_boy\x00" +
+	"\x19👨\u200d👩\u200d👦\u200d👦\x1cfamily: man, woman, boy, boy\x01\x18family_man_woman_boy_boy\x00" +
+	"\x19👨\u200d👩\u200d👧\u200d👧\x1efamily: man, woman, girl, girl\x01\x1afamily_man_woman_girl_girl\x00" +
+	"\x12👩\u200d👩\u200d👦\x19family: woman, woman, boy\x01\x16family_woman_woman_boy\x00" +
+	"\x12👩\u200d👩\u200d👧\x1afamily: woman, woman, girl\x01\x17family_woman_woman_girl\x00" +
+	"\x19👩\u200d👩\u200d👧\u200d👦\x1ffamily: woman, woman, girl, boy\x01\x1bfamily_woman_woman_girl_boy\x00" +
+	"\x19👩\u200d👩\u200d👦\u200d👦\x1efamily: woman, woman, boy, boy\x01\x1afamily_woman_woman_boy_boy\x00" +
+	"\x19👩\u200d👩\u200d👧\u200d👧 family: woman, woman, girl, girl\x01\x1cfamily_woman_woman_girl_girl\x00" +
+	"\x12👨\u200d👨\u200d👦\x15family: man, man, boy\x01\x12family_man_man_boy\x00" +
+	"\x12👨\u200d👨\u200d👧\x16family: man, man, girl\x01\x13family_man_man_girl\x00" +
+	"\x19👨\u200d👨\u200d👧\u200d👦\x1bfamily: man, man, girl, boy\x01\x17family_man_man_girl_boy\x00" +
+	"\x19👨\u200d👨\u200d👦\u200d👦\x1afamily: man, man, boy, boy\x01\x16family_man_man_boy_boy\x00" +
+	"\x19👨\u200d👨\u200d👧\u200d👧\x1cfamily: man, man, girl, girl\x01\x18family_man_man_girl_girl\x00" +
+	"\v👩\u200d👦\x12family: woman, boy\x01\x10family_woman_boy\x00" +
+	"\v👩\u200d👧\x13family: woman, girl\x01\x11family_woman_girl\x00" +
+	"\x12👩\u200d👧\u200d👦\x18family: woman, girl, boy\x01\x15family_woman_girl_boy\x00" +
+	"\x12👩\u200d👦\u200d👦\x17family: woman, boy, boy\x01\x14family_woman_boy_boy\x00" +
+	"\x12👩\u200d👧\u200d👧\x19family: woman, girl, girl\x01\x16family_woman_girl_girl\x00" +
+	"\v👨\u200d👦\x10family: man, boy\x01\x0efamily_man_boy\x00" +
+	"\v👨\u200d👧\x11family: man, girl\x01\x0ffamily_man_girl\x00" +
+	"\x12👨\u200d👧\u200d👦\x16family: man, girl, boy\x01\x13family_man_girl_boy\x00" +
+	"\x12👨\u200d👦\u200d👦\x15family: man, boy, boy\x01\x12family_man_boy_boy\x00" +
+	"\x12👨\u200d👧\u200d👧\x17family: man, girl, girl\x01\x14family_man_girl_girl\x00" +
+	"\x04👚\x11woman’s clothes\x01\x0ewomans_clothes\x00" +
+	"\x04👕\at-shirt\x02\x05shirt\x06tshirt\x00" +
+	"\x04👖\x05jeans\x01\x05jeans\x00" +
+	"\x04👔\anecktie\x01\anecktie\x00" +
+	"\x04👗\x05dress\x01\x05dress\x00" +
+	"\x04👙\x06bikini\x01\x06bikini\x00" +
+	"\x04👘\x06kimono\x01\x06kimono\x00" +
+	"\x04💄\blipstick\x01\blipstick\x00" +
+	"\x04💋\tkiss mark\x01\x04kiss\x00" +
+	"\x04👣\nfootprints\x01\nfootprints\x00" +
+	"\x04👠\x10high-heeled shoe\x01\thigh_heel\x00" +
+	"\x04👡\x10woman’s sandal\x01\x06sandal\x00" +
+	"\x04👢\x0ewoman’s boot\x01\x04boot\x00" +
+	"\x04👞\fman’s shoe\x02\tmans_shoe\x04shoe\x00" +
+	"\x04👟\frunning shoe\x01\rathletic_shoe\x00" +
+	"\x04👒\rwoman’s hat\x01\nwomans_hat\x00" +
+	"\x04🎩\atop hat\x01\x06tophat\x00" +
+	"\x04🎓\x0egraduation cap\x01\fmortar_board\x00" +
+	"\x04👑\x05crown\x01\x05crown\x00" +
+	"\x03⛑\x18rescue worker’s helmet\x01\x14rescue_worker_helmet\x00" +
+	"\x04🎒\x0fschool backpack\x01\x0eschool_satchel\x00" +
+	"\x04👝\nclutch bag\x01\x05pouch\x00" +
+	"\x04👛\x05purse\x01\x05purse\x00" +
+	"\x04👜\ahandbag\x01\ahandbag\x00" +
+	"\x04💼\tbriefcase\x01\tbriefcase\x00" +
+	"\x04👓\aglasses\x01\neyeglasses\x00" +
+	"\x04🕶\nsunglasses\x01\x0fdark_sunglasses\x00" +
+	"\x04💍\x04ring\x01\x04ring\x00" +
+	"\x04🌂\x0fclosed umbrella\x01\x0fclosed_umbrella\x00" +
+	"\x04🐶\bdog face\x01\x03dog\x00" +
+	"\x04🐱\bcat face\x01\x03cat\x00" +
+	"\x04🐭\nmouse face\x01\x05mouse\x00" +
+	"\x04🐹\fhamster face\x01\ahamster\x00" +
+	"\x04🐰\vrabbit face\x01\x06rabbit\x00" +
+	"\x04🐻\tbear face\x01\x04bear\x00" +
+	"\x04🐼\npanda face\x01\npanda_face\x00" +
+	"\x04🐨\x05koala\x01\x05koala\x00" +
+	"\x04🐯\ntiger face\x01\x05tiger\x00" +
+	"\x04🦁\tlion face\x01\x04lion\x00" +
+	"\x04🐮\bcow face\x01\x03cow\x00" +
+	"\x04🐷\bpig face\x01\x03pig\x00" +
+	"\x04🐽\bpig nose\x01\bpig_nose\x00" +
+	"\x04🐸\tfrog face\x01\x04frog\x00" +
+	"\x04🐙\aoctopus\x01\aoctopus\x00" +
+	"\x04🐵\vmonkey face\x01\vmonkey_face\x00" +
+	"\x04🙈\x12see-no-evil monkey\x01\vsee_no_evil\x00" +
+	"\x04🙉\x13hear-no-evil monkey\x01\fhear_no_evil\x00" +
+	"\x04🙊\x14speak-no-evil monkey\x01\rspeak_no_evil\x00" +
+	"\x04🐒\x06monkey\x01\x06monkey\x00" +
+	"\x04🐔\achicken\x01\achicken\x00" +
+	"\x04🐧\apenguin\x01\apenguin\x00" +
+	"\x04🐦\x04bird\x01\x04bird\x00" +
+	"\x04🐤\nbaby chick\x01\nbaby_chick\x00" +
+	"\x04🐣\x0ehatching chick\x01\x0ehatching_chick\x00" +
+	"\x04🐥\x17front-facing baby chick\x01\rhatched_chick\x00" +
+	"\x04🐺\twolf face\x01\x04wolf\x00" +
+	"\x04🐗\x04boar\x01\x04boar\x00" +
+	"\x04🐴\nhorse face\x01\x05horse\x00" +
+	"\x04🦄\funicorn face\x01\aunicorn\x00" +
+	"\x04🐝\bhoneybee\x02\x03bee\bhoneybee\x00" +
+	"\x04🐛\x03bug\x01\x03bug\x00" +
+	"\x04🐌\x05snail\x01\x05snail\x00" +
+	"\x04🐞\vlady beetle\x01\x06beetle\x00" +
+	"\x04🐜\x03ant\x01\x03ant\x00" +
+	"\x04🕷\x06spider\x01\x06spider\x00" +
+	"\x04🦂\bscorpion\x01\bscorpion\x00" +
+	"\x04🦀\x04crab\x01\x04crab\x00" +
+	"\x04🐍\x05snake\x01\x05snake\x00" +
+	"\x04🐢\x06turtle\x01\x06turtle\x00" +
+	"\x04🐠\rtropical fish\x01\rtropical_fish\x00" +
+	"\x04🐟\x04fish\x01\x04fish\x00" +
+	"\x04🐡\bblowfish\x01\bblowfish\x00" +
+	"\x04🐬\adolphin\x02\adolphin\aflipper\x00" +
+	"\x04🐳\x0espouting whale\x01\x05whale\x00" +
+	"\x04🐋\x05whale\x01\x06whale2\x00" +
+	"\x04🐊\tcrocodile\x01\tcrocodile\x00" +
+	"\x04🐆\aleopard\x01\aleopard\x00" +
+	"\x04🐅\x05tiger\x01\x06tiger2\x00" +
+	"\x04🐃\rwater buffalo\x01\rwater_buffalo\x00" +
+	"\x04🐂\x02ox\x01\x02ox\x00" +
+	"\x04🐄\x03cow\x01\x04cow2\x00" +
+	"\x04🐪\x05camel\x01\x0fdromedary_camel\x00" +
+	"\x04🐫\x0etwo-hump camel\x01\x05camel\x00" +
+	"\x04🐘\belephant\x01\belephant\x00" +
+	"\x04🐐\x04goat\x01\x04goat\x00" +
+	"\x04🐏\x03ram\x01\x03ram\x00" +
+	"\x04🐑\x05sheep\x01\x05sheep\x00" +
+	"\x04🐎\x05horse\x01\tracehorse\x00" +
+	"\x04🐖\x03pig\x01\x04pig2\x00" +
+	"\x04🐀\x03rat\x01\x03rat\x00" +
+	"\x04🐁\x05mouse\x01\x06mouse2\x00" +
+	"\x04🐓\arooster\x01\arooster\x00" +
+	"\x04🦃\x06turkey\x01\x06turkey\x00" +
+	"\x04🕊\x04dove\x01\x04dove\x00" +
+	"\x04🐕\x03dog\x01\x04dog2\x00" +
+	"\x04🐩\x06poodle\x01\x06poodle\x00" +
+	"\x04🐈\x03cat\x01\x04cat2\x00" +
+	"\x04🐇\x06rabbit\x01\arabbit2\x00" +
+	"\x04🐿\bchipmunk\x01\bchipmunk\x00" +
+	"\x04🐾\npaw prints\x02\x04feet\npaw_prints\x00" +
+	"\x04🐉\x06dragon\x01\x06dragon\x00" +
+	"\x04🐲\vdragon face\x01\vdragon_face\x00" +
+	"\x04🌵\x06cactus\x01\x06cactus\x00" +
+	"\x04🎄\x0eChristmas tree\x01\x0echristmas_tree\x00" +
+	"\x04🌲\x0eevergreen tree\x01\x0eevergreen_tree\x00" +
+	"\x04🌳\x0edeciduous tree\x01\x0edeciduous_tree\x00" +
+	"\x04🌴\tpalm tree\x01\tpalm_tree\x00" +
+	"\x04🌱\bseedling\x01\bseedling\x00" +
+	"\x04🌿\x04herb\x01\x04herb\x00" +
+	"\x03☘\bshamrock\x01\bshamrock\x00" +
+	"\x04🍀\x10four leaf clover\x01\x10four_leaf_clover\x00" +
+	"\x04🎍\x0fpine decoration\x01\x06bamboo\x00" +
+	"\x04🎋\rtanabata tree\x01\rtanabata_tree\x00" +
+	"\x04🍃\x17leaf fluttering in wind\x01\x06leaves\x00" +
+	"\x04🍂\vfallen leaf\x01\vfallen_leaf\x00" +
+	"\x04🍁\nmaple leaf\x01\nmaple_leaf\x00" +
+	"\x04🌾\rsheaf of rice\x01\vear_of_rice\x00" +
+	"\x04🌺\bhibiscus\x01\bhibiscus\x00" +
+	"\x04🌻\tsunflower\x01\tsunflower\x00" +
+	"\x04🌹\x04rose\x01\x04rose\x00" +
+	"\x04🌷\x05tulip\x01\x05tulip\x00" +
+	"\x04🌼\ablossom\x01\ablossom\x00" +
+	"\x04🌸\x0echerry blossom\x01\x0echerry_blossom\x00" +
+	"\x04💐\abouquet\x01\abouquet\x00" +
+	"\x04🍄\bmushroom\x01\bmushroom\x00" +
+	"\x04🌰\bchestnut\x01\bchestnut\x00" +
+	"\x04🎃\x0ejack-o-lantern\x01\x0ejack_o_lantern\x00" +
+	"\x04🐚\fspiral shell\x01\x05shell\x00" +
+	"\x04🕸\nspider web\x01\nspider_web\x00" +
+	"\x04🌎\x16globe showing Americas\x01\x0eearth_americas\x00" +
+	"\x04🌍\x1bglobe showing Europe-Africa\x01\fearth_africa\x00" +
+	"\x04🌏\x1cglobe showing Asia-Australia\x01\nearth_asia\x00" +
+	"\x04🌕\tfull moon\x01\tfull_moon\x00" +
+	"\x04🌖\x13waning gibbous moon\x01\x13waning_gibbous_moon\x00" +
+	"\x04🌗\x11last quarter moon\x01\x11last_quarter_moon\x00" +
+	"\x04🌘\x14waning crescent moon\x01\x14waning_crescent_moon\x00" +
+	"\x04🌑\bnew moon\x01\bnew_moon\x00" +
+	"\x04🌒\x14waxing crescent moon\x01\x14waxing_crescent_moon\x00" +
+	"\x04🌓\x12first quarter moon\x01\x12first_quarter_moon\x00" +
+	"\x04🌔\x13waxing gibbous moon\x02\x04moon\x13waxing_gibbous_moon\x00" +
+	"\x04🌚\rnew moon face\x01\x12new_moon_with_face\x00" +
+	"\x04🌝\x13full moon with face\x01\x13full_moon_with_face\x00" +
+	"\x04🌛\x1cfirst quarter moon with face\x01\x1cfirst_quarter_moon_with_face\x00" +
+	"\x04🌜\x1blast quarter moon with face\x01\x1blast_quarter_moon_with_face\x00" +
+	"\x04🌞\rsun with face\x01\rsun_with_face\x00" +
+	"\x04🌙\rcrescent moon\x01\rcrescent_moon\x00" +
+	"\x06⭐️\x11white medium star\x01\x04star\x00" +
+	"\x04🌟\fglowing star\x01\x05star2\x00" +
+	"\x04💫\x05dizzy\x01\x05dizzy\x00" +
+	"\x03✨\bsparkles\x01\bsparkles\x00" +
+	"\x06☄️\x05comet\x01\x05comet\x00" +
+	"\x06☀️\x03sun\x01\x05sunny\x00" +
+	"\x04🌤\x16sun behind small cloud\x01\x16sun_behind_small_cloud\x00" +
+	"\x06⛅️\x10sun behind cloud\x01\fpartly_sunny\x00" +
+	"\x04🌥\x16sun behind large cloud\x01\x16sun_behind_large_cloud\x00" +
+	"\x04🌦\x15sun behind rain cloud\x01\x15sun_behind_rain_cloud\x00" +
+	"\x06☁️\x05cloud\x01\x05cloud\x00" +
+	"\x04🌧\x0fcloud with rain\x01\x0fcloud_with_rain\x00" +
+	"\x03⛈\x1dcloud with lightning and rain\x01\x1dcloud_with_lightning_and_rain\x00" +
+	"\x04🌩\x14cloud with lightning\x01\x14cloud_with_lightning\x00" +
+	"\x06⚡️\fhigh voltage\x01\x03zap\x00" +
+	"\x04🔥\x04fire\x01\x04fire\x00" +
+	"\x04💥\tcollision\x02\x04boom\tcollision\x00" +
+	"\x06❄️\tsnowflake\x01\tsnowflake\x00" +
+	"\x04🌨\x0fcloud with snow\x01\x0fcloud_with_snow\x00" +
+	"\x06☃️\asnowman\x01\x11snowman_with_snow\x00" +
+	"\x06⛄️\x14snowman without snow\x01\asnowman\x00" +
+	"\x04🌬\twind face\x01\twind_face\x00" +
+	"\x04💨\fdashing away\x01\x04dash\x00" +
+	"\x04🌪\atornado\x01\atornado\x00" +
+	"\x04🌫\x03fog\x01\x03fog\x00" +
+	"\x06☂️\bumbrella\x01\ropen_umbrella\x00" +
+	"\x06☔️\x18umbrella with rain drops\x01\bumbrella\x00" +
+	"\x04💧\adroplet\x01\adroplet\x00" +
+	"\x04💦\x0esweat droplets\x01\vsweat_drops\x00" +
+	"\x04🌊\nwater wave\x01\x05ocean\x00" +
+	"\x04🍏\vgreen apple\x01\vgreen_apple\x00" +
+	"\x04🍎\tred apple\x01\x05apple\x00" +
+	"\x04🍐\x04pear\x01\x04pear\x00" +
+	"\x04🍊\ttangerine\x03\ttangerine\x06orange\bmandarin\x00" +
+	"\x04🍋\x05lemon\x01\x05lemon\x00" +
+	"\x04🍌\x06banana\x01\x06banana\x00" +
+	"\x04🍉\nwatermelon\x01\nwatermelon\x00" +
+	"\x04🍇\x06grapes\x01\x06grapes\x00" +
+	"\x04🍓\nstrawberry\x01\nstrawberry\x00" +
+	"\x04🍈\x05melon\x01\x05melon\x00" +
+	"\x04🍒\bcherries\x01\bcherries\x00" +
+	"\x04🍑\x05peach\x01\x05peach\x00" +
+	"\x04🍍\tpineapple\x01\tpineapple\x00" +
+	"\x04🍅\x06tomato\x01\x06tomato\x00" +
+	"\x04🍆\beggplant\x01\beggplant\x00" +
+	"\x04🌶\nhot pepper\x01\nhot_pepper\x00" +
+	"\x04🌽\vear of corn\x01\x04corn\x00" +
+	"\x04🍠\x14roasted sweet potato\x01\fsweet_potato\x00" +
+	"\x04🍯\thoney pot\x01\thoney_pot\x00" +
+	"\x04🍞\x05bread\x01\x05bread\x00" +
+	"\x04🧀\fcheese wedge\x01\x06cheese\x00" +
+	"\x04🍗\vpoultry leg\x01\vpoultry_leg\x00" +
+	"\x04🍖\fmeat on bone\x01\fmeat_on_bone\x00" +
+	"\x04🍤\ffried shrimp\x01\ffried_shrimp\x00" +
+	"\x04🍳\acooking\x01\x03egg\x00" +
+	"\x04🍔\thamburger\x01\thamburger\x00" +
+	"\x04🍟\ffrench fries\x01\x05fries\x00" +
+	"\x04🌭\ahot dog\x01\x06hotdog\x00" +
+	"\x04🍕\x05pizza\x01\x05pizza\x00" +
+	"\x04🍝\tspaghetti\x01\tspaghetti\x00" +
+	"\x04🌮\x04taco\x01\x04taco\x00" +
+	"\x04🌯\aburrito\x01\aburrito\x00" +
+	"\x04🍜\rsteaming bowl\x01\x05ramen\x00" +
+	"\x04🍲\vpot of food\x01\x04stew\x00" +
+	"\x04🍥\x14fish cake with swirl\x01\tfish_cake\x00" +
+	"\x04🍣\x05sushi\x01\x05sushi\x00" +
+	"\x04🍱\tbento box\x01\x05bento\x00" +
+	"\x04🍛\ncurry rice\x01\x05curry\x00" +
+	"\x04🍙\trice ball\x01\trice_ball\x00" +
+	"\x04🍚\vcooked rice\x01\x04rice\x00" +
+	"\x04🍘\frice cracker\x01\frice_cracker\x00" +
+	"\x04🍢\x04oden\x01\x04oden\x00" +
+	"\x04🍡\x05dango\x01\x05dango\x00" +
+	"\x04🍧\nshaved ice\x01\nshaved_ice\x00" +
+	"\x04🍨\tice cream\x01\tice_cream\x00" +
+	"\x04🍦\x0esoft ice cream\x01\bicecream\x00" +
+	"\x04🍰\tshortcake\x01\x04cake\x00" +
+	"\x04🎂\rbirthday cake\x01\bbirthday\x00" +
+	"\x04🍮\acustard\x01\acustard\x00" +
+	"\x04🍬\x05candy\x01\x05candy\x00" +
+	"\x04🍭\blollipop\x01\blollipop\x00" +
+	"\x04🍫\rchocolate bar\x01\rchocolate_bar\x00" +
+	"\x04🍿\apopcorn\x01\apopcorn\x00" +
+	"\x04🍩\bdoughnut\x01\bdoughnut\x00" +
+	"\x04🍪\x06cookie\x01\x06cookie\x00" +
+	"\x04🍺\bbeer mug\x01\x04beer\x00" +
+	"\x04🍻\x12clinking beer mugs\x01\x05beers\x00" +
+	"\x04🍷\nwine glass\x01\nwine_glass\x00" +
+	"\x04🍸\x0ecocktail glass\x01\bcocktail\x00" +
+	"\x04🍹\x0etropical drink\x01\x0etropical_drink\x00" +
+	"\x04🍾\x18bottle with popping cork\x01\tchampagne\x00" +
+	"\x04🍶\x04sake\x01\x04sake\x00" +
+	"\x04🍵\x15teacup without handle\x01\x03tea\x00" +
+	"\x06☕️\fhot beverage\x01\x06coffee\x00" +
+	"\x04🍼\vbaby bottle\x01\vbaby_bottle\x00" +
+	"\x04🍴\x0efork and knife\x01\x0efork_and_knife\x00" +
+	"\x04🍽\x19fork and knife with plate\x01\x12plate_with_cutlery\x00" +
+	"\x06⚽️\vsoccer ball\x01\x06soccer\x00" +
+	"\x04🏀\nbasketball\x01\nbasketball\x00" +
+	"\x04🏈\x11american football\x01\bfootball\x00" +
+	"\x06⚾️\bbaseball\x01\bbaseball\x00" +
+	"\x04🎾\x06tennis\x01\x06tennis\x00" +
+	"\x04🏐\nvolleyball\x01\nvolleyball\x00" +
+	"\x04🏉\x0erugby football\x01\x0erugby_football\x00" +
+	"\x04🎱\vpool 8 ball\x01\x058ball\x00" +
+	"\x04🏓\tping pong\x01\tping_pong\x00" +
+	"\x04🏸\tbadminton\x01\tbadminton\x00" +
+	"\x04🏒\nice hockey\x01\nice_hockey\x00" +
+	"\x04🏑\ffield hockey\x01\ffield_hockey\x00" +
+	"\x04🏏\acricket\x01\acricket\x00" +
+	"\x04🏹\rbow and arrow\x01\rbow_and_arrow\x00" +
+	"\x06⛳️\fflag in hole\x01\x04golf\x00" +
+	"\x04🎣\ffishing pole\x01\x15fishing_pole_and_fish\x00" +
+	"\x03⛸\tice skate\x01\tice_skate\x00" +
+	"\x04🎿\x04skis\x01\x03ski\x00" +
+	"\x03⛷\x05skier\x01\x05skier\x00" +
+	"\x04🏂\vsnowboarder\x01\vsnowboarder\x00" +
+	"\x10🏋️\u200d♀️\x15woman lifting weights\x01\x14weight_lifting_woman\x00" +
+	"\a🏋️\x16person lifting weights\x01\x12weight_lifting_man\x00" +
+	"\x0f⛹️\u200d♀️\x13woman bouncing ball\x01\x10basketball_woman\x00" +
+	"\x06⛹️\x14person bouncing ball\x01\x0ebasketball_man\x00" +
+	"\x10🏌️\u200d♀️\rwoman golfing\x01\rgolfing_woman\x00" +
+	"\a🏌️\x0eperson golfing\x01\vgolfing_man\x00" +
+	"\r🏄\u200d♀️\rwoman surfing\x01\rsurfing_woman\x00" +
+	"\x04🏄\x0eperson surfing\x02\vsurfing_man\x06surfer\x00" +
+	"\r🏊\u200d♀️\x0ewoman swimming\x01\x0eswimming_woman\x00" +
+	"\x04🏊\x0fperson swimming\x02\fswimming_man\aswimmer\x00" +
+	"\r🚣\u200d♀️\x11woman rowing boat\x01\frowing_woman\x00" +
+	"\x04🚣\x12person rowing boat\x02\nrowing_man\arowboat\x00" +
+	"\x04🏇\fhorse racing\x01\fhorse_racing\x00" +
+	"\r🚴\u200d♀️\fwoman biking\x01\fbiking_woman\x00" +
+	"\x04🚴\rperson biking\x02\nbiking_man\tbicyclist\x00" +
+	"\r🚵\u200d♀️\x15woman mountain biking\x01\x15mountain_biking_woman\x00" +
+	"\x04🚵\x16person mountain biking\x02\x13mountain_biking_man\x12mountain_bicyclist\x00" +
+	"\x04🛀\x12person taking bath\x01\x04bath\x00" +
+	"\x04🕴\x1fman in business suit levitating\x01\x18business_suit_levitating\x00" +
+	"\x04🎗\x0freminder ribbon\x01\x0freminder_ribbon\x00" +
+	"\x04🎽\rrunning shirt\x01\x17running_shirt_with_sash\x00" +
+	"\x04🏅\fsports medal\x01\fmedal_sports\x00" +
+	"\x04🎖\x0emilitary medal\x01\x0emedal_military\x00" +
+	"\x04🏆\x06trophy\x01\x06trophy\x00" +
+	"\x04🏵\arosette\x01\arosette\x00" +
+	"\x04🎯\ndirect hit\x01\x04dart\x00" +
+	"\x04🎫\x06ticket\x01\x06ticket\x00" +
+	"\x04🎟\x11admission tickets\x01\atickets\x00" +
+	"\x04🎭\x0fperforming arts\x01\x0fperforming_arts\x00" +
+	"\x04🎨\x0eartist palette\x01\x03art\x00" +
+	"\x04🎪\vcircus tent\x01\vcircus_tent\x00" +
+	"\x04🎬\rclapper board\x01\aclapper\x00" +
+	"\x04🎤\nmicrophone\x01\nmicrophone\x00" +
+	"\x04🎧\theadphone\x01\nheadphones\x00" +
+	"\x04🎼\rmusical score\x01\rmusical_score\x00" +
+	"\x04🎹\x10musical keyboard\x01\x10musical_keyboard\x00" +
+	"\x04🎷\tsaxophone\x01\tsaxophone\x00" +
+	"\x04🎺\atrumpet\x01\atrumpet\x00" +
+	"\x04🎸\x06guitar\x01\x06guitar\x00" +
+	"\x04🎻\x06violin\x01\x06violin\x00" +
+	"\x04🎮\nvideo game\x01\nvideo_game\x00" +
+	"\x04🎰\fslot machine\x01\fslot_machine\x00" +
+	"\x04🎲\bgame die\x01\bgame_die\x00" +
+	"\x04🎳\abowling\x01\abowling\x00" +
+	"\x04🚗\nautomobile\x02\x03car\ared_car\x00" +
+	"\x04🚕\x04taxi\x01\x04taxi\x00" +
+	"\x04🚙\x15sport utility vehicle\x01\bblue_car\x00" +
+	"\x04🚌\x03bus\x01\x03bus\x00" +
+	"\x04🚎\ntrolleybus\x01\ntrolleybus\x00" +
+	"\x04🏎\nracing car\x01\nracing_car\x00" +
+	"\x04🚓\npolice car\x01\npolice_car\x00" +
+	"\x04🚑\tambulance\x01\tambulance\x00" +
+	"\x04🚒\vfire engine\x01\vfire_engine\x00" +
+	"\x04🚐\aminibus\x01\aminibus\x00" +
+	"\x04🚚\x0edelivery truck\x01\x05truck\x00" +
+	"\x04🚛\x11articulated lorry\x01\x11articulated_lorry\x00" +
+	"\x04🚜\atractor\x01\atractor\x00" +
+	"\x04🏍\nmotorcycle\x01\nmotorcycle\x00" +
+	"\x04🚲\abicycle\x01\x04bike\x00" +
+	"\x04🚨\x10police car light\x01\x0erotating_light\x00" +
+	"\x04🚔\x13oncoming police car\x01\x13oncoming_police_car\x00" +
+	"\x04🚍\foncoming bus\x01\foncoming_bus\x00" +
+	"\x04🚘\x13oncoming automobile\x01\x13oncoming_automobile\x00" +
+	"\x04🚖\roncoming taxi\x01\roncoming_taxi\x00" +
+	"\x04🚡\x0eaerial tramway\x01\x0eaerial_tramway\x00" +
+	"\x04🚠\x11mountain cableway\x01\x11mountain_cableway\x00" +
+	"\x04🚟\x12suspension railway\x01\x12suspension_railway\x00" +
+	"\x04🚃\vrailway car\x01\vrailway_car\x00" +
+	"\x04🚋\btram car\x01\x05train\x00" +
+	"\x04🚝\bmonorail\x01\bmonorail\x00" +
+	"\x04🚄\x10high-speed train\x01\x10bullettrain_side\x00" +
+	"\x04🚅!high-speed train with bullet nose\x01\x11bullettrain_front\x00" +
+	"\x04🚈\nlight rail\x01\nlight_rail\x00" +
+	"\x04🚞\x10mountain railway\x01\x10mountain_railway\x00" +
+	"\x04🚂\nlocomotive\x01\x10steam_locomotive\x00" +
+	"\x04🚆\x05train\x01\x06train2\x00" +
+	"\x04🚇\x05metro\x01\x05metro\x00" +
+	"\x04🚊\x04tram\x01\x04tram\x00" +
+	"\x04🚉\astation\x01\astation\x00" +
+	"\x04🚁\nhelicopter\x01\nhelicopter\x00" +
+	"\x04🛩\x0esmall airplane\x01\x0esmall_airplane\x00" +
+	"\x06✈️\bairplane\x01\bairplane\x00" +
+	"\x04🛫\x12airplane departure\x01\x10flight_departure\x00" +
+	"\x04🛬\x10airplane arrival\x01\x0eflight_arrival\x00" +
+	"\x06⛵️\bsailboat\x02\x04boat\bsailboat\x00" +
+	"\x04🛥\nmotor boat\x01\nmotor_boat\x00" +
+	"\x04🚤\tspeedboat\x01\tspeedboat\x00" +
+	"\x03⛴\x05ferry\x01\x05ferry\x00" +
+	"\x04🛳\x0epassenger ship\x01\x0epassenger_ship\x00" +
+	"\x04🚀\x06rocket\x01\x06rocket\x00" +
+	"\x04🛰\tsatellite\x01\x14artificial_satellite\x00" +
+	"\x04💺\x04seat\x01\x04seat\x00" +
+	"\x06⚓️\x06anchor\x01\x06anchor\x00" +
+	"\x04🚧\fconstruction\x01\fconstruction\x00" +
+	"\x06⛽️\tfuel pump\x01\bfuelpump\x00" +
+	"\x04🚏\bbus stop\x01\abusstop\x00" +
+	"\x04🚦\x16vertical traffic light\x01\x16vertical_traffic_light\x00" +
+	"\x04🚥\x18horizontal traffic light\x01\rtraffic_light\x00" +
+	"\x04🗺\tworld map\x01\tworld_map\x00" +
+	"\x04🚢\x04ship\x01\x04ship\x00" +
+	"\x04🎡\fferris wheel\x01\fferris_wheel\x00" +
+	"\x04🎢\x0eroller coaster\x01\x0eroller_coaster\x00" +
+	"\x04🎠\x0ecarousel horse\x01\x0ecarousel_horse\x00" +
+	"\x04🏗\x15building construction\x01\x15building_construction\x00" +
+	"\x04🌁\x05foggy\x01\x05foggy\x00" +
+	"\x04🗼\vTokyo tower\x01\vtokyo_tower\x00" +
+	"\x04🏭\afactory\x01\afactory\x00" +
+	"\x06⛲️\bfountain\x01\bfountain\x00" +
+	"\x04🎑\x15moon viewing ceremony\x01\nrice_scene\x00" +
+	"\x03⛰\bmountain\x01\bmountain\x00" +
+	"\x04🏔\x14snow-capped mountain\x01\rmountain_snow\x00" +
+	"\x04🗻\nmount fuji\x01\nmount_fuji\x00" +
+	"\x04🌋\avolcano\x01\avolcano\x00" +
+	"\x04🗾\fmap of Japan\x01\x05japan\x00" +
+	"\x04🏕\acamping\x01\acamping\x00" +
+	"\x06⛺️\x04tent\x01\x04tent\x00" +
+	"\x04🏞\rnational park\x01\rnational_park\x00" +
+	"\x04🛣\bmotorway\x01\bmotorway\x00" +
+	"\x04🛤\rrailway track\x01\rrailway_track\x00" +
+	"\x04🌅\asunrise\x01\asunrise\x00" +
+	"\x04🌄\x16sunrise over mountains\x01\x16sunrise_over_mountains\x00" +
+	"\x04🏜\x06desert\x01\x06desert\x00" +
+	"\x04🏖\x13beach with umbrella\x01\x0ebeach_umbrella\x00" +
+	"\x04🏝\rdesert island\x01\rdesert_island\x00" +
+	"\x04🌇\x06sunset\x01\fcity_sunrise\x00" +
+	"\x04🌆\x11cityscape at dusk\x01\vcity_sunset\x00" +
+	"\x04🏙\tcityscape\x01\tcityscape\x00" +
+	"\x04🌃\x10night with stars\x01\x10night_with_stars\x00" +
+	"\x04🌉\x0fbridge at night\x01\x0fbridge_at_night\x00" +
+	"\x04🌌\tmilky way\x01\tmilky_way\x00" +
+	"\x04🌠\rshooting star\x01\x05stars\x00" +
+	"\x04🎇\bsparkler\x01\bsparkler\x00" +
+	"\x04🎆\tfireworks\x01\tfireworks\x00" +
+	"\x04🌈\arainbow\x01\arainbow\x00" +
+	"\x04🏘\x05house\x01\x06houses\x00" +
+	"\x04🏰\x06castle\x01\x0feuropean_castle\x00" +
+	"\x04🏯\x0fJapanese castle\x01\x0fjapanese_castle\x00" +
+	"\x04🏟\astadium\x01\astadium\x00" +
+	"\x04🗽\x11Statue of Liberty\x01\x11statue_of_liberty\x00" +
+	"\x04🏠\x05house\x01\x05house\x00" +
+	"\x04🏡\x11house with garden\x01\x11house_with_garden\x00" +
+	"\x04🏚\x0ederelict house\x01\x0ederelict_house\x00" +
+	"\x04🏢\x0foffice building\x01\x06office\x00" +
+	"\x04🏬\x10department store\x01\x10department_store\x00" +
+	"\x04🏣\x14Japanese post office\x01\vpost_office\x00" +
+	"\x04🏤\vpost office\x01\x14european_post_office\x00" +
+	"\x04🏥\bhospital\x01\bhospital\x00" +
+	"\x04🏦\x04bank\x01\x04bank\x00" +
+	"\x04🏨\x05hotel\x01\x05hotel\x00" +
+	"\x04🏪\x11convenience store\x01\x11convenience_store\x00" +
+	"\x04🏫\x06school\x01\x06school\x00" +
+	"\x04🏩\nlove hotel\x01\nlove_hotel\x00" +
+	"\x04💒\awedding\x01\awedding\x00" +
+	"\x04🏛\x12classical building\x01\x12classical_building\x00" +
+	"\x06⛪️\x06church\x01\x06church\x00" +
+	"\x04🕌\x06mosque\x01\x06mosque\x00" +
+	"\x04🕍\tsynagogue\x01\tsynagogue\x00" +
+	"\x04🕋\x05kaaba\x01\x05kaaba\x00" +
+	"\x03⛩\rshinto shrine\x01\rshinto_shrine\x00" +
+	"\x06⌚️\x05watch\x01\x05watch\x00" +
+	"\x04📱\fmobile phone\x01\x06iphone\x00" +
+	"\x04📲\x17mobile phone with arrow\x01\acalling\x00" +
+	"\x04💻\x0flaptop computer\x01\bcomputer\x00" +
+	"\x06⌨️\bkeyboard\x01\bkeyboard\x00" +
+	"\x04🖥\x10desktop computer\x01\x10desktop_computer\x00" +
+	"\x04🖨\aprinter\x01\aprinter\x00" +
+	"\x04🖱\x0ecomputer mouse\x01\x0ecomputer_mouse\x00" +
+	"\x04🖲\ttrackball\x01\ttrackball\x00" +
+	"\x04🕹\bjoystick\x01\bjoystick\x00" +
+	"\x04🗜\x05clamp\x01\x05clamp\x00" +
+	"\x04💽\rcomputer disk\x01\bminidisc\x00" +
+	"\x04💾\vfloppy disk\x01\vfloppy_disk\x00" +
+	"\x04💿\foptical disk\x01\x02cd\x00" +
+	"\x04📀\x03dvd\x01\x03dvd\x00" +
+	"\x04📼\rvideocassette\x01\x03vhs\x00" +
+	"\x04📷\x06camera\x01\x06camera\x00" +
+	"\x04📸\x11camera with flash\x01\fcamera_flash\x00" +
+	"\x04📹\fvideo camera\x01\fvideo_camera\x00" +
+	"\x04🎥\fmovie camera\x01\fmovie_camera\x00" +
+	"\x04📽\x0efilm projector\x01\x0efilm_projector\x00" +
+	"\x04🎞\vfilm frames\x01\nfilm_strip\x00" +
+	"\x04📞\x12telephone receiver\x01\x12telephone_receiver\x00" +
+	"\x06☎️\ttelephone\x02\x05phone\ttelephone\x00" +
+	"\x04📟\x05pager\x01\x05pager\x00" +
+	"\x04📠\vfax machine\x01\x03fax\x00" +
+	"\x04📺\ntelevision\x01\x02tv\x00" +
+	"\x04📻\x05radio\x01\x05radio\x00" +
+	"\x04🎙\x11studio microphone\x01\x11studio_microphone\x00" +
+	"\x04🎚\flevel slider\x01\flevel_slider\x00" +
+	"\x04🎛\rcontrol knobs\x01\rcontrol_knobs\x00" +
+	"\x03⏱\tstopwatch\x01\tstopwatch\x00" +
+	"\x03⏲\vtimer clock\x01\vtimer_clock\x00" +
+	"\x03⏰\valarm clock\x01\valarm_clock\x00" +
+	"\x04🕰\x11mantelpiece clock\x01\x11mantelpiece_clock\x00" +
+	"\x03⏳\x1bhourglass with flowing sand\x01\x16hourglass_flowing_sand\x00" +
+	"\x06⌛️\thourglass\x01\thourglass\x00" +
+	"\x04📡\x11satellite antenna\x01\tsatellite\x00" +
+	"\x04🔋\abattery\x01\abattery\x00" +
+	"\x04🔌\relectric plug\x01\relectric_plug\x00" +
+	"\x04💡\nlight bulb\x01\x04bulb\x00" +
+	"\x04🔦\nflashlight\x01\nflashlight\x00" +
+	"\x04🕯\x06candle\x01\x06candle\x00" +
+	"\x04🗑\vwastebasket\x01\vwastebasket\x00" +
+	"\x04🛢\boil drum\x01\boil_drum\x00" +
+	"\x04💸\x10money with wings\x01\x10money_with_wings\x00" +
+	"\x04💵\x0fdollar banknote\x01\x06dollar\x00" +
+	"\x04💴\fyen banknote\x01\x03yen\x00" +
+	"\x04💶\reuro banknote\x01\x04euro\x00" +
+	"\x04💷\x0epound banknote\x01\x05pound\x00" +
+	"\x04💰\tmoney bag\x01\bmoneybag\x00" +
+	"\x04💳\vcredit card\x01\vcredit_card\x00" +
+	"\x04💎\tgem stone\x01\x03gem\x00" +
+	"\x03⚖\rbalance scale\x01\rbalance_scale\x00" +
+	"\x04🔧\x06wrench\x01\x06wrench\x00" +
+	"\x04🔨\x06hammer\x01\x06hammer\x00" +
+	"\x03⚒\x0fhammer and pick\x01\x0fhammer_and_pick\x00" +
+	"\x04🛠\x11hammer and wrench\x01\x11hammer_and_wrench\x00" +
+	"\x03⛏\x04pick\x01\x04pick\x00" +
+	"\x04🔩\fnut and bolt\x01\fnut_and_bolt\x00" +
+	"\x03⚙\x04gear\x01\x04gear\x00" +
+	"\x03⛓\x06chains\x01\x06chains\x00" +
+	"\x04🔫\x06pistol\x01\x03gun\x00" +
+	"\x04💣\x04bomb\x01\x04bomb\x00" +
+	"\x04🔪\rkitchen knife\x02\x05hocho\x05knife\x00" +
+	"\x04🗡\x06dagger\x01\x06dagger\x00" +
+	"\x03⚔\x0ecrossed swords\x01\x0ecrossed_swords\x00" +
+	"\x04🛡\x06shield\x01\x06shield\x00" +
+	"\x04🚬\tcigarette\x01\asmoking\x00" +
+	"\x03⚰\x06coffin\x01\x06coffin\x00" +
+	"\x03⚱\vfuneral urn\x01\vfuneral_urn\x00" +
+	"\x04🏺\aamphora\x01\aamphora\x00" +
+	"\x04🔮\fcrystal ball\x01\fcrystal_ball\x00" +
+	"\x04📿\fprayer beads\x01\fprayer_beads\x00" +
+	"\x04💈\vbarber pole\x01\x06barber\x00" +
+	"\x03⚗\aalembic\x01\aalembic\x00" +
+	"\x04🔭\ttelescope\x01\ttelescope\x00" +
+	"\x04🔬\nmicroscope\x01\nmicroscope\x00" +
+	"\x04🕳\x04hole\x01\x04hole\x00" +
+	"\x04💊\x04pill\x01\x04pill\x00" +
+	"\x04💉\asyringe\x01\asyringe\x00" +
+	"\x04🌡\vthermometer\x01\vthermometer\x00" +
+	"\x04🚽\x06toilet\x01\x06toilet\x00" +
+	"\x04🚿\x06shower\x01\x06shower\x00" +
+	"\x04🛁\abathtub\x01\abathtub\x00" +
+	"\x04🛎\fbellhop bell\x01\fbellhop_bell\x00" +
+	"\x04🔑\x03key\x01\x03key\x00" +
+	"\x04🗝\aold key\x01\aold_key\x00" +
+	"\x04🚪\x04door\x01\x04door\x00" +
+	"\x04🛋\x0ecouch and lamp\x01\x0ecouch_and_lamp\x00" +
+	"\x04🛌\rperson in bed\x01\fsleeping_bed\x00" +
+	"\x04🛏\x03bed\x01\x03bed\x00" +
+	"\x04🖼\x0eframed picture\x01\x0eframed_picture\x00" +
+	"\x03⛱\x12umbrella on ground\x01\x11parasol_on_ground\x00" +
+	"\x04🗿\x04moai\x01\x05moyai\x00" +
+	"\x04🛍\rshopping bags\x01\bshopping\x00" +
+	"\x04🎁\fwrapped gift\x01\x04gift\x00" +
+	"\x04🎈\aballoon\x01\aballoon\x00" +
+	"\x04🎏\rcarp streamer\x01\x05flags\x00" +
+	"\x04🎀\x06ribbon\x01\x06ribbon\x00" +
+	"\x04🎊\rconfetti ball\x01\rconfetti_ball\x00" +
+	"\x04🎉\fparty popper\x01\x04tada\x00" +
+	"\x04🎐\nwind chime\x01\nwind_chime\x00" +
+	"\x04🏮\x11red paper lantern\x02\x0fizakaya_lantern\alantern\x00" +
+	"\x04🎎\x0eJapanese dolls\x01\x05dolls\x00" +
+	"\x06✉️\benvelope\x02\x05email\benvelope\x00" +
+	"\x04📩\x13envelope with arrow\x01\x13envelope_with_arrow\x00" +
+	"\x04📨\x11incoming envelope\x01\x11incoming_envelope\x00" +
+	"\x04📧\x06e-mail\x01\x06e-mail\x00" +
+	"\x04💌\vlove letter\x01\vlove_letter\x00" +
+	"\x04📥\ninbox tray\x01\ninbox_tray\x00" +
+	"\x04📤\voutbox tray\x01\voutbox_tray\x00" +
+	"\x04📦\apackage\x01\apackage\x00" +
+	"\x04🏷\x05label\x01\x05label\x00" +
+	"\x04🔖\bbookmark\x01\bbookmark\x00" +
+	"\x04📪 closed mailbox with lowered flag\x01\x0emailbox_closed\x00" +
+	"\x04📫\x1fclosed mailbox with raised flag\x01\amailbox\x00" +
+	"\x04📬\x1dopen mailbox with raised flag\x01\x11mailbox_with_mail\x00" +
+	"\x04📭\x1eopen mailbox with lowered flag\x01\x14mailbox_with_no_mail\x00" +
+	"\x04📮\apostbox\x01\apostbox\x00" +
+	"\x04📯\vpostal horn\x01\vpostal_horn\x00" +
+	"\x04📜\x06scroll\x01\x06scroll\x00" +
+	"\x04📃\x0epage with curl\x01\x0epage_with_curl\x00" +
+	"\x04📄\x0epage facing up\x01\x0epage_facing_up\x00" +
+	"\x04📑\rbookmark tabs\x01\rbookmark_tabs\x00" +
+	"\x04📊\tbar chart\x01\tbar_chart\x00" +
+	"\x04📈\x10chart increasing\x01\x18chart_with_upwards_trend\x00" +
+	"\x04📉\x10chart decreasing\x01\x1achart_with_downwards_trend\x00" +
+	"\x04🗒\x0espiral notepad\x01\x0espiral_notepad\x00" +
+	"\x04🗓\x0fspiral calendar\x01\x0fspiral_calendar\x00" +
+	"\x04📆\x11tear-off calendar\x01\bcalendar\x00" +
+	"\x04📅\bcalendar\x01\x04date\x00" +
+	"\x04📇\ncard index\x01\ncard_index\x00" +
+	"\x04🗃\rcard file box\x01\rcard_file_box\x00" +
+	"\x04🗳\x16ballot box with ballot\x01\nballot_box\x00" +
+	"\x04🗄\ffile cabinet\x01\ffile_cabinet\x00" +
+	"\x04📋\tclipboard\x01\tclipboard\x00" +
+	"\x04📁\vfile folder\x01\vfile_folder\x00" +
+	"\x04📂\x10open file folder\x01\x10open_file_folder\x00" +
+	"\x04🗂\x13card index dividers\x01\x13card_index_dividers\x00" +
+	"\x04🗞\x13rolled-up newspaper\x01\x0enewspaper_roll\x00" +
+	"\x04📰\tnewspaper\x01\tnewspaper\x00" +
+	"\x04📓\bnotebook\x01\bnotebook\x00" +
+	"\x04📔\x1enotebook with decorative cover\x01\x1enotebook_with_decorative_cover\x00" +
+	"\x04📒\x06ledger\x01\x06ledger\x00" +
+	"\x04📕\vclosed book\x01\vclosed_book\x00" +
+	"\x04📗\ngreen book\x01\ngreen_book\x00" +
+	"\x04📘\tblue book\x01\tblue_book\x00" +
+	"\x04📙\vorange book\x01\vorange_book\x00" +
+	"\x04📚\x05books\x01\x05books\x00" +
+	"\x04📖\topen book\x02\x04book\topen_book\x00" +
+	"\x04🔗\x04link\x01\x04link\x00" +
+	"\x04📎\tpaperclip\x01\tpaperclip\x00" +
+	"\x04🖇\x11linked paperclips\x01\npaperclips\x00" +
+	"\x04📐\x10triangular ruler\x01\x10triangular_ruler\x00" +
+	"\x04📏\x0estraight ruler\x01\x0estraight_ruler\x00" +
+	"\x06✂️\bscissors\x01\bscissors\x00" +
+	"\x04📌\apushpin\x01\apushpin\x00" +
+	"\x04📍\rround pushpin\x01\rround_pushpin\x00" +
+	"\x04🚩\x0ftriangular flag\x01\x17triangular_flag_on_post\x00" +
+	"\x04🎌\rcrossed flags\x01\rcrossed_flags\x00" +
+	"\a🏳️\nwhite flag\x01\nwhite_flag\x00" +
+	"\x04🏴\nblack flag\x01\nblack_flag\x00" +
+	"\x04🏁\x0echequered flag\x01\x0echeckered_flag\x00" +
+	"\x0e🏳️\u200d🌈\frainbow flag\x01\frainbow_flag\x00" +
+	"\x04🖌\npaintbrush\x01\npaintbrush\x00" +
+	"\x04🖍\x06crayon\x01\x06crayon\x00" +
+	"\x04🖊\x03pen\x01\x03pen\x00" +
+	"\x04🖋\ffountain pen\x01\ffountain_pen\x00" +
+	"\x06✒️\tblack nib\x01\tblack_nib\x00" +
+	"\x04📝\x04memo\x02\x04memo\x06pencil\x00" +
+	"\x06✏️\x06pencil\x01\apencil2\x00" +
+	"\x04🔏\x0flocked with pen\x01\x11lock_with_ink_pen\x00" +
+	"\x04🔐\x0flocked with key\x01\x14closed_lock_with_key\x00" +
+	"\x04🔒\x06locked\x01\x04lock\x00" +
+	"\x04🔓\bunlocked\x01\x06unlock\x00" +
+	"\x04🔍\x1eleft-pointing magnifying glass\x01\x03mag\x00" +
+	"\x04🔎\x1fright-pointing magnifying glass\x01\tmag_right\x00" +
+	"\x06❤️\tred heart\x01\x05heart\x00" +
+	"\x04💛\fyellow heart\x01\fyellow_heart\x00" +
+	"\x04💚\vgreen heart\x01\vgreen_heart\x00" +
+	"\x04💙\nblue heart\x01\nblue_heart\x00" +
+	"\x04💜\fpurple heart\x01\fpurple_heart\x00" +
+	"\x04💔\fbroken heart\x01\fbroken_heart\x00" +
+	"\x06❣️\x17heavy heart exclamation\x01\x17heavy_heart_exclamation\x00" +
+	"\x04💕\ntwo hearts\x01\ntwo_hearts\x00" +
+	"\x04💞\x10revolving hearts\x01\x10revolving_hearts\x00" +
+	"\x04💓\rbeating heart\x01\theartbeat\x00" +
+	"\x04💗\rgrowing heart\x01\nheartpulse\x00" +
+	"\x04💖\x0fsparkling heart\x01\x0fsparkling_heart\x00" +
+	"\x04💘\x10heart with arrow\x01\x05cupid\x00" +
+	"\x04💝\x11heart with ribbon\x01\ngift_heart\x00" +
+	"\x04💟\x10heart decoration\x01\x10heart_decoration\x00" +
+	"\x06☮️\fpeace symbol\x01\fpeace_symbol\x00" +
+	"\x06✝️\vlatin cross\x01\vlatin_cross\x00" +
+	"\x06☪️\x11star and crescent\x01\x11star_and_crescent\x00" +
+	"\x04🕉\x02om\x01\x02om\x00" +
+	"\x06☸️\x0fwheel of dharma\x01\x0fwheel_of_dharma\x00" +
+	"\x06✡️\rstar of David\x01\rstar_of_david\x00" +
+	"\x04🔯\x17dotted six-pointed star\x01\x10six_pointed_star\x00" +
+	"\x04🕎\amenorah\x01\amenorah\x00" +
+	"\x06☯️\byin yang\x01\byin_yang\x00" +
+	"\x06☦️\x0eorthodox cross\x01\x0eorthodox_cross\x00" +
+	"\x04🛐\x10place of worship\x01\x10place_of_worship\x00" +
+	"\x03⛎\tOphiuchus\x01\tophiuchus\x00" +
+	"\x06♈️\x05Aries\x01\x05aries\x00" +
+	"\x06♉️\x06Taurus\x01\x06taurus\x00" +
+	"\x06♊️\x06Gemini\x01\x06gemini\x00" +
+	"\x06♋️\x06Cancer\x01\x06cancer\x00" +
+	"\x06♌️\x03Leo\x01\x03leo\x00" +
+	"\x06♍️\x05Virgo\x01\x05virgo\x00" +
+	"\x06♎️\x05Libra\x01\x05libra\x00" +
+	"\x06♏️\bScorpius\x01\bscorpius\x00" +
+	"\x06♐️\vSagittarius\x01\vsagittarius\x00" +
+	"\x06♑️\tCapricorn\x01\tcapricorn\x00" +
+	"\x06♒️\bAquarius\x01\baquarius\x00" +
+	"\x06♓️\x06Pisces\x01\x06pisces\x00" +
+	"\x04🆔\tID button\x01\x02id\x00" +
+	"\x03⚛\vatom symbol\x01\vatom_symbol\x00" +
+	"\x04🈳\x1dJapanese “vacancy” button\x01\x05u7a7a\x00" +
+	"\x04🈹\x1eJapanese “discount” button\x01\x05u5272\x00" +
+	"\x06☢️\vradioactive\x01\vradioactive\x00" +
+	"\x06☣️\tbiohazard\x01\tbiohazard\x00" +
+	"\x04📴\x10mobile phone off\x01\x10mobile_phone_off\x00" +
+	"\x04📳\x0evibration mode\x01\x0evibration_mode\x00" +
+	"\x04🈶(Japanese “not free of charge” button\x01\x05u6709\x00" +
+	"\a🈚️$Japanese “free of charge” button\x01\x05u7121\x00" +
+	"\x04🈸!Japanese “application” button\x01\x05u7533\x00" +
+	"\x04🈺'Japanese “open for business” button\x01\x05u55b6\x00" +
+	"\a🈷️$Japanese “monthly amount” button\x01\x05u6708\x00" +
+	"\x06✴️\x12eight-pointed star\x01\x18eight_pointed_black_star\x00" +
+	"\x04🆚\tVS button\x01\x02vs\x00" +
+	"\x04🉑 Japanese “acceptable” button\x01\x06accept\x00" +
+	"\x04💮\fwhite flower\x01\fwhite_flower\x00" +
+	"\x04🉐\x1dJapanese “bargain” button\x01\x13ideograph_advantage\x00" +
+	"\x06㊙️\x1cJapanese “secret” button\x01\x06secret\x00" +
+	"\x06㊗️%Japanese “congratulations” button\x01\x0fcongratulations\x00" +
+	"\x04🈴#Japanese “passing grade” button\x01\x05u5408\x00" +
+	"\x04🈵 Japanese “no vacancy” button\x01\x05u6e80\x00" +
+	"\x04🈲 Japanese “prohibited” button\x01\x05u7981\x00" +
+	"\a🅰️\x15A button (blood type)\x01\x01a\x00" +
+	"\a🅱️\x15B button (blood type)\x01\x01b\x00" +
+	"\x04🆎\x16AB button (blood type)\x01\x02ab\x00" +
+	"\x04🆑\tCL button\x01\x02cl\x00" +
+	"\a🅾️\x15O button (blood type)\x01\x02o2\x00" +
+	"\x04🆘\nSOS button\x01\x03sos\x00" +
+	"\x06⛔️\bno entry\x01\bno_entry\x00" +
+	"\x04📛\nname badge\x01\nname_badge\x00" +
+	"\x04🚫\nprohibited\x01\rno_entry_sign\x00" +
+	"\x03❌\ncross mark\x01\x01x\x00" +
+	"\x06⭕️\x12heavy large circle\x01\x01o\x00" +
+	"\x04💢\fanger symbol\x01\x05anger\x00" +
+	"\x06♨️\vhot springs\x01\nhotsprings\x00" +
+	"\x04🚷\x0eno pedestrians\x01\x0eno_pedestrians\x00" +
+	"\x04🚯\fno littering\x01\rdo_not_litter\x00" +
+	"\x04🚳\vno bicycles\x01\vno_bicycles\x00" +
+	"\x04🚱\x11non-potable water\x01\x11non-potable_water\x00" +
+	"\x04🔞\x15no one under eighteen\x01\bunderage\x00" +
+	"\x04📵\x10no mobile phones\x01\x10no_mobile_phones\x00" +
+	"\x06❗️\x10exclamation mark\x02\vexclamation\x16heavy_exclamation_mark\x00" +
+	"\x03❕\x16white exclamation mark\x01\x10grey_exclamation\x00" +
+	"\x03❓\rquestion mark\x01\bquestion\x00" +
+	"\x03❔\x13white question mark\x01\rgrey_question\x00" +
+	"\x06‼️\x17double exclamation mark\x01\bbangbang\x00" +
+	"\x06⁉️\x19exclamation question mark\x01\vinterrobang\x00" +
+	"\x04💯\x0ehundred points\x01\x03100\x00" +
+	"\x04🔅\ndim button\x01\x0elow_brightness\x00" +
+	"\x04🔆\rbright button\x01\x0fhigh_brightness\x00" +
+	"\x04🔱\x0etrident emblem\x01\atrident\x00" +
+	"\x03⚜\ffleur-de-lis\x01\ffleur_de_lis\x00" +
+	"\x06〽️\x15part alternation mark\x01\x15part_alternation_mark\x00" +
+	"\x06⚠️\awarning\x01\awarning\x00" +
+	"\x04🚸\x11children crossing\x01\x11children_crossing\x00" +
+	"\x04🔰\x1cJapanese symbol for beginner\x01\bbeginner\x00" +
+	"\x06♻️\x10recycling symbol\x01\arecycle\x00" +
+	"\a🈯️\x1eJapanese “reserved” button\x01\x05u6307\x00" +
+	"\x04💹\x19chart increasing with yen\x01\x05chart\x00" +
+	"\x06❇️\asparkle\x01\asparkle\x00" +
+	"\x06✳️\x15eight-spoked asterisk\x01\x15eight_spoked_asterisk\x00" +
+	"\x03❎\x11cross mark button\x01\x1bnegative_squared_cross_mark\x00" +
+	"\x03✅\x16white heavy check mark\x01\x10white_check_mark\x00" +
+	"\x04🌐\x14globe with meridians\x01\x14globe_with_meridians\x00" +
+	"\x06Ⓜ️\tcircled M\x01\x01m\x00" +
+	"\x04💠\x12diamond with a dot\x01\x1fdiamond_shape_with_a_dot_inside\x00" +
+	"\x04🌀\acyclone\x01\acyclone\x00" +
+	"\x03➿\x11double curly loop\x01\x04loop\x00" +
+	"\x04🏧\bATM sign\x01\x03atm\x00" +
+	"\a🈂️$Japanese “service charge” button\x01\x02sa\x00" +
+	"\x04🛂\x10passport control\x01\x10passport_control\x00" +
+	"\x04🛃\acustoms\x01\acustoms\x00" +
+	"\x04🛄\rbaggage claim\x01\rbaggage_claim\x00" +
+	"\x04🛅\fleft luggage\x01\fleft_luggage\x00" +
+	"\x06♿️\x11wheelchair symbol\x01\nwheelchair\x00" +
+	"\x04🚭\nno smoking\x01\nno_smoking\x00" +
+	"\x04🚾\fwater closet\x01\x02wc\x00" +
+	"\a🅿️\bP button\x01\aparking\x00" +
+	"\x04🚰\rpotable water\x01\rpotable_water\x00" +
+	"\x04🚹\fmen’s room\x01\x04mens\x00" +
+	"\x04🚺\x0ewomen’s room\x01\x06womens\x00" +
+	"\x04🚼\vbaby symbol\x01\vbaby_symbol\x00" +
+	"\x04🚻\brestroom\x01\brestroom\x00" +
+	"\x04🚮\x12litter in bin sign\x01\x17put_litter_in_its_place\x00" +
+	"\x04🎦\x06cinema\x01\x06cinema\x00" +
+	"\x04📶\fantenna bars\x01\x0fsignal_strength\x00" +
+	"\x04🈁\x1aJapanese “here” button\x01\x04koko\x00" +
+	"\x04🔤\x13input latin letters\x01\x03abc\x00" +
+	"\x04🔡\x15input latin lowercase\x01\x04abcd\x00" +
+	"\x04🔠\x15input latin uppercase\x01\fcapital_abcd\x00" +
+	"\x04🔣\rinput symbols\x01\asymbols\x00" +
+	"\x06ℹ️\vinformation\x01\x12information_source\x00" +
+	"\x04🆖\tNG button\x01\x02ng\x00" +
+	"\x04🆗\tOK button\x01\x02ok\x00" +
+	"\x04🆙\nUP! button\x01\x02up\x00" +
+	"\x04🆒\vCOOL button\x01\x04cool\x00" +
+	"\x04🆕\nNEW button\x01\x03new\x00" +
+	"\x04🆓\vFREE button\x01\x04free\x00" +
+	"\a0️⃣\tkeycap: 0\x01\x04zero\x00" +
+	"\a1️⃣\tkeycap: 1\x01\x03one\x00" +
+	"\a2️⃣\tkeycap: 2\x01\x03two\x00" +
+	"\a3️⃣\tkeycap: 3\x01\x05three\x00" +
+	"\a4️⃣\tkeycap: 4\x01\x04four\x00" +
+	"\a5️⃣\tkeycap: 5\x01\x04five\x00" +
+	"\a6️⃣\tkeycap: 6\x01\x03six\x00" +
+	"\a7️⃣\tkeycap: 7\x01\x05seven\x00" +
+	"\a8️⃣\tkeycap: 8\x01\x05eight\x00" +
+	"\a9️⃣\tkeycap: 9\x01\x04nine\x00" +
+	"\x04🔟\tkeycap 10\x01\nkeycap_ten\x00" +
+	"\x04🔢\rinput numbers\x01\x041234\x00" +
+	"\a#️⃣\tkeycap: #\x01\x04hash\x00" +
+	"\a*️⃣\tkeycap: *\x01\basterisk\x00" +
+	"\x06▶️\vplay button\x01\rarrow_forward\x00" +
+	"\x03⏸\fpause button\x01\fpause_button\x00" +
+	"\x03⏯\x14play or pause button\x01\x14play_or_pause_button\x00" +
+	"\x03⏹\vstop button\x01\vstop_button\x00" +
+	"\x03⏺\rrecord button\x01\rrecord_button\x00" +
+	"\x03⏭\x11next track button\x01\x11next_track_button\x00" +
+	"\x03⏮\x11last track button\x01\x15previous_track_button\x00" +
+	"\x03⏩\x13fast-forward button\x01\ffast_forward\x00" +
+	"\x03⏪\x13fast reverse button\x01\x06rewind\x00" +
+	"\x03⏫\x0efast up button\x01\x0farrow_double_up\x00" +
+	"\x03⏬\x10fast down button\x01\x11arrow_double_down\x00" +
+	"\x06◀️\x0ereverse button\x01\x0earrow_backward\x00" +
+	"\x04🔼\tup button\x01\x0earrow_up_small\x00" +
+	"\x04🔽\vdown button\x01\x10arrow_down_small\x00" +
+	"\x06➡️\vright arrow\x01\varrow_right\x00" +
+	"\x06⬅️\nleft arrow\x01\narrow_left\x00" +
+	"\x06⬆️\bup arrow\x01\barrow_up\x00" +
+	"\x06⬇️\ndown arrow\x01\narrow_down\x00" +
+	"\x06↗️\x0eup-right arrow\x01\x11arrow_upper_right\x00" +
+	"\x06↘️\x10down-right arrow\x01\x11arrow_lower_right\x00" +
+	"\x06↙️\x0fdown-left arrow\x01\x10arrow_lower_left\x00" +
+	"\x06↖️\rup-left arrow\x01\x10arrow_upper_left\x00" +
+	"\x06↕️\rup-down arrow\x01\rarrow_up_down\x00" +
+	"\x06↔️\x10left-right arrow\x01\x10left_right_arrow\x00" +
+	"\x06↪️\x18left arrow curving right\x01\x10arrow_right_hook\x00" +
+	"\x06↩️\x18right arrow curving left\x01\x19leftwards_arrow_with_hook\x00" +
+	"\x06⤴️\x16right arrow curving up\x01\x10arrow_heading_up\x00" +
+	"\x06⤵️\x18right arrow curving down\x01\x12arrow_heading_down\x00" +
+	"\x04🔀\x15shuffle tracks button\x01\x19twisted_rightwards_arrows\x00" +
+	"\x04🔁\rrepeat button\x01\x06repeat\x00" +
+	"\x04🔂\x14repeat single button\x01\nrepeat_one\x00" +
+	"\x04🔄\x1banticlockwise arrows button\x01\x17arrows_counterclockwise\x00" +
+	"\x04🔃\x19clockwise vertical arrows\x01\x10arrows_clockwise\x00" +
+	"\x04🎵\fmusical note\x01\fmusical_note\x00" +
+	"\x04🎶\rmusical notes\x01\x05notes\x00" +
+	"\x06〰️\twavy dash\x01\twavy_dash\x00" +
+	"\x03➰\ncurly loop\x01\ncurly_loop\x00" +
+	"\x06✔️\x10heavy check mark\x01\x10heavy_check_mark\x00" +
+	"\x03➕\x0fheavy plus sign\x01\x0fheavy_plus_sign\x00" +
+	"\x03➖\x10heavy minus sign\x01\x10heavy_minus_sign\x00" +
+	"\x03➗\x13heavy division sign\x01\x13heavy_division_sign\x00" +
+	"\x06✖️\x16heavy multiplication x\x01\x16heavy_multiplication_x\x00" +
+	"\x04💲\x11heavy dollar sign\x01\x11heavy_dollar_sign\x00" +
+	"\x04💱\x11currency exchange\x01\x11currency_exchange\x00" +
+	"\x06™️\ntrade mark\x01\x02tm\x00" +
+	"\x05©️\tcopyright\x01\tcopyright\x00" +
+	"\x05®️\nregistered\x01\nregistered\x00" +
+	"\x04🔚\tEND arrow\x01\x03end\x00" +
+	"\x04🔙\nBACK arrow\x01\x04back\x00" +
+	"\x04🔛\tON! arrow\x01\x02on\x00" +
+	"\x04🔝\tTOP arrow\x01\x03top\x00" +
+	"\x04🔜\nSOON arrow\x01\x04soon\x00" +
+	"\x06☑️\x15ballot box with check\x01\x15ballot_box_with_check\x00" +
+	"\x04🔘\fradio button\x01\fradio_button\x00" +
+	"\x06⚪️\fwhite circle\x01\fwhite_circle\x00" +
+	"\x06⚫️\fblack circle\x01\fblack_circle\x00" +
+	"\x04🔴\nred circle\x01\nred_circle\x00" +
+	"\x04🔵\vblue circle\x01\x11large_blue_circle\x00" +
+	"\x04🔺\x17red triangle pointed up\x01\x12small_red_triangle\x00" +
+	"\x04🔻\x19red triangle pointed down\x01\x17small_red_triangle_down\x00" +
+	"\x04🔸\x14small orange diamond\x01\x14small_orange_diamond\x00" +
+	"\x04🔹\x12small blue diamond\x01\x12small_blue_diamond\x00" +
+	"\x04🔶\x14large orange diamond\x01\x14large_orange_diamond\x00" +
+	"\x04🔷\x12large blue diamond\x01\x12large_blue_diamond\x00" +
+	"\x04🔳\x13white square button\x01\x13white_square_button\x00" +
+	"\x04🔲\x13black square button\x01\x13black_square_button\x00" +
+	"\x06▪️\x12black small square\x01\x12black_small_square\x00" +
+	"\x06▫️\x12white small square\x01\x12white_small_square\x00" +
+	"\x06◾️\x19black medium-small square\x01\x19black_medium_small_square\x00" +
+	"\x06◽️\x19white medium-small square\x01\x19white_medium_small_square\x00" +
+	"\x06◼️\x13black medium square\x01\x13black_medium_square\x00" +
+	"\x06◻️\x13white medium square\x01\x13white_medium_square\x00" +
+	"\x06⬛️\x12black large square\x01\x12black_large_square\x00" +
+	"\x06⬜️\x12white large square\x01\x12white_large_square\x00" +
+	"\x04🔇\rmuted speaker\x01\x04mute\x00" +
+	"\x04🔈\x12speaker low volume\x01\aspeaker\x00" +
+	"\x04🔉\x15speaker medium volume\x01\x05sound\x00" +
+	"\x04🔊\x13speaker high volume\x01\nloud_sound\x00" +
+	"\x04🔕\x0fbell with slash\x01\ano_bell\x00" +
+	"\x04🔔\x04bell\x01\x04bell\x00" +
+	"\x04📣\tmegaphone\x01\x04mega\x00" +
+	"\x04📢\vloudspeaker\x01\vloudspeaker\x00" +
+	"\v👁\u200d🗨\x14eye in speech bubble\x01\x11eye_speech_bubble\x00" +
+	"\x04💬\x0espeech balloon\x01\x0espeech_balloon\x00" +
+	"\x04💭\x0fthought balloon\x01\x0fthought_balloon\x00" +
+	"\x04🗯\x12right anger bubble\x01\x12right_anger_bubble\x00" +
+	"\x04🃏\x05joker\x01\vblack_joker\x00" +
+	"\a🀄️\x12mahjong red dragon\x01\amahjong\x00" +
+	"\x04🎴\x14flower playing cards\x01\x14flower_playing_cards\x00" +
+	"\x06♠️\nspade suit\x01\x06spades\x00" +
+	"\x06♣️\tclub suit\x01\x05clubs\x00" +
+	"\x06♥️\nheart suit\x01\x06hearts\x00" +
+	"\x06♦️\fdiamond suit\x01\bdiamonds\x00" +
+	"\x04🕐\rone o’clock\x01\x06clock1\x00" +
+	"\x04🕑\rtwo o’clock\x01\x06clock2\x00" +
+	"\x04🕒\x0fthree o’clock\x01\x06clock3\x00" +
+	"\x04🕓\x0efour o’clock\x01\x06clock4\x00" +
+	"\x04🕔\x0efive o’clock\x01\x06clock5\x00" +
+	"\x04🕕\rsix o’clock\x01\x06clock6\x00" +
+	"\x04🕖\x0fseven o’clock\x01\x06clock7\x00" +
+	"\x04🕗\x0feight o’clock\x01\x06clock8\x00" +
+	"\x04🕘\x0enine o’clock\x01\x06clock9\x00" +
+	"\x04🕙\rten o’clock\x01\aclock10\x00" +
+	"\x04🕚\x10eleven o’clock\x01\aclock11\x00" +
+	"\x04🕛\x10twelve o’clock\x01\aclock12\x00" +
+	"\x04🕜\none-thirty\x01\bclock130\x00" +
+	"\x04🕝\ntwo-thirty\x01\bclock230\x00" +
+	"\x04🕞\fthree-thirty\x01\bclock330\x00" +
+	"\x04🕟\vfour-thirty\x01\bclock430\x00" +
+	"\x04🕠\vfive-thirty\x01\bclock530\x00" +
+	"\x04🕡\nsix-thirty\x01\bclock630\x00" +
+	"\x04🕢\fseven-thirty\x01\bclock730\x00" +
+	"\x04🕣\feight-thirty\x01\bclock830\x00" +
+	"\x04🕤\vnine-thirty\x01\bclock930\x00" +
+	"\x04🕥\nten-thirty\x01\tclock1030\x00" +
+	"\x04🕦\releven-thirty\x01\tclock1130\x00" +
+	"\x04🕧\rtwelve-thirty\x01\tclock1230\x00" +
+	"\b🇦🇫\vAfghanistan\x01\vafghanistan\x00" +
+	"\b🇦🇽\x0eÅland Islands\x01\raland_islands\x00" +
+	"\b🇦🇱\aAlbania\x01\aalbania\x00" +
+	"\b🇩🇿\aAlgeria\x01\aalgeria\x00" +
+	"\b🇦🇸\x0eAmerican Samoa\x01\x0eamerican_samoa\x00" +
+	"\b🇦🇩\aAndorra\x01\aandorra\x00" +
+	"\b🇦🇴\x06Angola\x01\x06angola\x00" +
+	"\b🇦🇮\bAnguilla\x01\banguilla\x00" +
+	"\b🇦🇶\nAntarctica\x01\nantarctica\x00" +
+	"\b🇦🇬\x11Antigua & Barbuda\x01\x0fantigua_barbuda\x00" +
+	"\b🇦🇷\tArgentina\x01\targentina\x00" +
+	"\b🇦🇲\aArmenia\x01\aarmenia\x00" +
+	"\b🇦🇼\x05Aruba\x01\x05aruba\x00" +
+	"\b🇦🇺\tAustralia\x01\taustralia\x00" +
+	"\b🇦🇹\aAustria\x01\aaustria\x00" +
+	"\b🇦🇿\nAzerbaijan\x01\nazerbaijan\x00" +
+	"\b🇧🇸\aBahamas\x01\abahamas\x00" +
+	"\b🇧🇭\aBahrain\x01\abahrain\x00" +
+	"\b🇧🇩\nBangladesh\x01\nbangladesh\x00" +
+	"\b🇧🇧\bBarbados\x01\bbarbados\x00" +
+	"\b🇧🇾\aBelarus\x01\abelarus\x00" +
+	"\b🇧🇪\aBelgium\x01\abelgium\x00" +
+	"\b🇧🇿\x06Belize\x01\x06belize\x00" +
+	"\b🇧🇯\x05Benin\x01\x05benin\x00" +
+	"\b🇧🇲\aBermuda\x01\abermuda\x00" +
+	"\b🇧🇹\x06Bhutan\x01\x06bhutan\x00" +
+	"\b🇧🇴\aBolivia\x01\abolivia\x00" +
+	"\b🇧🇶\x15Caribbean Netherlands\x01\x15caribbean_netherlands\x00" +
+	"\b🇧🇦\x14Bosnia & Herzegovina\x01\x12bosnia_herzegovina\x00" +
+	"\b🇧🇼\bBotswana\x01\bbotswana\x00" +
+	"\b🇧🇷\x06Brazil\x01\x06brazil\x00" +
+	"\b🇮🇴\x1eBritish Indian Ocean Territory\x01\x1ebritish_indian_ocean_territory\x00" +
+	"\b🇻🇬\x16British Virgin Islands\x01\x16british_virgin_islands\x00" +
+	"\b🇧🇳\x06Brunei\x01\x06brunei\x00" +
+	"\b🇧🇬\bBulgaria\x01\bbulgaria\x00" +
+	"\b🇧🇫\fBurkina Faso\x01\fburkina_faso\x00" +
+	"\b🇧🇮\aBurundi\x01\aburundi\x00" +
+	"\b🇨🇻\nCape Verde\x01\ncape_verde\x00" +
+	"\b🇰🇭\bCambodia\x01\bcambodia\x00" +
+	"\b🇨🇲\bCameroon\x01\bcameroon\x00" +
+	"\b🇨🇦\x06Canada\x01\x06canada\x00" +
+	"\b🇮🇨\x0eCanary Islands\x01\x0ecanary_islands\x00" +
+	"\b🇰🇾\x0eCayman Islands\x01\x0ecayman_islands\x00" +
+	"\b🇨🇫\x18Central African Republic\x01\x18central_african_republic\x00" +
+	"\b🇹🇩\x04Chad\x01\x04chad\x00" +
+	"\b🇨🇱\x05Chile\x01\x05chile\x00" +
+	"\b🇨🇳\x05China\x01\x02cn\x00" +
+	"\b🇨🇽\x10Christmas Island\x01\x10christmas_island\x00" +
+	"\b🇨🇨\x17Cocos (Keeling) Islands\x01\rcocos_islands\x00" +
+	"\b🇨🇴\bColombia\x01\bcolombia\x00" +
+	"\b🇰🇲\aComoros\x01\acomoros\x00" +
+	"\b🇨🇬\x13Congo - Brazzaville\x01\x11congo_brazzaville\x00" +
+	"\b🇨🇩\x10Congo - Kinshasa\x01\x0econgo_kinshasa\x00" +
+	"\b🇨🇰\fCook Islands\x01\fcook_islands\x00" +
+	"\b🇨🇷\nCosta Rica\x01\ncosta_rica\x00" +
+	"\b🇭🇷\aCroatia\x01\acroatia\x00" +
+	"\b🇨🇺\x04Cuba\x01\x04cuba\x00" +
+	"\b🇨🇼\bCuraçao\x01\acuracao\x00" +
+	"\b🇨🇾\x06Cyprus\x01\x06cyprus\x00" +
+	"\b🇨🇿\x0eCzech Republic\x01\x0eczech_republic\x00" +
+	"\b🇩🇰\aDenmark\x01\adenmark\x00" +
+	"\b🇩🇯\bDjibouti\x01\bdjibouti\x00" +
+	"\b🇩🇲\bDominica\x01\bdominica\x00" +
+	"\b🇩🇴\x12Dominican Republic\x01\x12dominican_republic\x00" +
+	"\b🇪🇨\aEcuador\x01\aecuador\x00" +
+	"\b🇪🇬\x05Egypt\x01\x05egypt\x00" +
+	"\b🇸🇻\vEl Salvador\x01\vel_salvador\x00" +
+	"\b🇬🇶\x11Equatorial Guinea\x01\x11equatorial_guinea\x00" +
+	"\b🇪🇷\aEritrea\x01\aeritrea\x00" +
+	"\b🇪🇪\aEstonia\x01\aestonia\x00" +
+	"\b🇪🇹\bEthiopia\x01\bethiopia\x00" +
+	"\b🇪🇺\x0eEuropean Union\x02\x02eu\x0eeuropean_union\x00" +
+	"\b🇫🇰\x10Falkland Islands\x01\x10falkland_islands\x00" +
+	"\b🇫🇴\rFaroe Islands\x01\rfaroe_islands\x00" +
+	"\b🇫🇯\x04Fiji\x01\x04fiji\x00" +
+	"\b🇫🇮\aFinland\x01\afinland\x00" +
+	"\b🇫🇷\x06France\x01\x02fr\x00" +
+	"\b🇬🇫\rFrench Guiana\x01\rfrench_guiana\x00" +
+	"\b🇵🇫\x10French Polynesia\x01\x10french_polynesia\x00" +
+	"\b🇹🇫\x1bFrench Southern Territories\x01\x1bfrench_southern_territories\x00" +
+	"\b🇬🇦\x05Gabon\x01\x05gabon\x00" +
+	"\b🇬🇲\x06Gambia\x01\x06gambia\x00" +
+	"\b🇬🇪\aGeorgia\x01\ageorgia\x00" +
+	"\b🇩🇪\aGermany\x01\x02de\x00" +
+	"\b🇬🇭\x05Ghana\x01\x05ghana\x00" +
+	"\b🇬🇮\tGibraltar\x01\tgibraltar\x00" +
+	"\b🇬🇷\x06Greece\x01\x06greece\x00" +
+	"\b🇬🇱\tGreenland\x01\tgreenland\x00" +
+	"\b🇬🇩\aGrenada\x01\agrenada\x00" +
+	"\b🇬🇵\nGuadeloupe\x01\nguadeloupe\x00" +
+	"\b🇬🇺\x04Guam\x01\x04guam\x00" +
+	"\b🇬🇹\tGuatemala\x01\tguatemala\x00" +
+	"\b🇬🇬\bGuernsey\x01\bguernsey\x00" +
+	"\b🇬🇳\x06Guinea\x01\x06guinea\x00" +
+	"\b🇬🇼\rGuinea-Bissau\x01\rguinea_bissau\x00" +
+	"\b🇬🇾\x06Guyana\x01\x06guyana\x00" +
+	"\b🇭🇹\x05Haiti\x01\x05haiti\x00" +
+	"\b🇭🇳\bHonduras\x01\bhonduras\x00" +
+	"\b🇭🇰\x13Hong Kong SAR China\x01\thong_kong\x00" +
+	"\b🇭🇺\aHungary\x01\ahungary\x00" +
+	"\b🇮🇸\aIceland\x01\aiceland\x00" +
+	"\b🇮🇳\x05India\x01\x05india\x00" +
+	"\b🇮🇩\tIndonesia\x01\tindonesia\x00" +
+	"\b🇮🇷\x04Iran\x01\x04iran\x00" +
+	"\b🇮🇶\x04Iraq\x01\x04iraq\x00" +
+	"\b🇮🇪\aIreland\x01\aireland\x00" +
+	"\b🇮🇲\vIsle of Man\x01\visle_of_man\x00" +
+	"\b🇮🇱\x06Israel\x01\x06israel\x00" +
+	"\b🇮🇹\x05Italy\x01\x02it\x00" +
+	"\b🇨🇮\x10Côte d’Ivoire\x01\fcote_divoire\x00" +
+	"\b🇯🇲\aJamaica\x01\ajamaica\x00" +
+	"\b🇯🇵\x05Japan\x01\x02jp\x00" +
+	"\b🇯🇪\x06Jersey\x01\x06jersey\x00" +
+	"\b🇯🇴\x06Jordan\x01\x06jordan\x00" +
+	"\b🇰🇿\nKazakhstan\x01\nkazakhstan\x00" +
+	"\b🇰🇪\x05Kenya\x01\x05kenya\x00" +
+	"\b🇰🇮\bKiribati\x01\bkiribati\x00" +
+	"\b🇽🇰\x06Kosovo\x01\x06kosovo\x00" +
+	"\b🇰🇼\x06Kuwait\x01\x06kuwait\x00" +
+	"\b🇰🇬\nKyrgyzstan\x01\nkyrgyzstan\x00" +
+	"\b🇱🇦\x04Laos\x01\x04laos\x00" +
+	"\b🇱🇻\x06Latvia\x01\x06latvia\x00" +
+	"\b🇱🇧\aLebanon\x01\alebanon\x00" +
+	"\b🇱🇸\aLesotho\x01\alesotho\x00" +
+	"\b🇱🇷\aLiberia\x01\aliberia\x00" +
+	"\b🇱🇾\x05Libya\x01\x05libya\x00" +
+	"\b🇱🇮\rLiechtenstein\x01\rliechtenstein\x00" +
+	"\b🇱🇹\tLithuania\x01\tlithuania\x00" +
+	"\b🇱🇺\nLuxembourg\x01\nluxembourg\x00" +
+	"\b🇲🇴\x0fMacau SAR China\x01\x05macau\x00" +
+	"\b🇲🇰\tMacedonia\x01\tmacedonia\x00" +
+	"\b🇲🇬\nMadagascar\x01\nmadagascar\x00" +
+	"\b🇲🇼\x06Malawi\x01\x06malawi\x00" +
+	"\b🇲🇾\bMalaysia\x01\bmalaysia\x00" +
+	"\b🇲🇻\bMaldives\x01\bmaldives\x00" +
+	"\b🇲🇱\x04Mali\x01\x04mali\x00" +
+	"\b🇲🇹\x05Malta\x01\x05malta\x00" +
+	"\b🇲🇭\x10Marshall Islands\x01\x10marshall_islands\x00" +
+	"\b🇲🇶\nMartinique\x01\nmartinique\x00" +
+	"\b🇲🇷\nMauritania\x01\nmauritania\x00" +
+	"\b🇲🇺\tMauritius\x01\tmauritius\x00" +
+	"\b🇾🇹\aMayotte\x01\amayotte\x00" +
+	"\b🇲🇽\x06Mexico\x01\x06mexico\x00" +
+	"\b🇫🇲\nMicronesia\x01\nmicronesia\x00" +
+	"\b🇲🇩\aMoldova\x01\amoldova\x00" +
+	"\b🇲🇨\x06Monaco\x01\x06monaco\x00" +
+	"\b🇲🇳\bMongolia\x01\bmongolia\x00" +
+	"\b🇲🇪\nMontenegro\x01\nmontenegro\x00" +
+	"\b🇲🇸\nMontserrat\x01\nmontserrat\x00" +
+	"\b🇲🇦\aMorocco\x01\amorocco\x00" +
+	"\b🇲🇿\nMozambique\x01\nmozambique\x00" +
+	"\b🇲🇲\x0fMyanmar (Burma)\x01\amyanmar\x00" +
+	"\b🇳🇦\aNamibia\x01\anamibia\x00" +
+	"\b🇳🇷\x05Nauru\x01\x05nauru\x00" +
+	"\b🇳🇵\x05Nepal\x01\x05nepal\x00" +
+	"\b🇳🇱\vNetherlands\x01\vnetherlands\x00" +
+	"\b🇳🇨\rNew Caledonia\x01\rnew_caledonia\x00" +
+	"\b🇳🇿\vNew Zealand\x01\vnew_zealand\x00" +
+	"\b🇳🇮\tNicaragua\x01\tnicaragua\x00" +
+	"\b🇳🇪\x05Niger\x01\x05niger\x00" +
+	"\b🇳🇬\aNigeria\x01\anigeria\x00" +
+	"\b🇳🇺\x04Niue\x01\x04niue\x00" +
+	"\b🇳🇫\x0eNorfolk Island\x01\x0enorfolk_island\x00" +
+	"\b🇲🇵\x18Northern Mariana Islands\x01\x18northern_mariana_islands\x00" +
+	"\b🇰🇵\vNorth Korea\x01\vnorth_korea\x00" +
+	"\b🇳🇴\x06Norway\x01\x06norway\x00" +
+	"\b🇴🇲\x04Oman\x01\x04oman\x00" +
+	"\b🇵🇰\bPakistan\x01\bpakistan\x00" +
+	"\b🇵🇼\x05Palau\x01\x05palau\x00" +
+	"\b🇵🇸\x17Palestinian Territories\x01\x17palestinian_territories\x00" +
+	"\b🇵🇦\x06Panama\x01\x06panama\x00" +
+	"\b🇵🇬\x10Papua New Guinea\x01\x10papua_new_guinea\x00" +
+	"\b🇵🇾\bParaguay\x01\bparaguay\x00" +
+	"\b🇵🇪\x04Peru\x01\x04peru\x00" +
+	"\b🇵🇭\vPhilippines\x01\vphilippines\x00" +
+	"\b🇵🇳\x10Pitcairn Islands\x01\x10pitcairn_islands\x00" +
+	"\b🇵🇱\x06Poland\x01\x06poland\x00" +
+	"\b🇵🇹\bPortugal\x01\bportugal\x00" +
+	"\b🇵🇷\vPuerto Rico\x01\vpuerto_rico\x00" +
+	"\b🇶🇦\x05Qatar\x01\x05qatar\x00" +
+	"\b🇷🇪\bRéunion\x01\areunion\x00" +
+	"\b🇷🇴\aRomania\x01\aromania\x00" +
+	"\b🇷🇺\x06Russia\x01\x02ru\x00" +
+	"\b🇷🇼\x06Rwanda\x01\x06rwanda\x00" +
+	"\b🇧🇱\x0fSt. Barthélemy\x01\rst_barthelemy\x00" +
+	"\b🇸🇭\nSt. Helena\x01\tst_helena\x00" +
+	"\b🇰🇳\x11St. Kitts & Nevis\x01\x0est_kitts_nevis\x00" +
+	"\b🇱🇨\tSt. Lucia\x01\bst_lucia\x00" +
+	"\b🇵🇲\x15St. Pierre & Miquelon\x01\x12st_pierre_miquelon\x00" +
+	"\b🇻🇨\x18St. Vincent & Grenadines\x01\x15st_vincent_grenadines\x00" +
+	"\b🇼🇸\x05Samoa\x01\x05samoa\x00" +
+	"\b🇸🇲\nSan Marino\x01\nsan_marino\x00" +
+	"\b🇸🇹\x16São Tomé & Príncipe\x01\x11sao_tome_principe\x00" +
+	"\b🇸🇦\fSaudi Arabia\x01\fsaudi_arabia\x00" +
+	"\b🇸🇳\aSenegal\x01\asenegal\x00" +
+	"\b🇷🇸\x06Serbia\x01\x06serbia\x00" +
+	"\b🇸🇨\nSeychelles\x01\nseychelles\x00" +
+	"\b🇸🇱\fSierra Leone\x01\fsierra_leone\x00" +
+	"\b🇸🇬\tSingapore\x01\tsingapore\x00" +
+	"\b🇸🇽\fSint Maarten\x01\fsint_maarten\x00" +
+	"\b🇸🇰\bSlovakia\x01\bslovakia\x00" +
+	"\b🇸🇮\bSlovenia\x01\bslovenia\x00" +
+	"\b🇸🇧\x0fSolomon Islands\x01\x0fsolomon_islands\x00" +
+	"\b🇸🇴\aSomalia\x01\asomalia\x00" +
+	"\b🇿🇦\fSouth Africa\x01\fsouth_africa\x00" +
+	"\b🇬🇸&South Georgia & South Sandwich Islands\x01$south_georgia_south_sandwich_islands\x00" +
+	"\b🇰🇷\vSouth Korea\x01\x02kr\x00" +
+	"\b🇸🇸\vSouth Sudan\x01\vsouth_sudan\x00" +
+	"\b🇪🇸\x05Spain\x01\x02es\x00" +
+	"\b🇱🇰\tSri Lanka\x01\tsri_lanka\x00" +
+	"\b🇸🇩\x05Sudan\x01\x05sudan\x00" +
+	"\b🇸🇷\bSuriname\x01\bsuriname\x00" +
+	"\b🇸🇿\tSwaziland\x01\tswaziland\x00" +
+	"\b🇸🇪\x06Sweden\x01\x06sweden\x00" +
+	"\b🇨🇭\vSwitzerland\x01\vswitzerland\x00" +
+	"\b🇸🇾\x05Syria\x01\x05syria\x00" +
+	"\b🇹🇼\x06Taiwan\x01\x06taiwan\x00" +
+	"\b🇹🇯\nTajikistan\x01\ntajikistan\x00" +
+	"\b🇹🇿\bTanzania\x01\btanzania\x00" +
+	"\b🇹🇭\bThailand\x01\bthailand\x00" +
+	"\b🇹🇱\vTimor-Leste\x01\vtimor_leste\x00" +
+	"\b🇹🇬\x04Togo\x01\x04togo\x00" +
+	"\b🇹🇰\aTokelau\x01\atokelau\x00" +
+	"\b🇹🇴\x05Tonga\x01\x05tonga\x00" +
+	"\b🇹🇹\x11Trinidad & Tobago\x01\x0ftrinidad_tobago\x00" +
+	"\b🇹🇳\aTunisia\x01\atunisia\x00" +
+	"\b🇹🇷\x06Turkey\x01\x02tr\x00" +
+	"\b🇹🇲\fTurkmenistan\x01\fturkmenistan\x00" +
+	"\b🇹🇨\x16Turks & Caicos Islands\x01\x14turks_caicos_islands\x00" +
+	"\b🇹🇻\x06Tuvalu\x01\x06tuvalu\x00" +
+	"\b🇺🇬\x06Uganda\x01\x06uganda\x00" +
+	"\b🇺🇦\aUkraine\x01\aukraine\x00" +
+	"\b🇦🇪\x14United Arab Emirates\x01\x14united_arab_emirates\x00" +
+	"\b🇬🇧\x0eUnited Kingdom\x02\x02gb\x02uk\x00" +
+	"\b🇺🇸\rUnited States\x01\x02us\x00" +
+	"\b🇻🇮\x13U.S. Virgin Islands\x01\x11us_virgin_islands\x00" +
+	"\b🇺🇾\aUruguay\x01\auruguay\x00" +
+	"\b🇺🇿\nUzbekistan\x01\nuzbekistan\x00" +
+	"\b🇻🇺\aVanuatu\x01\avanuatu\x00" +
+	"\b🇻🇦\fVatican City\x01\fvatican_city\x00" +
+	"\b🇻🇪\tVenezuela\x01\tvenezuela\x00" +
+	"\b🇻🇳\aVietnam\x01\avietnam\x00" +
+	"\b🇼🇫\x0fWallis & Futuna\x01\rwallis_futuna\x00" +
+	"\b🇪🇭\x0eWestern Sahara\x01\x0ewestern_sahara\x00" +
+	"\b🇾🇪\x05Yemen\x01\x05yemen\x00" +
+	"\b🇿🇲\x06Zambia\x01\x06zambia\x00" +
+	"\b🇿🇼\bZimbabwe\x01\bzimbabwe\x00" +
+	"\b" +
+	"\bActivity@\xc0\x03\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01" +
+	"\x05Flags\xf7\x01\xb2\b\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01" +
+	"\x05FoodsC\xfd\x02\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01" +
+	"\x06Nature\x93\x01\xea\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01" +
+	"\aObjects\xb2\x01\xf3\x04\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01" +
+	"\x06People\xea\x01\x00\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01" +
+	"\x06Placess\x80\x04\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01" +
+	"\aSymbols\x8d\x02\xa5\x06\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01" +
+	"\x8c\x03" +
+	"\x03911\x01\x8f\x04" +
+	"\raccessibility\x01\x97\a" +
+	"\aairport\x01\x95\a" +
+	"\balphabet\x01\xa4\a" +
+	"\x06amazed\x01>" +
+	"\aamerica\x01\x9c\n" +
+	"\x05angel\x01\b" +
+	"\x05angry\x03' \xa7\x06" +
+	"\fannouncement\x01\x8e\b" +
+	"\aannoyed\x01&" +
+	"\bapplause\x01Z" +
+	"\aapprove\x01[" +
+	"\aarchery\x01\xcd\x03" +
+	"\x06attack\x01]" +
+	"\taubergine\x01\x8b\x03" +
+	"\x06autumn\x01\xbf\x02" +
+	"\x05award\x01\xeb\x03" +
+	"\x03bag\x02\xe2\x01\x02" +
+	"\x04bags\x01\xd2\x05" +
+	"\x04bang\x01\xf6\x06" +
+	"\x04bath\x01\xc6\x05" +
+	"\abawling\x01<" +
+	"\x05beach\x02\xd2\x01z" +
+	"\x0ebeach_umbrella\x01\xd0\x05" +
+	"\x06beauty\x02p0" +
+	"\x05bicep\x01j" +
+	"\abicycle\x01\x8e\x04" +
+	"\tbilliards\x01\xc7\x03" +
+	"\bbirthday\x02\xd3\x05\x01" +
+	"\x05blind\x01\xfa\x01" +
+	"\x05block\x01\xeb\x06" +
+	"\x06blonde\x01\x8f\x01" +
+	"\x04blow\x01\xf5\x02" +
+	"\x05blush\x01\r" +
+	"\x04boom\x01\xb2\x05" +
+	"\x06bottle\x01\xb9\x03" +
+	"\x03boy\x01\x80\x01" +
+	"\tbreakfast\x02\x95\x03&" +
+	"\abritish\x01\x9b\n" +
+	"\x06bubbly\x01\xb9\x03" +
+	"\x03bug\x01\x8b\x02" +
+	"\x05bunny\x03\xa5\x01\x01H" +
+	"\x06burger\x01\x96\x03" +
+	"\x05burma\x01\xc9\t" +
+	"\x04burn\x01\xee\x02" +
+	"\x04bury\x01\\" +
+	"\bbusiness\x01\xe5\x01" +
+	"\x04cafe\x01\xbc\x03" +
+	"\bcalendar\x01\xf6\x05" +
+	"\x04call\x02\xf5\x04\x14" +
+	"\acamping\x01\xc7\x04" +
+	"\x06canada\x01\xc0\x02" +
+	"\vcelebration\x02\xb9\x03\x9f\x01" +
+	"\achicken\x01\x92\x03" +
+	"\x05child\x04z\x01\x018" +
+	"\x05china\x01\xe0\b" +
+	"\nchocolates\x01\xb2\x06" +
+	"\x04chop\x01\xb3\x05" +
+	"\tchristmas\x03\x8e\x01\xe4\x01\xe1\x02" +
+	"\tcigarette\x01\xb7\x05" +
+	"\x06classy\x01\xdd\x01" +
+	"\x05cloud\x01\xe6\x02" +
+	"\x04cold\x01\xf0\x02" +
+	"\acollege\x01\xde\x01" +
+	"\acomment\x01\x90\b" +
+	"\bconfused\x01\xf8\x06" +
+	"\aconsole\x01\xfc\x03" +
+	"\acontest\x01\xeb\x03" +
+	"\ncontroller\x01\xfc\x03" +
+	"\x04cool\x01\x1a" +
+	"\x06couple\x02\xac\x01\x01" +
+	"\x04crap\x01E" +
+	"\x05cream\x01\xa5\x05" +
+	"\x05crown\x01\x8f\x01" +
+	"\x06cruise\x01\xac\x04" +
+	"\x05crush\x01\x10" +
+	"\x03cry\x01<" +
+	"\x03cut\x02\xb3\x05\\" +
+	"\acutlery\x01\xbe\x03" +
+	"\x03dad\x01}" +
+	"\x06danger\x02K\x01" +
+	"\x04date\x03\xab\x01\x01\x01" +
+	"\x04dead\x01K" +
+	"\x04deaf\x01\xfb\x01" +
+	"\x06desert\x01\x9e\x02" +
+	"\x06design\x01\xf1\x03" +
+	"\adesktop\x01\xf6\x04" +
+	"\adessert\x01\xab\x03" +
+	"\x05devil\x02F\x01" +
+	"\adiamond\x01\xa7\x05" +
+	"\x04dice\x01\xfe\x03" +
+	"\x06dining\x01\xbf\x03" +
+	"\x06dinner\x01\xbf\x03" +
+	"\tdirectory\x01\xfc\x05" +
+	"\ndisapprove\x01\\" +
+	"\bdocument\x03\xec\x05\x02/" +
+	"\x03dog\x01\xac\x02" +
+	"\x06dollar\x02\xa0\x05\x05" +
+	"\x05drama\x01\xf0\x03" +
+	"\x05dress\x01\xa4\x01" +
+	"\x05drink\x02\xb4\x03\x03" +
+	"\x06drinks\x01\xb5\x03" +
+	"\tearphones\x01\xf5\x03" +
+	"\teducation\x01\xde\x01" +
+	"\x05email\x01\xe0\x05" +
+	"\temergency\x02\x8f\x04\xd9\x02" +
+	"\aengaged\x01\xe8\x01" +
+	"\benvelope\x01\xe0\x05" +
+	"\venvironment\x01\x85\a" +
+	"\bespresso\x01\xbc\x03" +
+	"\x04evil\x02F\x01" +
+	"\bexercise\x02\xa9\x01\x01" +
+	"\aexplode\x01\xef\x02" +
+	"\x04fast\x01\xf5\x02" +
+	"\x06father\x01}" +
+	"\x04feet\x01\xd6\x01" +
+	"\bfestival\x01\xd8\x04" +
+	"\x04film\x03\xf3\x03\x93\x01\x9b\x02" +
+	"\x06finish\x01\x96\x06" +
+	"\x04flag\x03\x85\t\x96\x01\x01" +
+	"\x04flex\x01j" +
+	"\x06flight\x02\xa4\x04\x01" +
+	"\x05flirt\x02\t\b" +
+	"\x06flower\x03\xc4\x02\x01\x02" +
+	"\aflowers\x01\xc8\x02" +
+	"\tforbidden\x01\xeb\x06" +
+	"\x06formal\x01\xd0\x01" +
+	"\afortune\x01\xbb\x05" +
+	"\x06france\x01\xfe\b" +
+	"\x06french\x01\xfe\b" +
+	"\x05fresh\x01\xad\a" +
+	"\x05fruit\x04\xfd\x02\x05\x03\x02" +
+	"\afuneral\x01\xb8\x05" +
+	"\bgambling\x01\xfe\x03" +
+	"\x04game\x01N" +
+	"\x04gasp\x01>" +
+	"\x04geek\x01\x19" +
+	"\agermany\x01\x85\t" +
+	"\x05girls\x01~" +
+	"\aglasses\x02\x19\xcd\x01" +
+	"\x06global\x01\x8c\a" +
+	"\x05globe\x03\xce\x02\x01\x01" +
+	"\x04gold\x01\xe9\x03" +
+	"\agoodbye\x01_" +
+	"\ngraduation\x01\xde\x01" +
+	"\x05graph\x02\xf1\x05\x01" +
+	"\x05green\x02\xbb\x03\xca\x03" +
+	"\x05group\x01y" +
+	"\x03gym\x02\xd4\x03\x01" +
+	"\x04haha\x02\x04\x03" +
+	"\thalloween\x02J\x81\x02" +
+	"\x04halt\x02\x96\x01\x01" +
+	"\x05happy\x04\x00\x04\x01\x02" +
+	"\x03hat\x01\xdd\x01" +
+	"\x06health\x02\xc2\x05\x01" +
+	"\x04hear\x01s" +
+	"\x05heart\x01\xb1\x06" +
+	"\x06helmet\x01\x89\x01" +
+	"\x04help\x01\xe8\x06" +
+	"\bhighfive\x01g" +
+	"\x04home\x01\xb4\x01" +
+	"\x06hooray\x01Y" +
+	"\x04hope\x01k" +
+	"\x05horns\x02F\x01" +
+	"\x06horror\x022$" +
+	"\bhospital\x01\xc3\x05" +
+	"\x03hot\x01\x06" +
+	"\x04hurt\x01B" +
+	"\x04hush\x02?\xbd\x01" +
+	"\x04idea\x01\x9b\x05" +
+	"\x06ignore\x01\xfa\x01" +
+	"\x03ill\x01@" +
+	"\timpressed\x011" +
+	"\bincoming\x01\xf5\x04" +
+	"\vinformation\x01\x95\x01" +
+	"\rinternational\x04\xce\x02\x01\x01\xbc\x04" +
+	"\vinvestigate\x01\xc0\x05" +
+	"\x05italy\x01\x9e\t" +
+	"\x05ivory\x01\x9f\t" +
+	"\x05japan\x01\xa1\t" +
+	"\x03joy\x02\x04\x01" +
+	"\x04karl\x01\xbc\x04" +
+	"\akeeling\x01\xe2\b" +
+	"\x04king\x01\xdf\x01" +
+	"\x04kiss\x01q" +
+	"\x05korea\x01\x80\n" +
+	"\nlaboratory\x01\xc0\x05" +
+	"\x06launch\x01\xad\x04" +
+	"\x03law\x01\x87\x01" +
+	"\x04leaf\x01\xbe\x02" +
+	"\x06letter\x01\xdc\x05" +
+	"\aletters\x01\xa6\a" +
+	"\alibrary\x01\x88\x06" +
+	"\x04lick\x01\x0e" +
+	"\x05light\x01\x9b\x05" +
+	"\tlightning\x01\xed\x02" +
+	"\x05limit\x01\xe9\x06" +
+	"\blipstick\x01\xd5\x01" +
+	"\x06listen\x01s" +
+	"\blocation\x02\x90\x06\x01" +
+	"\x04lock\x01\xc9\x05" +
+	"\x04look\x01v" +
+	"\x04loop\x01\xda\a" +
+	"\x04love\x03\x10\x95\x06\f" +
+	"\x04luck\x01\xbb\x02" +
+	"\x03mad\x01&" +
+	"\x06makeup\x01\xd4\x01" +
+	"\bmanicure\x01p" +
+	"\bmarathon\x03\xa9\x01\x01\xbe\x02" +
+	"\bmarriage\x03\x90\x01X\x84\x03" +
+	"\x04meat\x01\x92\x03" +
+	"\bmedicine\x01\xc2\x05" +
+	"\x03meh\x02\x1e\x02" +
+	"\ametrics\x03\xf0\x05\x01\x01" +
+	"\tmilestone\x01\x96\x06" +
+	"\x04milk\x01\xbd\x03" +
+	"\x06mobile\x01\xf4\x04" +
+	"\x05money\x01\xa1\x05" +
+	"\x06monkey\x03\xfa\x01\x01\x01" +
+	"\amonster\x01H" +
+	"\amorning\x01\x94\x05" +
+	"\x05movie\x01\xa1\a" +
+	"\x05music\x02\xf5\x03\xea\x03" +
+	"\bmustache\x01}" +
+	"\x04mute\x03\x1d\xdf\x01\xd6\x04" +
+	"\x06needle\x01\xc3\x05" +
+	"\anervous\x03%\x0f\x05" +
+	"\anewborn\x01z" +
+	"\x05night\x01\xde\x02" +
+	"\x06noodle\x01\x9d\x03" +
+	"\x04note\x01\x9d\x06" +
+	"\fnotification\x01\x8c\b" +
+	"\x06number\x01\xbb\a" +
+	"\anumbers\x01\xba\a" +
+	"\x03off\x02\xd2\x06\xb9\x01" +
+	"\x02ok\x01[" +
+	"\x04oops\x013" +
+	"\x05orbit\x01\xae\x04" +
+	"\x05paint\x01\xf1\x03" +
+	"\x05pants\x01\xcf\x01" +
+	"\aparents\x01\xb4\x01" +
+	"\x05party\x03\xac\x03\xa8\x02\x04" +
+	"\bpassword\x01\xc9\x05" +
+	"\x05pasta\x01\x9a\x03" +
+	"\x05peace\x02f\xc4\x01" +
+	"\aperfect\x01\xfc\x06" +
+	"\x03pet\x03\xea\x01\x01\x02" +
+	"\x04phew\x019" +
+	"\x05phone\x01\x89\x05" +
+	"\x05photo\x02\x83\x05\x01" +
+	"\x05piano\x01\xf7\x03" +
+	"\x06pirate\x01L" +
+	"\x05plant\x01\xb8\x02" +
+	"\x04play\x01\xfc\x03" +
+	"\x06please\x01k" +
+	"\apleased\x02\x05\b" +
+	"\apodcast\x02\x8e\x05\x01" +
+	"\x06poison\x01K" +
+	"\x06police\x01\x87\x01" +
+	"\x04pool\x01\xc7\x03" +
+	"\x05power\x02^\xbb\x04" +
+	"\x06praise\x01Z" +
+	"\x05prank\x02\x15\x01" +
+	"\apresent\x01\xd3\x05" +
+	"\x05press\x02\xff\x05\x01" +
+	"\x05pride\x01\x97\x06" +
+	"\aprivate\x01\xa1\x06" +
+	"\aprosper\x01l" +
+	"\x05proud\x01\n" +
+	"\x05queen\x01\xdf\x01" +
+	"\x04rain\x02\xe9\x01\x90\x01" +
+	"\arespect\x02\x92\x01\x01" +
+	"\brestroom\x01\x99\a" +
+	"\x05retro\x01N" +
+	"\x06return\x01\xd6\a" +
+	"\x04rich\x01\x18" +
+	"\x04rock\x01\xfa\x03" +
+	"\x05royal\x02\x8f\x01P" +
+	"\arunning\x01\xdb\x01" +
+	"\x06russia\x01\xe8\t" +
+	"\x03sad\x05$\x14\x04\x1bG" +
+	"\x04save\x01\xff\x04" +
+	"\x06scared\x013" +
+	"\bschedule\x02\xf5\x05\x01" +
+	"\ascience\x01\xc0\x05" +
+	"\x05score\x01\xfc\x06" +
+	"\x06screen\x01\xf6\x04" +
+	"\x03sea\x03\x96\x0260" +
+	"\x06search\x01\xa3\x06" +
+	"\bsecurity\x03\xa0\x06\x01\x01" +
+	"\x03see\x01v" +
+	"\tsemaphore\x01\xb4\x04" +
+	"\x05shiny\x01\xe2\x02" +
+	"\x04ship\x03\xaa\x04\x03\x03" +
+	"\bshipping\x01\xe3\x05" +
+	"\x05shirt\x01\xd0\x01" +
+	"\ashocked\x022\x01" +
+	"\x04shoe\x02\xd7\x01\x01" +
+	"\x05shoot\x01\xb1\x05" +
+	"\x06shower\x01\xe5\x03" +
+	"\ashuffle\x01\xd9\a" +
+	"\x04sick\x02@\x01" +
+	"\x06signal\x01\x98\x05" +
+	"\asilence\x03\x1d\x18\n" +
+	"\x05silly\x01\x15" +
+	"\x04sing\x01\xf4\x03" +
+	"\askating\x01\xd0\x03" +
+	"\askyline\x01\xd2\x04" +
+	"\bsleeping\x01D" +
+	"\x06sleuth\x02\x8c\x01\x01" +
+	"\x04slow\x02\x8a\x02\a" +
+	"\nsmartphone\x01\xf4\x04" +
+	"\x05smell\x01t" +
+	"\x05smile\x01\x00" +
+	"\x04smug\x02\x1c\x14" +
+	"\asneaker\x01\xdb\x01" +
+	"\x05sound\x03s\x94\a\x05" +
+	"\x03spa\x02\xa2\x01\x01" +
+	"\x05space\x01\xae\x04" +
+	"\x05spain\x01\x82\n" +
+	"\nspeechless\x015" +
+	"\x05speed\x01\xa4\x02" +
+	"\x05spicy\x01\x8c\x03" +
+	"\x05spock\x01l" +
+	"\x05sport\x01\xdb\x01" +
+	"\x06sports\x05\xc0\x03\x01\x01\x01\x01" +
+	"\x06spring\x01\xc7\x02" +
+	"\x04star\x01\xe1\x02" +
+	"\x05stats\x01\xf0\x05" +
+	"\x05stone\x01\xd1\x05" +
+	"\x04stop\x03g/\x01" +
+	"\x06strong\x01j" +
+	"\nstruggling\x01," +
+	"\astunned\x017" +
+	"\fsubscription\x01\xa6\x05" +
+	"\x06summer\x02\xdd\x02[" +
+	"\bsurprise\x011" +
+	"\x05sweat\x019" +
+	"\x05sweet\x01\xae\x03" +
+	"\x05swirl\x01\x8f\a" +
+	"\x04sync\x01\xdc\a" +
+	"\x03tag\x01\xe4\x05" +
+	"\x06target\x01\xed\x03" +
+	"\x05taste\x01r" +
+	"\x04team\x01y" +
+	"\x04tear\x028\x1f" +
+	"\x05tears\x01\x03" +
+	"\atempura\x01\x94\x03" +
+	"\x06thanks\x02\x92\x01\x01" +
+	"\fthanksgiving\x01\xa9\x02" +
+	"\atheater\x01\xf0\x03" +
+	"\bthinking\x01\x91\b" +
+	"\athunder\x01\xed\x02" +
+	"\x04time\x03\xf3\x04#\x01" +
+	"\x05tired\x02/\v" +
+	"\x05toast\x01\x90\x03" +
+	"\x06toilet\x02\x99\a\x06" +
+	"\x06tongue\x01\x0e" +
+	"\x04tool\x02\xa9\x05\x01" +
+	"\x06tracks\x01\xd6\x01" +
+	"\ttrademark\x01\xe9\a" +
+	"\x05train\x03\x9a\x04\x01\x03" +
+	"\x05trash\x01\x9e\x05" +
+	"\x06travel\x01\xb6\x04" +
+	"\x06turkey\x01\x94\n" +
+	"\x03ufo\x01M" +
+	"\x06united\x01\x9c\n" +
+	"\nuniversity\x01\xde\x01" +
+	"\x05upset\x01." +
+	"\x04user\x01x" +
+	"\x05users\x01y" +
+	"\bvacation\x01\xb8\x03" +
+	"\avictory\x01f" +
+	"\x05video\x01\x86\x05" +
+	"\x06volume\x04\x87\b\x02\x01\x01" +
+	"\x05watch\x01v" +
+	"\x05water\x02\xfa\x02\x01" +
+	"\x02wc\x01\xc5\x05" +
+	"\x06weapon\x01\xb1\x05" +
+	"\aweather\x05\xe9\x01{\x02\n\t" +
+	"\awedding\x02\x90\x01X" +
+	"\x04whew\x01\x0f" +
+	"\x05whine\x01." +
+	"\x04wifi\x01\xa2\a" +
+	"\x04wind\x01\xf5\x02" +
+	"\x06winner\x02\xe9\x03\x02" +
+	"\x06winter\x03\xf0\x02\x02\x01" +
+	"\x03wip\x02\xb1\x04\xd1\x02" +
+	"\x04wish\x01k" +
+	"\x04wood\x02\xb5\x02\x01" +
+	"\aworkout\x06j?\x01\xd1\x01Y\x01" +
+	"\x05world\x04\xce\x02\x01\x01\xbc\x04" +
+	"\x03wow\x011" +
+	"\x03yes\x01\xaa\a" +
+	"\x04zoom\x01\xa3\x06" +
+	"\x03zzz\x01C"
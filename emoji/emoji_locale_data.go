@@ -0,0 +1,8 @@
+// THIS FILE IS GENERATED BY generate.go
+
+package emoji
+
+// localeData is the CLDR annotations for each locale, in the format read by
+// decodeLocales.
+const localeData = "" +
+	"\x00"
@@ -0,0 +1,130 @@
+package emoji
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+var defaultEmoticons = [...]struct {
+	emoticon string
+	name     string
+}{
+	{":)", ":slightly_smiling_face:"},
+	{":-)", ":slightly_smiling_face:"},
+	{"(:", ":slightly_smiling_face:"},
+	{":(", ":slightly_frowning_face:"},
+	{":-(", ":slightly_frowning_face:"},
+	{":D", ":smiley:"},
+	{":-D", ":smiley:"},
+	{"XD", ":laughing:"},
+	{";)", ":wink:"},
+	{";-)", ":wink:"},
+	{":P", ":stuck_out_tongue:"},
+	{":-P", ":stuck_out_tongue:"},
+	{":p", ":stuck_out_tongue:"},
+	{":-p", ":stuck_out_tongue:"},
+	{";P", ":stuck_out_tongue_winking_eye:"},
+	{";p", ":stuck_out_tongue_winking_eye:"},
+	{":O", ":open_mouth:"},
+	{":-O", ":open_mouth:"},
+	{":o", ":open_mouth:"},
+	{":-o", ":open_mouth:"},
+	{":|", ":neutral_face:"},
+	{":-|", ":neutral_face:"},
+	{":/", ":confused:"},
+	{":-/", ":confused:"},
+	{":'(", ":cry:"},
+	{":*", ":kissing_heart:"},
+	{":-*", ":kissing_heart:"},
+	{">:(", ":angry:"},
+	{"<3", ":heart:"},
+	{"</3", ":broken_heart:"},
+}
+
+// AddEmoticon adds an ASCII emoticon such as :) that is replaced with the
+// emoji or shortcode name. Emoticons are case-sensitive and are only replaced
+// when they are surrounded by whitespace or the start or end of the text, so
+// URLs and code are not affected. An emoticon may be followed by one of . , !
+// or ? and still be replaced.
+func (conf *Config) AddEmoticon(emoticon, name string) {
+	if emoticon == "" {
+		panic("emoji: emoticon cannot be empty string")
+	}
+	if strings.IndexFunc(emoticon, unicode.IsSpace) != -1 {
+		panic("emoji: emoticon cannot contain whitespace")
+	}
+	if _, ok := conf.emoticons[emoticon]; ok {
+		panic("emoji: emoticon already defined in this Config: " + emoticon)
+	}
+
+	conf.addEmoticon(emoticon, conf.get(name))
+}
+
+// AddDefaultEmoticons adds a set of common emoticons, such as :) and <3, to
+// the Config. Emoticons that are already defined in the Config are not
+// changed.
+func (conf *Config) AddDefaultEmoticons() {
+	for _, e := range defaultEmoticons {
+		if _, ok := conf.emoticons[e.emoticon]; !ok {
+			conf.addEmoticon(e.emoticon, conf.get(e.name))
+		}
+	}
+}
+
+func (conf *Config) addEmoticon(emoticon string, e *emoji) {
+	if conf.emoticons == nil {
+		conf.emoticons = make(map[string]*emoji)
+		conf.emoticonNames = &matcher{root: &state{}}
+	}
+	conf.emoticons[emoticon] = e
+	conf.emoticonNames = conf.emoticonNames.add(emoticon)
+}
+
+// findEmoticons adds the emoticons in text that do not overlap an existing
+// match or a URL to matches, which must be sorted.
+func (conf *Config) findEmoticons(text string, matches []emojiMatch, urls [][]int) []emojiMatch {
+	if conf.emoticonNames == nil {
+		return matches
+	}
+
+	spans := conf.emoticonNames.match(text)
+	if len(spans) == 0 {
+		return matches
+	}
+
+	result := make([]emojiMatch, 0, len(matches)+len(spans))
+	for _, span := range spans {
+		if !emoticonBoundary(text, span[0], span[1]) || overlapsAny(urls, span) {
+			continue
+		}
+		for len(matches) != 0 && matches[0].end <= span[0] {
+			result = append(result, matches[0])
+			matches = matches[1:]
+		}
+		if len(matches) != 0 && matches[0].start < span[1] {
+			continue
+		}
+		result = append(result, emojiMatch{
+			start: span[0],
+			end:   span[1],
+			e:     conf.emoticons[text[span[0]:span[1]]],
+		})
+	}
+
+	return append(result, matches...)
+}
+
+func emoticonBoundary(text string, start, end int) bool {
+	if start != 0 {
+		if r, _ := utf8.DecodeLastRuneInString(text[:start]); !unicode.IsSpace(r) {
+			return false
+		}
+	}
+	if end != len(text) {
+		if r, _ := utf8.DecodeRuneInString(text[end:]); !unicode.IsSpace(r) && !strings.ContainsRune(".,!?", r) {
+			return false
+		}
+	}
+	return true
+}
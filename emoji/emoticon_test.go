@@ -0,0 +1,108 @@
+package emoji_test
+
+import (
+	"testing"
+
+	"github.com/BenLubar/hellstew/emoji"
+)
+
+var emoticonConfig = func() *emoji.Config {
+	var conf emoji.Config
+
+	conf.AddImage("https://assets-cdn.github.com/images/icons/emoji/shipit.png", "ship it!", []string{"shipit"}, "GitHub", nil)
+	conf.AddEmoticon("(shipit)", ":shipit:")
+	conf.AddEmoticon(":)", "😀")
+	conf.AddDefaultEmoticons()
+
+	return &conf
+}()
+
+var emoticonTests = [...]replaceTest{
+	{
+		name:   "Smile",
+		input:  `Hello :)`,
+		output: `Hello <abbr class="emoji" title="grinning face">😀</abbr>`,
+	},
+	{
+		name:   "Heart",
+		input:  `<p>&lt;3 you</p>`,
+		output: `<p><abbr class="emoji" title="red heart">❤️</abbr> you</p>`,
+	},
+	{
+		name:   "Longest",
+		input:  `&lt;/3 :-( :&#39;(`,
+		output: `<abbr class="emoji" title="broken heart">💔</abbr> <abbr class="emoji" title="slightly frowning face">🙁</abbr> <abbr class="emoji" title="crying face">😢</abbr>`,
+	},
+	{
+		name:   "Punctuation",
+		input:  `Fine :P. Really ;)!`,
+		output: `Fine <abbr class="emoji" title="face with stuck-out tongue">😛</abbr>. Really <abbr class="emoji" title="winking face">😉</abbr>!`,
+	},
+	{
+		name:   "Custom",
+		input:  `(shipit)`,
+		output: `<img src="https://assets-cdn.github.com/images/icons/emoji/shipit.png" alt="(shipit)" class="emoji" title="ship it!"/>`,
+	},
+	{
+		name:   "Shortcode",
+		input:  `:P :stuck_out_tongue:`,
+		output: `<abbr class="emoji" title="face with stuck-out tongue">😛</abbr> <abbr class="emoji" title="face with stuck-out tongue">😛</abbr>`,
+	},
+	{
+		name:   "Code",
+		input:  `a:b) f(x:) <code>:)</code>`,
+		output: `a:b) f(x:) <code>:)</code>`,
+	},
+	{
+		name:   "URL",
+		input:  `http://example.com/:P/ <a href="http://example.com">http://example.com/:/</a>`,
+		output: `http://example.com/:P/ <a href="http://example.com">http://example.com/:/</a>`,
+	},
+	{
+		name:   "Words",
+		input:  `XDXD abc:) :)abc`,
+		output: `XDXD abc:) :)abc`,
+	},
+}
+
+func TestEmoticon(t *testing.T) {
+	t.Run("Replace", func(t *testing.T) {
+		for _, tt := range emoticonTests {
+			tt := tt
+			t.Run(tt.name, func(t *testing.T) {
+				testReplaceOne(t, emoticonConfig.Replace, tt)
+			})
+		}
+	})
+
+	t.Run("Disabled", func(t *testing.T) {
+		testReplaceOne(t, emoji.Replace, replaceTest{
+			input:  `Hello :) &lt;3`,
+			output: `Hello :) &lt;3`,
+		})
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		defer expectPanic(t, "emoji: emoticon cannot be empty string")
+
+		emoticonConfig.AddEmoticon("", ":smile:")
+	})
+
+	t.Run("Whitespace", func(t *testing.T) {
+		defer expectPanic(t, "emoji: emoticon cannot contain whitespace")
+
+		emoticonConfig.AddEmoticon(": )", ":smile:")
+	})
+
+	t.Run("AlreadyDefined", func(t *testing.T) {
+		defer expectPanic(t, "emoji: emoticon already defined in this Config: <3")
+
+		emoticonConfig.AddEmoticon("<3", ":smile:")
+	})
+
+	t.Run("NotDefined", func(t *testing.T) {
+		defer expectPanic(t, "emoji: not defined: :partyparrot:")
+
+		emoticonConfig.AddEmoticon("<party>", ":partyparrot:")
+	})
+}
@@ -0,0 +1,97 @@
+package emoji
+
+// DecodeData decodes the default emoji set without caching it.
+func DecodeData() {
+	decodeData(emojiData)
+}
+
+// BuildStartState builds the state machine for the default emoji set without
+// caching it.
+func BuildStartState() {
+	var root state
+	for name := range defaults().byName {
+		root.addInPlace(fold(name))
+	}
+}
+
+// MatchNames returns the names in this Config and the default set that occur
+// in str.
+func (conf *Config) MatchNames(str string, accept func(start, end int) bool) [][2]int {
+	return conf.nameMatcher().matchFunc(str, accept)
+}
+
+// MatchNamesNaive is like MatchNames, but it walks the trie from each offset.
+func (conf *Config) MatchNamesNaive(str string, accept func(start, end int) bool) [][2]int {
+	m := conf.nameMatcher()
+	root := m.root
+	if m.base != nil {
+		// Combine the names into one trie, as the matcher did before
+		// it could extend a base matcher.
+		root = m.base.root
+		var name []byte
+		var walk func(s *state)
+		walk = func(s *state) {
+			if s.term {
+				root = root.add(string(name))
+			}
+			for _, e := range s.edges {
+				name = append(name, e.b)
+				walk(e.next)
+				name = name[:len(name)-1]
+			}
+		}
+		walk(m.root)
+	}
+	return matchNaive(root, str, accept)
+}
+
+// matchNaive is the original implementation of matchFunc, which takes time
+// proportional to the length of str times the length of the longest name.
+func matchNaive(s *state, str string, accept func(start, end int) bool) [][2]int {
+	var matches [][2]int
+	var terms []int
+
+	for i := 0; i < len(str); i++ {
+		terms = terms[:0]
+		for j, c := i, s; ; j++ {
+			if c.term {
+				terms = append(terms, j)
+			}
+			if j == len(str) {
+				break
+			}
+			if c = c.next(str[j]); c == nil {
+				break
+			}
+		}
+		for k := len(terms) - 1; k >= 0; k-- {
+			if accept == nil || accept(i, terms[k]) {
+				matches = append(matches, [2]int{i, terms[k]})
+				i = terms[k] - 1
+				break
+			}
+		}
+	}
+
+	return matches
+}
+
+// DecodeAnnotation decodes data in the format of the default annotations and
+// returns the description and keywords of e in locale.
+func DecodeAnnotation(data, locale, e string) (string, []string, bool) {
+	l, ok := decodeLocales(data)[locale]
+	if !ok {
+		return "", nil, false
+	}
+	a, ok := decodeAnnotations(l.data)[e]
+	if !ok {
+		return "", nil, false
+	}
+	return a.description, a.keywords, true
+}
+
+// HasDefaultAnnotations reports whether the default annotations include
+// locale.
+func HasDefaultAnnotations(locale string) bool {
+	return defaultAnnotations(locale) != nil
+}
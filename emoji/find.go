@@ -0,0 +1,91 @@
+package emoji
+
+import "regexp"
+
+// Match is an emoji, shortcode, or emoticon found by FindAll.
+type Match struct {
+	// Start and End are the byte offsets of the match in the text.
+	Start, End int
+
+	// Name is the text that was matched: a Unicode emoji, a shortcode such
+	// as :tada:, or an emoticon such as :-).
+	Name string
+
+	// Unknown is set if Name looks like a shortcode but is not defined.
+	Unknown bool
+
+	// Result is the emoji that Name refers to. It is the zero SearchResult,
+	// whose methods must not be called, if Unknown is set.
+	Result SearchResult
+}
+
+// shortcodePattern matches text that looks like a shortcode. A letter is
+// required so that times such as 12:30:45 are not reported.
+var shortcodePattern = regexp.MustCompile(`:[\pL\pN_+\-]*\pL[\pL\pN_+\-]*:`)
+
+// FindAll returns the emoji, shortcodes, and emoticons in text, in the order
+// they appear, along with anything that looks like a shortcode but is not
+// defined.
+func FindAll(text string) []Match {
+	return defaultConfig.FindAll(text)
+}
+
+// FindAll returns the emoji, shortcodes, and emoticons in text that Replace
+// would replace, in the order they appear. Text between colons that looks
+// like a shortcode but is not defined in this Config or the default set, such
+// as :no_such_emoji:, is reported with Unknown set.
+func (conf *Config) FindAll(text string) []Match {
+	matches := conf.findUnknown(text, conf.findEmoji(text))
+
+	result := make([]Match, len(matches))
+	for i, m := range matches {
+		result[i] = Match{
+			Start: m.start,
+			End:   m.end,
+			Name:  text[m.start:m.end],
+		}
+		if m.e == nil {
+			result[i].Unknown = true
+		} else {
+			result[i].Result = SearchResult{emoji: m.e, annotation: conf.annotation(m.e)}
+		}
+	}
+
+	return result
+}
+
+// findUnknown adds the text that looks like a shortcode and does not overlap
+// an existing match or a URL to matches, which must be sorted. The added
+// matches have a nil emoji.
+func (conf *Config) findUnknown(text string, matches []emojiMatch) []emojiMatch {
+	spans := shortcodePattern.FindAllStringIndex(text, -1)
+	if len(spans) == 0 {
+		return matches
+	}
+
+	var urls [][]int
+	if conf.SkipURLs {
+		urls = urlPattern.FindAllStringIndex(text, -1)
+	}
+
+	result := make([]emojiMatch, 0, len(matches)+len(spans))
+	for _, span := range spans {
+		s := [2]int{span[0], span[1]}
+		if conf.WordBoundary && !wordBoundary(text, s[0], s[1]) || overlapsAny(urls, s) {
+			continue
+		}
+		for len(matches) != 0 && matches[0].end <= s[0] {
+			result = append(result, matches[0])
+			matches = matches[1:]
+		}
+		if len(matches) != 0 && matches[0].start < s[1] {
+			continue
+		}
+		result = append(result, emojiMatch{
+			start: s[0],
+			end:   s[1],
+		})
+	}
+
+	return append(result, matches...)
+}
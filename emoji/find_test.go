@@ -0,0 +1,145 @@
+package emoji_test
+
+import (
+	"strings"
+	"testing"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+
+	"github.com/BenLubar/hellstew/emoji"
+)
+
+func TestFindAll(t *testing.T) {
+	conf := &emoji.Config{WordBoundary: true, SkipURLs: true}
+	conf.AddImage("/images/shipit.png", "ship it!", []string{"shipit"}, "GitHub", nil)
+	conf.AddDefaultEmoticons()
+
+	type match struct {
+		name        string
+		unknown     bool
+		description string
+	}
+
+	for _, tt := range []struct {
+		name    string
+		input   string
+		matches []match
+	}{
+		{"Empty", "", nil},
+		{"PlainText", "Hello, world!", nil},
+		{"Shortcode", "To the :popcorn: thread!", []match{
+			{":popcorn:", false, "popcorn"},
+		}},
+		{"Unicode", "🏇 and :shipit:", []match{
+			{"🏇", false, "horse racing"},
+			{":shipit:", false, "ship it!"},
+		}},
+		{"Emoticon", "thanks :)", []match{
+			{":)", false, "slightly smiling face"},
+		}},
+		{"Unknown", ":popcorn: :no_such_emoji: :tada:", []match{
+			{":popcorn:", false, "popcorn"},
+			{":no_such_emoji:", true, ""},
+			{":tada:", false, "party popper"},
+		}},
+		{"Overlap", "::tada:", []match{
+			{":tada:", false, "party popper"},
+		}},
+		{"Time", "at 12:30:45", nil},
+		{"WordBoundary", "foo:bar:baz", nil},
+		{"URL", "https://example.com/:unknown:/:tada:", nil},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			actual := conf.FindAll(tt.input)
+			if len(actual) != len(tt.matches) {
+				t.Fatalf("expected %d matches, got %d: %+v", len(tt.matches), len(actual), actual)
+			}
+
+			for i, m := range actual {
+				expected := tt.matches[i]
+				if m.Name != expected.name || m.Unknown != expected.unknown {
+					t.Errorf("match %d: expected %q (unknown=%v), got %q (unknown=%v)", i, expected.name, expected.unknown, m.Name, m.Unknown)
+					continue
+				}
+				if tt.input[m.Start:m.End] != m.Name {
+					t.Errorf("match %d: span [%d:%d] is %q, not %q", i, m.Start, m.End, tt.input[m.Start:m.End], m.Name)
+				}
+				if !m.Unknown {
+					if description := m.Result.Description(); description != expected.description {
+						t.Errorf("match %d: Description: %q != %q", i, expected.description, description)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestUnknownShortcode(t *testing.T) {
+	userEmoji := map[string]string{
+		":partyparrot:": "/images/partyparrot.gif",
+	}
+
+	var called []string
+	conf := &emoji.Config{WordBoundary: true}
+	conf.UnknownShortcode = func(name string) []*html.Node {
+		called = append(called, name)
+
+		if src, ok := userEmoji[name]; ok {
+			return []*html.Node{{
+				Type:     html.ElementNode,
+				Data:     "img",
+				DataAtom: atom.Img,
+				Attr: []html.Attribute{
+					{Key: "src", Val: src},
+					{Key: "alt", Val: name},
+					{Key: "class", Val: "emoji"},
+				},
+			}}
+		}
+
+		if strings.HasPrefix(name, ":delete") {
+			return []*html.Node{}
+		}
+
+		if results := conf.Search(strings.Trim(name, ":"), 1); len(results) != 0 {
+			return []*html.Node{conf.Node(":" + results[0].Aliases()[0] + ":")}
+		}
+
+		return nil
+	}
+
+	for _, tt := range []replaceTest{
+		{
+			name:   "External",
+			input:  `hello :partyparrot:!`,
+			output: `hello <img src="/images/partyparrot.gif" alt=":partyparrot:" class="emoji"/>!`,
+		},
+		{
+			name:   "Suggestion",
+			input:  `:popcor: :tada:`,
+			output: `<abbr class="emoji" title="popcorn">🍿</abbr> <abbr class="emoji" title="party popper">🎉</abbr>`,
+		},
+		{
+			name:   "Removed",
+			input:  `a :delete_me: b`,
+			output: `a  b`,
+		},
+		{
+			name:   "Unchanged",
+			input:  `:qwxz: and 10:30:ok`,
+			output: `:qwxz: and 10:30:ok`,
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			testReplaceOne(t, conf.Replace, tt)
+		})
+	}
+
+	for _, name := range called {
+		if name == ":tada:" {
+			t.Errorf("UnknownShortcode called for %q", name)
+		}
+	}
+}
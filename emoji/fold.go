@@ -0,0 +1,172 @@
+package emoji
+
+import (
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/unicode/norm"
+)
+
+// unfoldSpan converts a span of a string returned by foldIndex to a span of the
+// original string.
+func unfoldSpan(s string, offsets []int, span [2]int) [2]int {
+	if offsets == nil {
+		return span
+	}
+	last := offsets[span[1]-1]
+	_, size := utf8.DecodeRuneInString(s[last:])
+	return [2]int{offsets[span[0]], last + size}
+}
+
+// lookup finds the emoji for a name matched by the state machine. Exact
+// matches are preferred over matches that differ in case, diacritics, or
+// punctuation.
+func (conf *Config) lookup(name string) (*emoji, bool) {
+	if e, ok := conf.byName[name]; ok {
+		return e, true
+	}
+	d := defaults()
+	if e, ok := d.byName[name]; ok || conf.CaseSensitive {
+		return e, ok
+	}
+
+	key := fold(name)
+	if e, ok := conf.byName[key]; ok {
+		return e, true
+	}
+	if e, ok := conf.byFold[key]; ok {
+		return e, true
+	}
+	if e, ok := d.byName[key]; ok {
+		return e, true
+	}
+	e, ok := d.byFold[key]
+	return e, ok
+}
+
+// key returns the form of name that is stored in the trie: the name itself if
+// the Config is case-sensitive, or its folded form otherwise.
+func (conf *Config) key(name string) string {
+	if conf.CaseSensitive {
+		return name
+	}
+	return fold(name)
+}
+
+// foldIndex is like the foldIndex function, but if the Config is
+// case-sensitive, s is returned unchanged.
+func (conf *Config) foldIndex(s string) (string, []int) {
+	if conf.CaseSensitive {
+		return s, nil
+	}
+	return foldIndex(s)
+}
+
+// fold returns a form of s suitable for case-insensitive, diacritic-insensitive,
+// and punctuation-insensitive comparison.
+func fold(s string) string {
+	folded, _ := foldIndex(s)
+	return folded
+}
+
+// foldIndex is like fold, but it also returns the offset in s of the rune that
+// produced each byte of the folded string. If the folded string is the same as
+// s, the offsets are nil.
+//
+// Folding applies Unicode case folding, removes combining diacritical marks
+// (but not variation selectors), removes apostrophes, and replaces typographic
+// quotation marks and dashes with their ASCII equivalents. The dotted and
+// dotless forms of i are folded together, so Turkish text matches regardless
+// of the locale.
+func foldIndex(s string) (string, []int) {
+	if !needsFold(s) {
+		return s, nil
+	}
+
+	buf := make([]byte, 0, len(s))
+	offsets := make([]int, 0, len(s))
+	var caser *cases.Caser
+
+	for i := 0; i < len(s); {
+		n := len(buf)
+		var size int
+		buf, size = appendFold(buf, s[i:], &caser)
+		for ; n < len(buf); n++ {
+			offsets = append(offsets, i)
+		}
+
+		i += size
+	}
+
+	return string(buf), offsets
+}
+
+// appendFold appends the folded form of the first rune in s to buf. It returns
+// the extended buffer and the size of the rune in s.
+func appendFold(buf []byte, s string, caser **cases.Caser) ([]byte, int) {
+	r, size := utf8.DecodeRuneInString(s)
+
+	switch {
+	case r == utf8.RuneError && size == 1:
+		buf = append(buf, s[0])
+	case r < utf8.RuneSelf:
+		buf = appendFoldRune(buf, r, caser)
+	default:
+		if d := norm.NFD.PropertiesString(s).Decomposition(); d != nil {
+			for len(d) != 0 {
+				dr, dsize := utf8.DecodeRune(d)
+				buf = appendFoldRune(buf, dr, caser)
+				d = d[dsize:]
+			}
+		} else {
+			buf = appendFoldRune(buf, r, caser)
+		}
+	}
+
+	return buf, size
+}
+
+func needsFold(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if c := s[i]; c >= utf8.RuneSelf || c == '\'' || ('A' <= c && c <= 'Z') {
+			return true
+		}
+	}
+	return false
+}
+
+func appendFoldRune(buf []byte, r rune, caser **cases.Caser) []byte {
+	switch r {
+	case '\'', '‘', '’', '‛', 'ʼ', '′':
+		return buf
+	case '“', '”', '„', '‟', '″':
+		return append(buf, '"')
+	case '‐', '‑', '‒', '–', '—', '―', '−':
+		return append(buf, '-')
+	case 'ı':
+		return append(buf, 'i')
+	}
+
+	if 'A' <= r && r <= 'Z' {
+		return append(buf, byte(r-'A'+'a'))
+	}
+	if r < utf8.RuneSelf {
+		return append(buf, byte(r))
+	}
+
+	if unicode.Is(unicode.Mn, r) && !unicode.Is(unicode.Variation_Selector, r) {
+		return buf
+	}
+
+	if unicode.SimpleFold(r) != r {
+		if *caser == nil {
+			c := cases.Fold()
+			*caser = &c
+		}
+		return append(buf, (*caser).String(string(r))...)
+	}
+
+	var b [utf8.UTFMax]byte
+	return append(buf, b[:utf8.EncodeRune(b[:], r)]...)
+}
@@ -0,0 +1,175 @@
+package emoji_test
+
+import (
+	"bytes"
+	"testing"
+
+	"golang.org/x/net/html"
+
+	"github.com/BenLubar/hellstew/emoji"
+)
+
+var foldConfig = func() *emoji.Config {
+	var conf emoji.Config
+
+	conf.AddImage("/images/cafe.png", "Café", []string{"Café"}, "Custom", []string{"Kaffee"})
+	conf.AddImage("/images/istanbul.png", "İstanbul", []string{"İstanbul"}, "Custom", nil)
+
+	return &conf
+}()
+
+func TestFold(t *testing.T) {
+	t.Run("Replace", func(t *testing.T) {
+		for _, tt := range []struct {
+			input  string
+			output string
+		}{
+			{":Horse_Racing:", `<abbr class="emoji" title="horse racing">🏇</abbr>`},
+			{"(:POPCORN:)", `(<abbr class="emoji" title="popcorn">🍿</abbr>)`},
+			{":Café:", `<img src="/images/cafe.png" alt=":Café:" class="emoji" title="Café"/>`},
+			{":cafe:", `<img src="/images/cafe.png" alt=":cafe:" class="emoji" title="Café"/>`},
+			{":CAFE\u0301:", "<img src=\"/images/cafe.png\" alt=\":CAFE\u0301:\" class=\"emoji\" title=\"Café\"/>"},
+			{":CAF\u00c9:", "<img src=\"/images/cafe.png\" alt=\":CAF\u00c9:\" class=\"emoji\" title=\"Café\"/>"},
+			{"é :istanbul: ı", `é <img src="/images/istanbul.png" alt=":istanbul:" class="emoji" title="İstanbul"/> ı`},
+			{":ıstanbul:", `<img src="/images/istanbul.png" alt=":ıstanbul:" class="emoji" title="İstanbul"/>`},
+			{"’:popcorn:’", `’<abbr class="emoji" title="popcorn">🍿</abbr>’`},
+		} {
+			nodes := foldConfig.Replace(&html.Node{
+				Type: html.TextNode,
+				Data: tt.input,
+			})
+
+			var buf bytes.Buffer
+			for _, n := range nodes {
+				if err := html.Render(&buf, n); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			if output := buf.String(); tt.output != output {
+				t.Errorf("input %q\nexpected %q\nactual   %q", tt.input, tt.output, output)
+			}
+		}
+	})
+
+	t.Run("Search", func(t *testing.T) {
+		for _, tt := range []struct {
+			query       string
+			description string
+		}{
+			{"eight o'clock", "eight o’clock"},
+			{"Eight OClock", "eight o’clock"},
+			{"cafe", "Café"},
+			{"CAFÉ", "Café"},
+			{"istanbul", "İstanbul"},
+			{"ISTANBUL", "İstanbul"},
+			{"kaffee", "Café"},
+		} {
+			results := foldConfig.Search(tt.query, 1)
+			if len(results) != 1 {
+				t.Errorf("query %q: unexpected len(results) == %d", tt.query, len(results))
+				continue
+			}
+			if actual := results[0].Description(); tt.description != actual {
+				t.Errorf("query %q: Description: %q != %q", tt.query, tt.description, actual)
+			}
+		}
+	})
+}
+
+func TestCaseSensitive(t *testing.T) {
+	conf := &emoji.Config{CaseSensitive: true}
+	conf.AddImage("/images/PogChamp.png", "PogChamp", []string{"PogChamp"}, "Custom", nil)
+	conf.AddImage("/images/pogchamp.png", "pogchamp", []string{"pogchamp"}, "Custom", nil)
+
+	t.Run("Replace", func(t *testing.T) {
+		for _, tt := range []struct {
+			input  string
+			output string
+		}{
+			{":PogChamp:", `<img src="/images/PogChamp.png" alt=":PogChamp:" class="emoji" title="PogChamp"/>`},
+			{":pogchamp:", `<img src="/images/pogchamp.png" alt=":pogchamp:" class="emoji" title="pogchamp"/>`},
+			{":POGCHAMP:", `:POGCHAMP:`},
+			{":tada: :TADA:", `<abbr class="emoji" title="party popper">🎉</abbr> :TADA:`},
+		} {
+			nodes := conf.Replace(&html.Node{
+				Type: html.TextNode,
+				Data: tt.input,
+			})
+
+			var buf bytes.Buffer
+			for _, n := range nodes {
+				if err := html.Render(&buf, n); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			if output := buf.String(); tt.output != output {
+				t.Errorf("input %q\nexpected %q\nactual   %q", tt.input, tt.output, output)
+			}
+		}
+	})
+
+	t.Run("Lookup", func(t *testing.T) {
+		for _, tt := range []struct {
+			name        string
+			description string
+		}{
+			{":PogChamp:", "PogChamp"},
+			{":pogchamp:", "pogchamp"},
+			{":POGCHAMP:", ""},
+			{":tada:", "party popper"},
+			{":Tada:", ""},
+		} {
+			result, ok := conf.Lookup(tt.name)
+			if ok != (tt.description != "") {
+				t.Errorf("%q: unexpected ok == %v", tt.name, ok)
+			} else if ok && tt.description != result.Description() {
+				t.Errorf("%q: Description: %q != %q", tt.name, tt.description, result.Description())
+			}
+		}
+	})
+
+	t.Run("Search", func(t *testing.T) {
+		for _, tt := range []struct {
+			query       string
+			description string
+		}{
+			{"Pog", "PogChamp"},
+			{"pog", "pogchamp"},
+		} {
+			results := conf.Search(tt.query, 1)
+			if len(results) != 1 {
+				t.Errorf("query %q: unexpected len(results) == %d", tt.query, len(results))
+				continue
+			}
+			if actual := results[0].Description(); tt.description != actual {
+				t.Errorf("query %q: Description: %q != %q", tt.query, tt.description, actual)
+			}
+		}
+	})
+
+	t.Run("Canonical", func(t *testing.T) {
+		var conf emoji.Config
+		conf.AddImage("/images/first.png", "first", []string{"Kappa"}, "Custom", nil)
+		conf.AddImage("/images/second.png", "second", []string{"KAPPA"}, "Custom", nil)
+
+		for _, name := range []string{":Kappa:", ":kappa:", ":KaPpA:"} {
+			if result, ok := conf.Lookup(name); !ok || result.Description() != "first" {
+				t.Errorf("%q: expected first, got %v", name, result)
+			}
+		}
+		if result, ok := conf.Lookup(":KAPPA:"); !ok || result.Description() != "second" {
+			t.Errorf(":KAPPA: expected second, got %v", result)
+		}
+	})
+
+	t.Run("Changed", func(t *testing.T) {
+		defer expectPanic(t, "emoji: CaseSensitive changed after emoji were added")
+
+		var conf emoji.Config
+		conf.AddImage("/images/first.png", "first", []string{"first"}, "Custom", nil)
+		conf.CaseSensitive = true
+		conf.AddImage("/images/second.png", "second", []string{"second"}, "Custom", nil)
+	})
+}
@@ -1,184 +1,173 @@
 // +build ignore
 
+// generate.go regenerates emoji_data.go and emoji_locale_data.go.
+//
+// By default, gemoji's emoji.json and CLDR's annotations are downloaded, and
+// emoji_data.go is only regenerated if emoji.json has changed since the ETag
+// recorded in generate_etag.go. To regenerate from local copies instead:
+//
+//	go run generate.go generate_etag.go -emoji path/to/emoji.json -cldr path/to/annotations
+//
+// where the -cldr directory contains locale/annotations.json for each locale.
+// If -emoji is given without -cldr, emoji_locale_data.go is left unchanged.
+//
+// gemoji does not include every emoji sequence. The Unicode consortium's
+// emoji-test.txt and emoji-zwj-sequences.txt can be merged into the data with
+// -emoji-test and -zwj; emoji that gemoji does not have are added without
+// shortcodes, and minimally-qualified forms are recognised as the same emoji.
+// When either is given, emoji.json is downloaded even if its ETag is unchanged.
 package main
 
 import (
-	"encoding/json"
+	"flag"
 	"fmt"
-	"go/format"
+	"io"
 	"io/ioutil"
-	"net/http"
-	"sort"
-	"strconv"
-)
+	"os"
+	"path/filepath"
 
-type emoji struct {
-	Emoji       string   `json:"emoji"`
-	Description string   `json:"description"`
-	Category    string   `json:"category"`
-	Aliases     []string `json:"aliases"`
-	Tags        []string `json:"tags"`
+	"github.com/BenLubar/hellstew/emoji/internal/generate"
+)
 
-	// not included in struct: unicode_version, ios_version
+// annotationLocales is the list of CLDR locales to generate annotations for.
+var annotationLocales = []string{
+	"de",
+	"ja",
+	"pt",
 }
 
 func main() {
-	allEmoji, newETag := loadEmoji()
-	if allEmoji == nil && newETag == "" {
-		return
-	}
-
-	buf := []byte("// THIS FILE IS GENERATED BY generate.go\n//go:generate go run generate.go generate_etag.go\n\npackage emoji\n\ntype emoji struct {\n\temoji       string\n\timageURL    string\n\tdescription string\n\taliases     []string\n}\n\nvar allEmoji = [...]emoji{\n")
-	byCategory := make(map[string][]int)
-	byTag := make(map[string][]int)
-	var byName [][]string
-	i := 0
-	for _, e := range allEmoji {
-		if e.Emoji == "" {
-			// skip non-Unicode emoji
-			continue
+	emojiPath := flag.String("emoji", "", "read gemoji's emoji.json from `file` instead of downloading it")
+	cldrPath := flag.String("cldr", "", "read CLDR annotations from `dir`/locale/annotations.json instead of downloading them")
+	emojiTestPath := flag.String("emoji-test", "", "merge sequences from the Unicode consortium's emoji-test.txt `file`")
+	zwjPath := flag.String("zwj", "", "merge sequences from the Unicode consortium's emoji-zwj-sequences.txt `file`")
+	flag.Parse()
+
+	if err := run(*emojiPath, *cldrPath, *emojiTestPath, *zwjPath); err != nil {
+		fmt.Fprintln(os.Stderr, "generate:", err)
+		os.Exit(1)
+	}
+}
+
+func run(emojiPath, cldrPath, emojiTestPath, zwjPath string) error {
+	if cldrPath != "" || emojiPath == "" {
+		if err := generateAnnotations(cldrPath); err != nil {
+			return err
 		}
+	}
 
-		names := make([]string, 1, len(e.Aliases)+1)
-		names[0] = e.Emoji
-		for _, a := range e.Aliases {
-			names = append(names, ":"+a+":")
+	var sequences []generate.Sequence
+	if emojiTestPath != "" {
+		s, err := loadSequences(emojiTestPath, generate.ParseEmojiTest)
+		if err != nil {
+			return err
 		}
-		byName = append(byName, names)
-
-		buf = append(buf, "\t{\n\t\temoji:       "...)
-		buf = strconv.AppendQuote(buf, e.Emoji)
-		buf = append(buf, ",\n\t\tdescription: "...)
-		buf = strconv.AppendQuote(buf, e.Description)
-		buf = appendAliases(buf, e.Aliases)
-		buf = append(buf, ",\n\t},\n"...)
-
-		byCategory[e.Category] = append(byCategory[e.Category], i)
-		for _, t := range e.Tags {
-			byTag[t] = append(byTag[t], i)
+		sequences = append(sequences, s...)
+	}
+	if zwjPath != "" {
+		s, err := loadSequences(zwjPath, generate.ParseSequences)
+		if err != nil {
+			return err
 		}
-		i++
+		sequences = append(sequences, s...)
 	}
-	buf = append(buf, "}\n"...)
-	buf = appendBy(buf, "categories", "byCategory", byCategory)
-	buf = appendBy(buf, "tags", "byTag", byTag)
-	buf = appendNames(buf, byName)
 
-	buf, err := format.Source(buf)
-	if err != nil {
-		panic(err)
+	if emojiPath != "" {
+		f, err := os.Open(emojiPath)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		allEmoji, err := generate.ParseEmoji(f)
+		if err != nil {
+			return fmt.Errorf("%s: %v", emojiPath, err)
+		}
+
+		return writeEmoji(allEmoji, sequences)
 	}
 
-	err = ioutil.WriteFile("emoji_data.go", buf, 0644)
-	if err != nil {
-		panic(err)
+	oldETag := etag
+	if sequences != nil {
+		oldETag = ""
 	}
 
-	err = ioutil.WriteFile("generate_etag.go", []byte(fmt.Sprintf("// THIS FILE IS GENERATED BY generate.go\n\n// +build ignore\n\npackage main\n\nconst etag = %q\n", newETag)), 0644)
+	allEmoji, newETag, err := generate.FetchEmoji(oldETag)
 	if err != nil {
-		panic(err)
+		return err
+	}
+	if allEmoji == nil && newETag == "" {
+		return nil
 	}
-}
 
-func loadEmoji() (allEmoji []emoji, newETag string) {
-	req, err := http.NewRequest("GET", "https://raw.githubusercontent.com/github/gemoji/master/db/emoji.json", nil)
-	if err != nil {
-		panic(err)
+	if err = writeEmoji(allEmoji, sequences); err != nil {
+		return err
 	}
 
-	req.Header.Set("User-Agent", "hellstew-updater/1.0 (+https://github.com/BenLubar/hellstew)")
-	req.Header.Set("If-None-Match", etag)
+	return ioutil.WriteFile("generate_etag.go", generate.ETagFile(newETag), 0644)
+}
+
+func writeEmoji(allEmoji []generate.Emoji, sequences []generate.Sequence) error {
+	if sequences != nil {
+		allEmoji = generate.Merge(allEmoji, sequences)
+	}
 
-	resp, err := http.DefaultClient.Do(req)
+	buf, err := generate.EmojiData(allEmoji)
 	if err != nil {
-		panic(err)
+		return err
 	}
-	defer func() {
-		err := resp.Body.Close()
+
+	return ioutil.WriteFile("emoji_data.go", buf, 0644)
+}
+
+func generateAnnotations(cldrPath string) error {
+	annotations := make([]*generate.Annotations, len(annotationLocales))
+	for i, locale := range annotationLocales {
+		var err error
+		if cldrPath == "" {
+			annotations[i], err = generate.FetchAnnotations(locale)
+		} else {
+			annotations[i], err = loadAnnotations(filepath.Join(cldrPath, locale, "annotations.json"))
+		}
 		if err != nil {
-			panic(err)
+			return err
 		}
-	}()
-
-	if resp.StatusCode == http.StatusNotModified {
-		return
 	}
 
-	if resp.StatusCode != http.StatusOK {
-		panic("GET emoji.json: " + resp.Status)
+	buf, err := generate.LocaleData(annotationLocales, annotations)
+	if err != nil {
+		return err
 	}
 
-	b, err := ioutil.ReadAll(resp.Body)
+	return ioutil.WriteFile("emoji_locale_data.go", buf, 0644)
+}
+
+func loadAnnotations(path string) (*generate.Annotations, error) {
+	f, err := os.Open(path)
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
+	defer f.Close()
 
-	err = json.Unmarshal(b, &allEmoji)
+	a, err := generate.ParseAnnotations(f)
 	if err != nil {
-		panic(err)
+		return nil, fmt.Errorf("%s: %v", path, err)
 	}
 
-	newETag = resp.Header.Get("ETag")
-
-	return
+	return a, nil
 }
 
-func appendAliases(buf []byte, aliases []string) []byte {
-	if len(aliases) != 0 {
-		buf = append(buf, ",\n\t\taliases: []string{\n"...)
-		for _, a := range aliases {
-			buf = append(buf, "\t\t\t"...)
-			buf = strconv.AppendQuote(buf, a)
-			buf = append(buf, ",\n"...)
-		}
-		buf = append(buf, "\t\t}"...)
+func loadSequences(path string, parse func(io.Reader) ([]generate.Sequence, error)) ([]generate.Sequence, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
 	}
-	return buf
-}
+	defer f.Close()
 
-func appendBy(buf []byte, stringVar, byVar string, by map[string][]int) []byte {
-	buf = append(buf, "\nvar "...)
-	buf = append(buf, stringVar...)
-	buf = append(buf, " = []string{\n"...)
-	str := make([]string, 0, len(by))
-	for s := range by {
-		str = append(str, s)
-	}
-	sort.Strings(str)
-	for _, s := range str {
-		buf = append(buf, "\t"...)
-		buf = strconv.AppendQuote(buf, s)
-		buf = append(buf, ",\n"...)
-	}
-	buf = append(buf, "}\n\nvar "...)
-	buf = append(buf, byVar...)
-	buf = append(buf, " = [][]*emoji{\n"...)
-	for _, s := range str {
-		buf = append(buf, "\t// "...)
-		buf = append(buf, s...)
-		buf = append(buf, "\n\t{\n"...)
-		for _, idx := range by[s] {
-			buf = append(buf, "\t\t&allEmoji["...)
-			buf = strconv.AppendInt(buf, int64(idx), 10)
-			buf = append(buf, "],\n"...)
-		}
-		buf = append(buf, "\t},\n"...)
+	sequences, err := parse(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
 	}
-	buf = append(buf, "}\n"...)
-	return buf
-}
 
-func appendNames(buf []byte, byName [][]string) []byte {
-	buf = append(buf, "\nvar byName = map[string]*emoji{\n"...)
-	for idx, names := range byName {
-		for _, name := range names {
-			buf = append(buf, "\t"...)
-			buf = strconv.AppendQuote(buf, name)
-			buf = append(buf, ": &allEmoji["...)
-			buf = strconv.AppendInt(buf, int64(idx), 10)
-			buf = append(buf, "],\n"...)
-		}
-	}
-	buf = append(buf, "}\n"...)
-	return buf
+	return sequences, nil
 }
@@ -0,0 +1,86 @@
+package generate
+
+import (
+	"encoding/json"
+	"go/format"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Annotations is the contents of a CLDR annotations.json file.
+type Annotations struct {
+	Annotations struct {
+		Annotations map[string]struct {
+			Default []string `json:"default"`
+			TTS     []string `json:"tts"`
+		} `json:"annotations"`
+	} `json:"annotations"`
+}
+
+// ParseAnnotations reads a CLDR annotations.json file.
+func ParseAnnotations(r io.Reader) (*Annotations, error) {
+	var a Annotations
+	if err := json.NewDecoder(r).Decode(&a); err != nil {
+		return nil, err
+	}
+	return &a, nil
+}
+
+// LocaleData returns the source code of emoji_locale_data.go. locales and
+// annotations are parallel slices.
+//
+// The data is encoded in the same way as EmojiData. Each locale's annotations
+// are preceded by their length in bytes, so that a locale can be decoded
+// without decoding the others.
+func LocaleData(locales []string, annotations []*Annotations) ([]byte, error) {
+	chunks := [][]byte{appendUvarint(nil, len(locales))}
+	for i, locale := range locales {
+		localeChunks := appendAnnotations(nil, annotations[i])
+
+		size := 0
+		for _, chunk := range localeChunks {
+			size += len(chunk)
+		}
+
+		chunk := appendString(nil, locale)
+		chunk = appendUvarint(chunk, size)
+		chunks = append(chunks, chunk)
+		chunks = append(chunks, localeChunks...)
+	}
+
+	buf := []byte("// THIS FILE IS GENERATED BY generate.go\n\npackage emoji\n\n// localeData is the CLDR annotations for each locale, in the format read by\n// decodeLocales.\nconst localeData = \"\"")
+	for _, chunk := range chunks {
+		buf = append(buf, " +\n\t"...)
+		buf = strconv.AppendQuote(buf, string(chunk))
+	}
+	buf = append(buf, "\n"...)
+
+	return format.Source(buf)
+}
+
+// appendAnnotations appends the number of annotations, followed by one chunk
+// per annotation containing the emoji, its description, and its keywords.
+// Annotations are sorted by emoji, and variation selectors are removed from
+// the emoji, as CLDR omits them.
+func appendAnnotations(chunks [][]byte, a *Annotations) [][]byte {
+	keys := make([]string, 0, len(a.Annotations.Annotations))
+	for k, v := range a.Annotations.Annotations {
+		if len(v.TTS) != 0 {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	chunks = append(chunks, appendUvarint(nil, len(keys)))
+	for _, k := range keys {
+		v := a.Annotations.Annotations[k]
+		chunk := appendString(nil, strings.Replace(k, "\ufe0f", "", -1))
+		chunk = appendString(chunk, v.TTS[0])
+		chunk = appendStrings(chunk, v.Default)
+		chunks = append(chunks, chunk)
+	}
+
+	return chunks
+}
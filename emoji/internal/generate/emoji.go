@@ -0,0 +1,126 @@
+// Package generate builds the data files of the emoji package from gemoji's
+// emoji.json and CLDR's annotations.json. It is used by generate.go.
+package generate
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"go/format"
+	"io"
+	"sort"
+	"strconv"
+)
+
+// Emoji is an entry in gemoji's emoji.json.
+type Emoji struct {
+	Emoji       string   `json:"emoji"`
+	Description string   `json:"description"`
+	Category    string   `json:"category"`
+	Aliases     []string `json:"aliases"`
+	Tags        []string `json:"tags"`
+
+	// not included in struct: unicode_version, ios_version
+
+	// Variants are other Unicode forms of the emoji, such as minimally
+	// qualified sequences, that are recognised as the same emoji. They are
+	// set by Merge.
+	Variants []string `json:"-"`
+}
+
+// ParseEmoji reads gemoji's emoji.json.
+func ParseEmoji(r io.Reader) ([]Emoji, error) {
+	var allEmoji []Emoji
+	if err := json.NewDecoder(r).Decode(&allEmoji); err != nil {
+		return nil, err
+	}
+	return allEmoji, nil
+}
+
+// EmojiData returns the source code of emoji_data.go for allEmoji. Entries
+// without a Unicode emoji are skipped. The data is encoded in the format read
+// by decodeData in the emoji package, with each emoji, category, and tag on its
+// own line.
+func EmojiData(allEmoji []Emoji) ([]byte, error) {
+	var chunks [][]byte
+	byCategory := make(map[string][]int)
+	byTag := make(map[string][]int)
+	var unicodeEmoji []Emoji
+	for _, e := range allEmoji {
+		if e.Emoji == "" {
+			// skip non-Unicode emoji
+			continue
+		}
+
+		i := len(unicodeEmoji)
+		unicodeEmoji = append(unicodeEmoji, e)
+		if e.Category != "" {
+			byCategory[e.Category] = append(byCategory[e.Category], i)
+		}
+		for _, t := range e.Tags {
+			byTag[t] = append(byTag[t], i)
+		}
+	}
+
+	chunks = append(chunks, appendUvarint(nil, len(unicodeEmoji)))
+	for _, e := range unicodeEmoji {
+		chunk := appendString(nil, e.Emoji)
+		chunk = appendString(chunk, e.Description)
+		chunk = appendStrings(chunk, e.Aliases)
+		chunk = appendStrings(chunk, e.Variants)
+		chunks = append(chunks, chunk)
+	}
+	chunks = appendSets(chunks, byCategory)
+	chunks = appendSets(chunks, byTag)
+
+	buf := []byte("// THIS FILE IS GENERATED BY generate.go\n//go:generate go run generate.go generate_etag.go\n\npackage emoji\n\n// emojiData is the default emoji set, in the format read by decodeData.\nconst emojiData = \"\"")
+	for _, chunk := range chunks {
+		buf = append(buf, " +\n\t"...)
+		buf = strconv.AppendQuote(buf, string(chunk))
+	}
+	buf = append(buf, "\n"...)
+
+	return format.Source(buf)
+}
+
+func appendUvarint(buf []byte, x int) []byte {
+	var tmp [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(tmp[:], uint64(x))
+	return append(buf, tmp[:n]...)
+}
+
+func appendString(buf []byte, s string) []byte {
+	buf = appendUvarint(buf, len(s))
+	return append(buf, s...)
+}
+
+func appendStrings(buf []byte, list []string) []byte {
+	buf = appendUvarint(buf, len(list))
+	for _, s := range list {
+		buf = appendString(buf, s)
+	}
+	return buf
+}
+
+// appendSets appends the number of sets, followed by one chunk per set
+// containing its name, its size, and the differences between consecutive
+// indices. Sets are sorted by name.
+func appendSets(chunks [][]byte, by map[string][]int) [][]byte {
+	names := make([]string, 0, len(by))
+	for s := range by {
+		names = append(names, s)
+	}
+	sort.Strings(names)
+
+	chunks = append(chunks, appendUvarint(nil, len(names)))
+	for _, s := range names {
+		chunk := appendString(nil, s)
+		chunk = appendUvarint(chunk, len(by[s]))
+		last := 0
+		for _, idx := range by[s] {
+			chunk = appendUvarint(chunk, idx-last)
+			last = idx
+		}
+		chunks = append(chunks, chunk)
+	}
+	return chunks
+}
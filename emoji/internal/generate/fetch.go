@@ -0,0 +1,83 @@
+package generate
+
+import (
+	"fmt"
+	"net/http"
+)
+
+const userAgent = "hellstew-updater/1.0 (+https://github.com/BenLubar/hellstew)"
+
+// EmojiURL is the location of gemoji's emoji.json.
+const EmojiURL = "https://raw.githubusercontent.com/github/gemoji/master/db/emoji.json"
+
+// AnnotationsURL returns the location of CLDR's annotations.json for locale.
+func AnnotationsURL(locale string) string {
+	return "https://raw.githubusercontent.com/unicode-org/cldr-json/main/cldr-json/cldr-annotations-full/annotations/" + locale + "/annotations.json"
+}
+
+// FetchEmoji downloads gemoji's emoji.json. If etag is not empty and the file
+// has not changed, FetchEmoji returns a nil slice and an empty ETag.
+func FetchEmoji(etag string) (allEmoji []Emoji, newETag string, err error) {
+	req, err := http.NewRequest("GET", EmojiURL, nil)
+	if err != nil {
+		return nil, "", err
+	}
+
+	req.Header.Set("User-Agent", userAgent)
+	if etag != "" {
+		req.Header.Set("If-None-Match", etag)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotModified {
+		return nil, "", nil
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, "", fmt.Errorf("GET emoji.json: %s", resp.Status)
+	}
+
+	allEmoji, err = ParseEmoji(resp.Body)
+	if err != nil {
+		return nil, "", fmt.Errorf("emoji.json: %v", err)
+	}
+
+	return allEmoji, resp.Header.Get("ETag"), nil
+}
+
+// FetchAnnotations downloads CLDR's annotations.json for locale.
+func FetchAnnotations(locale string) (*Annotations, error) {
+	req, err := http.NewRequest("GET", AnnotationsURL(locale), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("User-Agent", userAgent)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("GET %s/annotations.json: %s", locale, resp.Status)
+	}
+
+	a, err := ParseAnnotations(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("%s/annotations.json: %v", locale, err)
+	}
+
+	return a, nil
+}
+
+// ETagFile returns the source code of generate_etag.go.
+func ETagFile(etag string) []byte {
+	return []byte(fmt.Sprintf("// THIS FILE IS GENERATED BY generate.go\n\n// +build ignore\n\npackage main\n\nconst etag = %q\n", etag))
+}
@@ -0,0 +1,154 @@
+package generate_test
+
+import (
+	"bytes"
+	"flag"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/BenLubar/hellstew/emoji/internal/generate"
+)
+
+var update = flag.Bool("update", false, "update the golden files in testdata")
+
+func checkGolden(t *testing.T, name string, actual []byte) {
+	path := filepath.Join("testdata", name+".golden")
+	if *update {
+		if err := ioutil.WriteFile(path, actual, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	expected, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(expected, actual) {
+		t.Errorf("output does not match %s; run go test -update to see the difference", path)
+	}
+}
+
+func loadEmoji(t *testing.T) []generate.Emoji {
+	f, err := os.Open(filepath.Join("testdata", "emoji.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	allEmoji, err := generate.ParseEmoji(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return allEmoji
+}
+
+func loadSequences(t *testing.T, name string, parse func(io.Reader) ([]generate.Sequence, error)) []generate.Sequence {
+	f, err := os.Open(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	sequences, err := parse(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return sequences
+}
+
+func TestEmojiData(t *testing.T) {
+	buf, err := generate.EmojiData(loadEmoji(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	checkGolden(t, "emoji_data.go", buf)
+}
+
+func TestMerge(t *testing.T) {
+	allEmoji := loadEmoji(t)
+	allEmoji = generate.Merge(allEmoji, loadSequences(t, "emoji-test.txt", generate.ParseEmojiTest))
+	allEmoji = generate.Merge(allEmoji, loadSequences(t, "emoji-zwj-sequences.txt", generate.ParseSequences))
+
+	buf, err := generate.EmojiData(allEmoji)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	checkGolden(t, "emoji_data_unicode.go", buf)
+}
+
+func TestParseEmojiTest(t *testing.T) {
+	sequences := loadSequences(t, "emoji-test.txt", generate.ParseEmojiTest)
+
+	expected := generate.Sequence{
+		Emoji:  "#\ufe0f\u20e3",
+		Status: generate.FullyQualified,
+		Name:   "keycap: #",
+		Group:  "Symbols",
+	}
+	if actual := sequences[len(sequences)-2]; expected != actual {
+		t.Errorf("%+q != %+q", expected, actual)
+	}
+}
+
+func TestParseSequences(t *testing.T) {
+	sequences, err := generate.ParseSequences(strings.NewReader("0023 FE0F 20E3 ; RGI_Emoji_Keycap_Sequence ; keycap: \\x{23} # E0.6 [1] (#️⃣)\n231A..231B ; Basic_Emoji ; watch # E0.6 [2] (⌚..⌛)\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []generate.Sequence{
+		{
+			Emoji:  "#\ufe0f\u20e3",
+			Status: generate.FullyQualified,
+			Name:   "keycap: #",
+		},
+	}
+	if !reflect.DeepEqual(expected, sequences) {
+		t.Errorf("%+q != %+q", expected, sequences)
+	}
+}
+
+func TestLocaleData(t *testing.T) {
+	f, err := os.Open(filepath.Join("testdata", "annotations", "de", "annotations.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	a, err := generate.ParseAnnotations(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	buf, err := generate.LocaleData([]string{"de"}, []*generate.Annotations{a})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	checkGolden(t, "emoji_locale_data.go", buf)
+}
+
+func TestParseError(t *testing.T) {
+	if _, err := generate.ParseEmoji(strings.NewReader(`{"emoji": "😀"}`)); err == nil {
+		t.Errorf("ParseEmoji: expected an error")
+	}
+	if _, err := generate.ParseAnnotations(strings.NewReader(`[]`)); err == nil {
+		t.Errorf("ParseAnnotations: expected an error")
+	}
+	if _, err := generate.ParseEmojiTest(strings.NewReader("1F600 ; fully-qualified\n")); err == nil {
+		t.Errorf("ParseEmojiTest: expected an error")
+	}
+	if _, err := generate.ParseSequences(strings.NewReader("1F600 ; Basic_Emoji\n")); err == nil {
+		t.Errorf("ParseSequences: expected an error")
+	}
+}
@@ -0,0 +1,253 @@
+package generate
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Qualification statuses used in emoji-test.txt. Sequences read from the
+// emoji-sequences files are always FullyQualified.
+const (
+	Component          = "component"
+	FullyQualified     = "fully-qualified"
+	MinimallyQualified = "minimally-qualified"
+	Unqualified        = "unqualified"
+)
+
+// Sequence is an emoji listed in one of the Unicode consortium's emoji data
+// files.
+type Sequence struct {
+	Emoji  string
+	Status string
+	Name   string
+
+	// Group is the group heading in emoji-test.txt, such as Food & Drink.
+	// It is empty for sequences read by ParseSequences.
+	Group string
+}
+
+// unicodeCategories maps the groups in emoji-test.txt to gemoji's categories.
+var unicodeCategories = map[string]string{
+	"Smileys & Emotion": "People",
+	"People & Body":     "People",
+	"Animals & Nature":  "Nature",
+	"Food & Drink":      "Foods",
+	"Travel & Places":   "Places",
+	"Activities":        "Activity",
+	"Objects":           "Objects",
+	"Symbols":           "Symbols",
+	"Flags":             "Flags",
+}
+
+// ParseEmojiTest reads the Unicode consortium's emoji-test.txt, such as
+// https://unicode.org/Public/emoji/latest/emoji-test.txt.
+func ParseEmojiTest(r io.Reader) ([]Sequence, error) {
+	var sequences []Sequence
+	var group string
+
+	err := parseLines(r, func(line string) error {
+		if strings.HasPrefix(line, "# group:") {
+			group = strings.TrimSpace(strings.TrimPrefix(line, "# group:"))
+			return nil
+		}
+
+		fields, comment := splitLine(line)
+		if len(fields) == 0 {
+			return nil
+		}
+		if len(fields) != 2 {
+			return fmt.Errorf("expected 2 fields, found %d", len(fields))
+		}
+
+		s, err := parseCodePoints(fields[0])
+		if err != nil {
+			return err
+		}
+
+		// The comment is the emoji, the version it was added in, and
+		// its name.
+		parts := strings.SplitN(comment, " ", 3)
+		if len(parts) != 3 {
+			return fmt.Errorf("missing name in comment %q", comment)
+		}
+
+		sequences = append(sequences, Sequence{
+			Emoji:  s,
+			Status: fields[1],
+			Name:   unescapeName(parts[2]),
+			Group:  group,
+		})
+		return nil
+	})
+
+	return sequences, err
+}
+
+// ParseSequences reads one of the Unicode consortium's emoji sequence files,
+// such as emoji-zwj-sequences.txt or emoji-sequences.txt. Ranges of code
+// points are not supported, so single code points listed as ranges in
+// emoji-sequences.txt are skipped.
+func ParseSequences(r io.Reader) ([]Sequence, error) {
+	var sequences []Sequence
+
+	err := parseLines(r, func(line string) error {
+		fields, _ := splitLine(line)
+		if len(fields) == 0 || strings.Contains(fields[0], "..") {
+			return nil
+		}
+		if len(fields) != 3 {
+			return fmt.Errorf("expected 3 fields, found %d", len(fields))
+		}
+
+		s, err := parseCodePoints(fields[0])
+		if err != nil {
+			return err
+		}
+
+		sequences = append(sequences, Sequence{
+			Emoji:  s,
+			Status: FullyQualified,
+			Name:   unescapeName(fields[2]),
+		})
+		return nil
+	})
+
+	return sequences, err
+}
+
+// Merge adds the sequences to allEmoji. Fully-qualified sequences that are not
+// in allEmoji are added with their Unicode names and no aliases or tags.
+// Emoji in allEmoji are changed to their fully-qualified forms, and their
+// previous and minimally-qualified forms are kept as Variants. Components and
+// unqualified sequences are ignored.
+func Merge(allEmoji []Emoji, sequences []Sequence) []Emoji {
+	merged := make([]Emoji, len(allEmoji), len(allEmoji)+len(sequences))
+	copy(merged, allEmoji)
+
+	index := make(map[string]int)
+	for i, e := range merged {
+		if e.Emoji != "" {
+			index[unqualify(e.Emoji)] = i
+		}
+	}
+
+	for _, s := range sequences {
+		if s.Status != FullyQualified {
+			continue
+		}
+
+		key := unqualify(s.Emoji)
+		i, ok := index[key]
+		if !ok {
+			category, ok := unicodeCategories[s.Group]
+			if !ok {
+				category = s.Group
+			}
+			index[key] = len(merged)
+			merged = append(merged, Emoji{
+				Emoji:       s.Emoji,
+				Description: s.Name,
+				Category:    category,
+			})
+			continue
+		}
+
+		if e := &merged[i]; e.Emoji != s.Emoji {
+			e.Variants = append(e.Variants[:len(e.Variants):len(e.Variants)], e.Emoji)
+			e.Emoji = s.Emoji
+		}
+	}
+
+	for _, s := range sequences {
+		if s.Status != MinimallyQualified {
+			continue
+		}
+
+		if i, ok := index[unqualify(s.Emoji)]; ok {
+			e := &merged[i]
+			if e.Emoji != s.Emoji && !contains(e.Variants, s.Emoji) {
+				e.Variants = append(e.Variants[:len(e.Variants):len(e.Variants)], s.Emoji)
+			}
+		}
+	}
+
+	return merged
+}
+
+func parseLines(r io.Reader, f func(line string) error) error {
+	scanner := bufio.NewScanner(r)
+	for n := 1; scanner.Scan(); n++ {
+		if err := f(scanner.Text()); err != nil {
+			return fmt.Errorf("line %d: %v", n, err)
+		}
+	}
+	return scanner.Err()
+}
+
+// splitLine returns the semicolon-separated fields of line and the comment
+// after #, with surrounding spaces removed.
+func splitLine(line string) (fields []string, comment string) {
+	if i := strings.IndexByte(line, '#'); i != -1 {
+		line, comment = line[:i], strings.TrimSpace(line[i+1:])
+	}
+	if strings.TrimSpace(line) == "" {
+		return nil, comment
+	}
+
+	fields = strings.Split(line, ";")
+	for i := range fields {
+		fields[i] = strings.TrimSpace(fields[i])
+	}
+	return fields, comment
+}
+
+// parseCodePoints converts space-separated hexadecimal code points to a
+// string.
+func parseCodePoints(s string) (string, error) {
+	var buf []rune
+	for _, cp := range strings.Fields(s) {
+		r, err := strconv.ParseUint(cp, 16, 32)
+		if err != nil {
+			return "", fmt.Errorf("invalid code point %q", cp)
+		}
+		buf = append(buf, rune(r))
+	}
+	return string(buf), nil
+}
+
+// unescapeName replaces escapes such as \x{23} in names with the characters
+// they represent.
+func unescapeName(name string) string {
+	for {
+		i := strings.Index(name, `\x{`)
+		if i == -1 {
+			return name
+		}
+		j := strings.IndexByte(name[i:], '}')
+		if j == -1 {
+			return name
+		}
+		r, err := strconv.ParseUint(name[i+3:i+j], 16, 32)
+		if err != nil {
+			return name
+		}
+		name = name[:i] + string(rune(r)) + name[i+j+1:]
+	}
+}
+
+// unqualify removes emoji presentation selectors from s.
+func unqualify(s string) string {
+	return strings.Replace(s, "\ufe0f", "", -1)
+}
+
+func contains(list []string, s string) bool {
+	for _, l := range list {
+		if l == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,154 @@
+package emoji
+
+import (
+	"strings"
+	"sync"
+)
+
+type annotation struct {
+	description string
+	keywords    []string
+}
+
+// localeAnnotations is the default annotations for one locale, decoded from
+// its part of localeData the first time they are needed.
+type localeAnnotations struct {
+	once    sync.Once
+	data    string
+	byEmoji map[string]*annotation
+}
+
+var (
+	localesOnce sync.Once
+	localesData map[string]*localeAnnotations
+)
+
+// AddAnnotation adds a localised description and keywords for an emoji. The
+// name may be a Unicode emoji or a shortcode (such as :trollface:) from either
+// the default set or this Config. Annotations added this way take precedence
+// over the default annotations for the same locale.
+func (conf *Config) AddAnnotation(locale, name, description string, keywords []string) {
+	if locale == "" {
+		panic("emoji: locale cannot be empty string")
+	}
+	e := conf.get(name)
+
+	locale = canonicalLocale(locale)
+	if conf.annotations == nil {
+		conf.annotations = make(map[string]map[*emoji]*annotation)
+	}
+	if conf.annotations[locale] == nil {
+		conf.annotations[locale] = make(map[*emoji]*annotation)
+	}
+	conf.annotations[locale][e] = &annotation{
+		description: description,
+		keywords:    keywords,
+	}
+}
+
+// annotation returns the annotation for e in the Config's locale, or nil if
+// there is none.
+func (conf *Config) annotation(e *emoji) *annotation {
+	if conf.Locale == "" {
+		return nil
+	}
+
+	for locale := canonicalLocale(conf.Locale); locale != ""; locale = parentLocale(locale) {
+		if a, ok := conf.annotations[locale][e]; ok {
+			return a
+		}
+		if e.emoji != "" {
+			if a, ok := defaultAnnotations(locale)[strings.Replace(e.emoji, "\ufe0f", "", -1)]; ok {
+				return a
+			}
+		}
+	}
+
+	return nil
+}
+
+// defaultAnnotations returns the default annotations for locale, keyed by
+// emoji without variation selectors, or nil if there are none.
+func defaultAnnotations(locale string) map[string]*annotation {
+	localesOnce.Do(func() {
+		localesData = decodeLocales(localeData)
+	})
+
+	l, ok := localesData[locale]
+	if !ok {
+		return nil
+	}
+	l.once.Do(func() {
+		l.byEmoji = decodeAnnotations(l.data)
+		l.data = ""
+	})
+	return l.byEmoji
+}
+
+// decodeLocales splits the default annotations into locales without decoding
+// them. The data is in the format described by decodeData:
+//
+//	number of locales
+//	for each locale:
+//		name
+//		annotations, as a string
+func decodeLocales(data string) map[string]*localeAnnotations {
+	d := &dataDecoder{data: data}
+	n := d.uvarint()
+	locales := make(map[string]*localeAnnotations, n)
+	for ; n > 0; n-- {
+		name := d.string()
+		locales[name] = &localeAnnotations{data: d.string()}
+	}
+	if d.data != "" {
+		panic("emoji: invalid emoji data")
+	}
+	return locales
+}
+
+// decodeAnnotations decodes the annotations for one locale:
+//
+//	number of annotations
+//	for each annotation:
+//		emoji, description
+//		number of keywords, keywords
+func decodeAnnotations(data string) map[string]*annotation {
+	d := &dataDecoder{data: data}
+	n := d.uvarint()
+	byEmoji := make(map[string]*annotation, n)
+	for ; n > 0; n-- {
+		e := d.string()
+		byEmoji[e] = &annotation{
+			description: d.string(),
+			keywords:    d.strings(),
+		}
+	}
+	if d.data != "" {
+		panic("emoji: invalid emoji data")
+	}
+	return byEmoji
+}
+
+// description returns the localised description of e if there is one, or the
+// English description otherwise.
+func (conf *Config) description(e *emoji) string {
+	if a := conf.annotation(e); a != nil && a.description != "" {
+		return a.description
+	}
+	return e.description
+}
+
+// canonicalLocale converts a locale such as pt_BR to the form used by CLDR
+// (pt-BR).
+func canonicalLocale(locale string) string {
+	return strings.Replace(locale, "_", "-", -1)
+}
+
+// parentLocale returns the locale with its last subtag removed, or the empty
+// string if there is only one subtag.
+func parentLocale(locale string) string {
+	if i := strings.LastIndexByte(locale, '-'); i != -1 {
+		return locale[:i]
+	}
+	return ""
+}
@@ -0,0 +1,152 @@
+package emoji_test
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"golang.org/x/net/html"
+
+	"github.com/BenLubar/hellstew/emoji"
+)
+
+func localeConfig(locale string) *emoji.Config {
+	conf := &emoji.Config{Locale: locale}
+
+	conf.AddImage("https://assets-cdn.github.com/images/icons/emoji/trollface.png", "trollface", []string{"trollface"}, "GitHub", nil)
+	conf.AddAnnotation("de", "🏇", "Pferderennen", []string{"Pferd", "Rennen", "Jockey"})
+	conf.AddAnnotation("de", ":trollface:", "Trollgesicht", []string{"Troll"})
+	conf.AddAnnotation("pt", "🍿", "pipoca", nil)
+
+	return conf
+}
+
+func TestLocale(t *testing.T) {
+	t.Run("Search", func(t *testing.T) {
+		results := localeConfig("de").Search("pferd", 5)
+		if len(results) == 0 {
+			t.Fatal("no results for pferd")
+		}
+		if expected, actual := "🏇", results[0].Emoji(); expected != actual {
+			t.Errorf("Emoji: %q != %q", expected, actual)
+		}
+		if expected, actual := "horse racing", results[0].Description(); expected != actual {
+			t.Errorf("Description: %q != %q", expected, actual)
+		}
+		if expected, actual := "Pferderennen", results[0].LocalDescription(); expected != actual {
+			t.Errorf("LocalDescription: %q != %q", expected, actual)
+		}
+		if expected, actual := []string{"Pferd", "Rennen", "Jockey"}, results[0].Keywords(); !reflect.DeepEqual(expected, actual) {
+			t.Errorf("Keywords: %#v != %#v", expected, actual)
+		}
+	})
+
+	t.Run("SearchKeyword", func(t *testing.T) {
+		results := localeConfig("de").Search("jockey", 5)
+		if len(results) != 1 {
+			t.Fatalf("unexpected len(results) == %d", len(results))
+		}
+		if expected, actual := "🏇", results[0].Emoji(); expected != actual {
+			t.Errorf("Emoji: %q != %q", expected, actual)
+		}
+	})
+
+	t.Run("SearchDefault", func(t *testing.T) {
+		if !emoji.HasDefaultAnnotations("de") {
+			t.Skip("emoji_locale_data.go has no annotations for de; run go generate")
+		}
+
+		results := (&emoji.Config{Locale: "de"}).Search("hund", 10)
+		found := false
+		for _, r := range results {
+			if r.Emoji() == "🐕" {
+				found = true
+				if expected, actual := "Hund", r.LocalDescription(); expected != actual {
+					t.Errorf("LocalDescription: %q != %q", expected, actual)
+				}
+			}
+		}
+		if !found {
+			t.Errorf("🐕 not found in results for hund: %v", results)
+		}
+	})
+
+	t.Run("SearchOtherLocale", func(t *testing.T) {
+		results := localeConfig("ja").Search("pferd", 5)
+		if len(results) != 0 {
+			t.Errorf("unexpected len(results) == %d", len(results))
+		}
+
+		results = localeConfig("").Search("horse racing", 1)
+		if len(results) != 1 {
+			t.Fatalf("unexpected len(results) == %d", len(results))
+		}
+		if expected, actual := "horse racing", results[0].LocalDescription(); expected != actual {
+			t.Errorf("LocalDescription: %q != %q", expected, actual)
+		}
+		if actual := results[0].Keywords(); actual != nil {
+			t.Errorf("Keywords: %#v != nil", actual)
+		}
+	})
+
+	t.Run("Replace", func(t *testing.T) {
+		for _, tt := range []struct {
+			locale string
+			input  string
+			output string
+		}{
+			{"de", ":horse_racing:", `<abbr class="emoji" title="Pferderennen">🏇</abbr>`},
+			{"de-AT", "🏇", `<abbr class="emoji" title="Pferderennen">🏇</abbr>`},
+			{"de", ":trollface:", `<img src="https://assets-cdn.github.com/images/icons/emoji/trollface.png" alt=":trollface:" class="emoji" title="Trollgesicht"/>`},
+			{"pt_BR", ":popcorn:", `<abbr class="emoji" title="pipoca">🍿</abbr>`},
+			{"ja", ":popcorn:", `<abbr class="emoji" title="popcorn">🍿</abbr>`},
+			{"", ":horse_racing:", `<abbr class="emoji" title="horse racing">🏇</abbr>`},
+		} {
+			nodes := localeConfig(tt.locale).Replace(&html.Node{
+				Type: html.TextNode,
+				Data: tt.input,
+			})
+
+			var buf bytes.Buffer
+			for _, n := range nodes {
+				if err := html.Render(&buf, n); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			if output := buf.String(); tt.output != output {
+				t.Errorf("locale %q input %q\nexpected %q\nactual   %q", tt.locale, tt.input, tt.output, output)
+			}
+		}
+	})
+
+	t.Run("EmptyLocale", func(t *testing.T) {
+		defer expectPanic(t, "emoji: locale cannot be empty string")
+
+		localeConfig("de").AddAnnotation("", "🏇", "Pferderennen", nil)
+	})
+
+	t.Run("NotDefined", func(t *testing.T) {
+		defer expectPanic(t, "emoji: not defined: :partyparrot:")
+
+		localeConfig("de").AddAnnotation("de", ":partyparrot:", "Partypapagei", nil)
+	})
+}
+
+func TestDecodeAnnotations(t *testing.T) {
+	// The output of generate.LocaleData for testdata/annotations/de.
+	const data = "" +
+		"\x01" +
+		"\x02deW" +
+		"\x02" +
+		"\x03⁉\x19Ausrufe- und Fragezeichen\x02\x0eAusrufezeichen\fFragezeichen" +
+		"\x04🍿\aPopcorn\x02\x04Kino\aPopcorn"
+
+	description, keywords, ok := emoji.DecodeAnnotation(data, "de", "🍿")
+	if !ok || description != "Popcorn" || !reflect.DeepEqual(keywords, []string{"Kino", "Popcorn"}) {
+		t.Errorf("unexpected annotation %q %q %v", description, keywords, ok)
+	}
+	if _, _, ok = emoji.DecodeAnnotation(data, "fr", "🍿"); ok {
+		t.Errorf("unexpected annotation for fr")
+	}
+}
@@ -0,0 +1,162 @@
+package markdown
+
+import (
+	"io"
+
+	"github.com/russross/blackfriday/v2"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+
+	"github.com/BenLubar/hellstew/emoji"
+)
+
+// Blackfriday wraps a blackfriday renderer, replacing Unicode emoji,
+// shortcodes, and emoticons in text using conf. Code spans, code blocks, and
+// raw HTML are left unchanged, and emoji in the alt text of images are
+// replaced with plain text as by conf.ReplaceText. If conf is nil, the default
+// emoji set is used.
+//
+// Each piece of text is passed to conf.Replace, so the emoji, Locale,
+// CaseSensitive, WordBoundary, SkipURLs, UnknownShortcode, and SpritePrefix
+// options are honoured. The options that depend on HTML elements, such as
+// SkipElements, SkipClasses, and SkipAutolinks, are not. JumboClass is added
+// to the emoji in paragraphs, headings, and table cells that contain only
+// emoji.
+func Blackfriday(r blackfriday.Renderer, conf *emoji.Config) blackfriday.Renderer {
+	if conf == nil {
+		conf = &emoji.Config{}
+	}
+	return &blackfridayRenderer{
+		Renderer: r,
+		conf:     conf,
+	}
+}
+
+// jumboBlock lists the blackfriday nodes whose contents are checked
+// separately for emoji.Config.JumboClass.
+var jumboBlock = map[blackfriday.NodeType]bool{
+	blackfriday.Paragraph: true,
+	blackfriday.Heading:   true,
+	blackfriday.TableCell: true,
+}
+
+type blackfridayRenderer struct {
+	blackfriday.Renderer
+	conf *emoji.Config
+
+	// block is the last node checked by jumbo, and isJumbo is the result.
+	block   *blackfriday.Node
+	isJumbo bool
+}
+
+// RenderNode implements blackfriday.Renderer.
+func (r *blackfridayRenderer) RenderNode(w io.Writer, node *blackfriday.Node, entering bool) blackfriday.WalkStatus {
+	if node.Type != blackfriday.Text || len(node.Literal) == 0 {
+		return r.Renderer.RenderNode(w, node, entering)
+	}
+
+	if inImage(node) {
+		text := *node
+		text.Literal = []byte(r.conf.ReplaceText(string(node.Literal)))
+		return r.Renderer.RenderNode(w, &text, entering)
+	}
+
+	nodes := r.conf.ReplacePart(r.jumbo(node), &html.Node{
+		Type: html.TextNode,
+		Data: string(node.Literal),
+	})
+
+	status := blackfriday.GoToNext
+	for _, n := range nodes {
+		if n.Type == html.TextNode {
+			// Let the wrapped renderer escape the text and apply any
+			// typographic substitutions.
+			text := *node
+			text.Literal = []byte(n.Data)
+			status = r.Renderer.RenderNode(w, &text, entering)
+		} else {
+			// blackfriday.Renderer has no way to report write errors.
+			_ = html.Render(w, n)
+		}
+	}
+
+	return status
+}
+
+// jumbo reports whether the emoji in node should be given the JumboClass,
+// which depends on the paragraph, heading, or table cell that contains it.
+func (r *blackfridayRenderer) jumbo(node *blackfriday.Node) bool {
+	if r.conf.JumboClass == "" {
+		return false
+	}
+
+	block := node.Parent
+	for block != nil && !jumboBlock[block.Type] {
+		block = block.Parent
+	}
+	if block == nil {
+		return false
+	}
+
+	if block != r.block {
+		r.block = block
+		r.isJumbo = jumboNodes(r.conf, block)
+	}
+	return r.isJumbo
+}
+
+// jumboNodes converts the text, code spans, and images in block to HTML and
+// checks them with conf.Jumbo. Raw HTML is not parsed, so a block containing
+// it is never jumbo.
+func jumboNodes(conf *emoji.Config, block *blackfriday.Node) bool {
+	var nodes []*html.Node
+	ok := true
+	block.Walk(func(n *blackfriday.Node, entering bool) blackfriday.WalkStatus {
+		if !entering {
+			return blackfriday.GoToNext
+		}
+
+		switch n.Type {
+		case blackfriday.Text:
+			nodes = append(nodes, &html.Node{
+				Type: html.TextNode,
+				Data: string(n.Literal),
+			})
+		case blackfriday.Code:
+			code := &html.Node{
+				Type:     html.ElementNode,
+				Data:     "code",
+				DataAtom: atom.Code,
+			}
+			code.AppendChild(&html.Node{
+				Type: html.TextNode,
+				Data: string(n.Literal),
+			})
+			nodes = append(nodes, code)
+		case blackfriday.Image:
+			nodes = append(nodes, &html.Node{
+				Type:     html.ElementNode,
+				Data:     "img",
+				DataAtom: atom.Img,
+			})
+			return blackfriday.SkipChildren
+		case blackfriday.HTMLSpan:
+			ok = false
+			return blackfriday.Terminate
+		}
+		return blackfriday.GoToNext
+	})
+
+	return ok && conf.Jumbo(nodes...)
+}
+
+// inImage reports whether node is part of the alt text of an image, which
+// cannot contain HTML elements.
+func inImage(node *blackfriday.Node) bool {
+	for p := node.Parent; p != nil; p = p.Parent {
+		if p.Type == blackfriday.Image {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,220 @@
+// Package markdown replaces emoji and emoji shortcodes while rendering
+// Markdown, without parsing the rendered HTML again. Each emoji is rendered
+// the same way as by emoji.Config.Replace, but the renderers only see part of
+// the document at a time, so not every Config option applies. See Blackfriday
+// and Goldmark for the options that each renderer honours.
+package markdown
+
+import (
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/renderer"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+	"golang.org/x/net/html"
+
+	"github.com/BenLubar/hellstew/emoji"
+)
+
+// KindEmoji is the goldmark NodeKind of Emoji.
+var KindEmoji = ast.NewNodeKind("Emoji")
+
+// Emoji is a goldmark AST node representing a Unicode emoji or shortcode. It
+// has a String child containing its plain text, as returned by
+// emoji.Config.ReplaceText, which is used where HTML cannot be, such as in the
+// alt text of images.
+type Emoji struct {
+	ast.BaseInline
+
+	// Name is the text that was matched, such as :tada: or 🎉.
+	Name string
+}
+
+// Kind implements ast.Node.
+func (n *Emoji) Kind() ast.NodeKind {
+	return KindEmoji
+}
+
+// Dump implements ast.Node.
+func (n *Emoji) Dump(source []byte, level int) {
+	ast.DumpHelper(n, source, level, map[string]string{
+		"Name": n.Name,
+	}, nil)
+}
+
+// newEmoji returns an Emoji node for name, with its plain text from conf.
+func newEmoji(conf *emoji.Config, name string) *Emoji {
+	n := &Emoji{Name: name}
+	n.AppendChild(n, ast.NewString([]byte(conf.ReplaceText(name))))
+	return n
+}
+
+// Goldmark returns a goldmark extension that replaces Unicode emoji and
+// shortcodes in text using conf. Code spans, code blocks, and raw HTML are
+// left unchanged. If conf is nil, the default emoji set is used.
+//
+// Emoji are found with conf.MatchPrefix, so the emoji, Locale,
+// CaseSensitive, WordBoundary, and SpritePrefix options are honoured.
+// Emoticons are not replaced, and the SkipURLs, UnknownShortcode, and
+// JumboClass options and the options that depend on HTML elements, such as
+// SkipElements and SkipClasses, are not honoured.
+func Goldmark(conf *emoji.Config) goldmark.Extender {
+	if conf == nil {
+		conf = &emoji.Config{}
+	}
+	return &goldmarkExtension{conf: conf}
+}
+
+type goldmarkExtension struct {
+	conf *emoji.Config
+}
+
+// Extend implements goldmark.Extender.
+func (e *goldmarkExtension) Extend(m goldmark.Markdown) {
+	m.Parser().AddOptions(
+		parser.WithInlineParsers(
+			util.Prioritized(&goldmarkParser{conf: e.conf, trigger: ':'}, 999),
+			// *️⃣ is parsed before emphasis, which would otherwise
+			// take the asterisk.
+			util.Prioritized(&goldmarkParser{conf: e.conf, trigger: '*'}, 499),
+		),
+		parser.WithASTTransformers(
+			util.Prioritized(&goldmarkTransformer{conf: e.conf}, 999),
+		),
+	)
+	m.Renderer().AddOptions(renderer.WithNodeRenderers(
+		util.Prioritized(&goldmarkRenderer{conf: e.conf}, 500),
+	))
+}
+
+type goldmarkParser struct {
+	conf    *emoji.Config
+	trigger byte
+}
+
+// Trigger implements parser.InlineParser.
+func (p *goldmarkParser) Trigger() []byte {
+	return []byte{p.trigger}
+}
+
+// Parse implements parser.InlineParser. Shortcodes are parsed inline so that
+// underscores in them are not mistaken for emphasis.
+func (p *goldmarkParser) Parse(parent ast.Node, block text.Reader, pc parser.Context) ast.Node {
+	line, _ := block.PeekLine()
+
+	n := p.conf.MatchPrefix(util.BytesToReadOnlyString(line))
+	if n == 0 {
+		return nil
+	}
+
+	if p.conf.WordBoundary && line[0] == ':' {
+		if r := block.PrecendingCharacter(); unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return nil
+		}
+		if r, _ := utf8.DecodeRune(line[n:]); n < len(line) && (unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			return nil
+		}
+	}
+
+	block.Advance(n)
+	return newEmoji(p.conf, string(line[:n]))
+}
+
+// goldmarkTransformer finds Unicode emoji in text. goldmark only calls inline
+// parsers at punctuation and spaces, so they cannot be parsed inline.
+type goldmarkTransformer struct {
+	conf *emoji.Config
+}
+
+// Transform implements parser.ASTTransformer.
+func (t *goldmarkTransformer) Transform(doc *ast.Document, reader text.Reader, pc parser.Context) {
+	var texts []*ast.Text
+	_ = ast.Walk(doc, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+		switch n := n.(type) {
+		case *ast.CodeSpan:
+			return ast.WalkSkipChildren, nil
+		case *ast.Text:
+			if !n.IsRaw() {
+				texts = append(texts, n)
+			}
+		}
+		return ast.WalkContinue, nil
+	})
+
+	source := reader.Source()
+	for _, n := range texts {
+		t.split(n, source)
+	}
+}
+
+// split inserts an Emoji node before n for each Unicode emoji in its text,
+// along with Text nodes for the text between them. n keeps the text after the
+// last emoji, along with its line break flags.
+func (t *goldmarkTransformer) split(n *ast.Text, source []byte) {
+	parent := n.Parent()
+	value := n.Segment.Value(source)
+	start := 0
+
+	for i := 0; i < len(value); {
+		if value[i] < utf8.RuneSelf && !keycapBase(value[i]) {
+			i++
+			continue
+		}
+
+		m := t.conf.MatchPrefix(util.BytesToReadOnlyString(value[i:]))
+		if m == 0 {
+			_, size := utf8.DecodeRune(value[i:])
+			i += size
+			continue
+		}
+
+		if start != i {
+			parent.InsertBefore(parent, n, ast.NewTextSegment(text.NewSegment(n.Segment.Start+start, n.Segment.Start+i)))
+		}
+		parent.InsertBefore(parent, n, newEmoji(t.conf, string(value[i:i+m])))
+		i += m
+		start = i
+	}
+
+	if start != 0 {
+		n.Segment = n.Segment.WithStart(n.Segment.Start + start)
+	}
+}
+
+// keycapBase reports whether b can start a keycap emoji, such as #️⃣, which is
+// the only kind of Unicode emoji that starts with an ASCII character. *️⃣ is
+// normally parsed inline before emphasis, so it is only seen here if the
+// asterisk was left as text.
+func keycapBase(b byte) bool {
+	return b == '#' || b == '*' || ('0' <= b && b <= '9')
+}
+
+type goldmarkRenderer struct {
+	conf *emoji.Config
+}
+
+// RegisterFuncs implements renderer.NodeRenderer.
+func (r *goldmarkRenderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
+	reg.Register(KindEmoji, r.renderEmoji)
+}
+
+func (r *goldmarkRenderer) renderEmoji(w util.BufWriter, source []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	if !entering {
+		return ast.WalkContinue, nil
+	}
+
+	name := node.(*Emoji).Name
+	if n := r.conf.Node(name); n != nil {
+		return ast.WalkSkipChildren, html.Render(w, n)
+	}
+
+	_, err := w.WriteString(html.EscapeString(name))
+	return ast.WalkSkipChildren, err
+}
@@ -0,0 +1,160 @@
+package markdown_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/russross/blackfriday/v2"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/renderer/html"
+
+	"github.com/BenLubar/hellstew/emoji"
+	"github.com/BenLubar/hellstew/emoji/markdown"
+)
+
+type markdownTest struct {
+	name   string
+	input  string
+	output string
+}
+
+var markdownTests = [...]markdownTest{
+	{
+		name:   "PlainText",
+		input:  "Hello, world!",
+		output: "<p>Hello, world!</p>\n",
+	},
+	{
+		name:   "Shortcode",
+		input:  "To the :popcorn: thread!",
+		output: "<p>To the <abbr class=\"emoji\" title=\"popcorn\">🍿</abbr> thread!</p>\n",
+	},
+	{
+		name:   "Unicode",
+		input:  "*To the 🍿 thread!*",
+		output: "<p><em>To the <abbr class=\"emoji\" title=\"popcorn\">🍿</abbr> thread!</em></p>\n",
+	},
+	{
+		name:   "Underscore",
+		input:  ":smile_cat: and :horse_racing:",
+		output: "<p><abbr class=\"emoji\" title=\"grinning cat face with smiling eyes\">😸</abbr> and <abbr class=\"emoji\" title=\"horse racing\">🏇</abbr></p>\n",
+	},
+	{
+		name:   "CodeSpan",
+		input:  "`:tangerine: 🍊` :tangerine:\n🍊",
+		output: "<p><code>:tangerine: 🍊</code> <abbr class=\"emoji\" title=\"tangerine\">🍊</abbr>\n<abbr class=\"emoji\" title=\"tangerine\">🍊</abbr></p>\n",
+	},
+	{
+		name:   "FencedCode",
+		input:  "```\n:tangerine: 🍊\n```\n",
+		output: "<pre><code>:tangerine: 🍊\n</code></pre>\n",
+	},
+	{
+		name:   "Garbage",
+		input:  "it’s :po:popcor:corn:n: &amp; more",
+		output: "<p>it’s :po:popcor<abbr class=\"emoji\" title=\"ear of corn\">🌽</abbr>n: &amp; more</p>\n",
+	},
+	{
+		name:   "Custom",
+		input:  "# :wtf:",
+		output: "<h1><img src=\"http://thedailywtf.com/favicon.ico\" alt=\":wtf:\" class=\"emoji\" title=\"wtf\"/></h1>\n",
+	},
+	{
+		name:   "Keycap",
+		input:  "#️⃣ and 1️⃣ or *️⃣ 10 *a*",
+		output: "<p><abbr class=\"emoji\" title=\"keycap: #\">#️⃣</abbr> and <abbr class=\"emoji\" title=\"keycap: 1\">1️⃣</abbr> or <abbr class=\"emoji\" title=\"keycap: *\">*️⃣</abbr> 10 <em>a</em></p>\n",
+	},
+	{
+		name:   "ImageAlt",
+		input:  "![:smile: 🍿 :wtf:](x.png)",
+		output: "<p><img src=\"x.png\" alt=\"😄 🍿 :wtf:\" /></p>\n",
+	},
+}
+
+var markdownConfig = func() *emoji.Config {
+	var conf emoji.Config
+
+	conf.AddImage("http://thedailywtf.com/favicon.ico", "wtf", []string{"wtf"}, "The Daily WTF", nil)
+
+	return &conf
+}()
+
+func TestGoldmark(t *testing.T) {
+	md := goldmark.New(
+		goldmark.WithExtensions(markdown.Goldmark(markdownConfig)),
+		goldmark.WithRendererOptions(html.WithXHTML()),
+	)
+
+	for _, tt := range markdownTests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := md.Convert([]byte(tt.input), &buf); err != nil {
+				t.Fatal(err)
+			}
+
+			if output := buf.String(); tt.output != output {
+				t.Errorf("input %q\nexpected %q\nactual   %q", tt.input, tt.output, output)
+			}
+		})
+	}
+}
+
+func TestGoldmarkWordBoundary(t *testing.T) {
+	md := goldmark.New(goldmark.WithExtensions(markdown.Goldmark(&emoji.Config{WordBoundary: true})))
+
+	var buf bytes.Buffer
+	if err := md.Convert([]byte("10:30:ok: :ok:"), &buf); err != nil {
+		t.Fatal(err)
+	}
+
+	if expected, output := "<p>10:30:ok: <abbr class=\"emoji\" title=\"OK button\">🆗</abbr></p>\n", buf.String(); expected != output {
+		t.Errorf("expected %q\nactual   %q", expected, output)
+	}
+}
+
+func TestBlackfriday(t *testing.T) {
+	for _, tt := range markdownTests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			renderer := markdown.Blackfriday(blackfriday.NewHTMLRenderer(blackfriday.HTMLRendererParameters{}), markdownConfig)
+			output := string(blackfriday.Run([]byte(tt.input), blackfriday.WithRenderer(renderer)))
+
+			if tt.output != output {
+				t.Errorf("input %q\nexpected %q\nactual   %q", tt.input, tt.output, output)
+			}
+		})
+	}
+}
+
+func TestBlackfridayJumbo(t *testing.T) {
+	conf := &emoji.Config{JumboClass: "emoji-jumbo", JumboMax: 2}
+
+	for _, tt := range []markdownTest{
+		{
+			name:   "Paragraph",
+			input:  "**🎉** :tada:\n\nhi **🎉**",
+			output: "<p><strong><abbr class=\"emoji emoji-jumbo\" title=\"party popper\">🎉</abbr></strong> <abbr class=\"emoji emoji-jumbo\" title=\"party popper\">🎉</abbr></p>\n\n<p>hi <strong><abbr class=\"emoji\" title=\"party popper\">🎉</abbr></strong></p>\n",
+		},
+		{
+			name:   "TooMany",
+			input:  "🎉 *🎉* 🎉",
+			output: "<p><abbr class=\"emoji\" title=\"party popper\">🎉</abbr> <em><abbr class=\"emoji\" title=\"party popper\">🎉</abbr></em> <abbr class=\"emoji\" title=\"party popper\">🎉</abbr></p>\n",
+		},
+		{
+			name:   "Code",
+			input:  "# 🎉 `x`",
+			output: "<h1><abbr class=\"emoji\" title=\"party popper\">🎉</abbr> <code>x</code></h1>\n",
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			renderer := markdown.Blackfriday(blackfriday.NewHTMLRenderer(blackfriday.HTMLRendererParameters{}), conf)
+			output := string(blackfriday.Run([]byte(tt.input), blackfriday.WithRenderer(renderer)))
+
+			if tt.output != output {
+				t.Errorf("input %q\nexpected %q\nactual   %q", tt.input, tt.output, output)
+			}
+		})
+	}
+}
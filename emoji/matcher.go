@@ -0,0 +1,334 @@
+package emoji
+
+import "sync"
+
+// matcher finds the names in a trie that occur in a string. The trie is used
+// directly to match prefixes; finding every name in a string uses an
+// Aho-Corasick automaton, which is built the first time it is needed.
+//
+// The automaton is built from the reversed names and run over the string from
+// end to start. At each offset, the names that start there are then found
+// longest first by following dictionary links, so leftmost-longest matching
+// takes time linear in the length of the string plus the number of names that
+// are rejected, no matter how many names share a prefix.
+//
+// A matcher may extend a base matcher, which is shared with other matchers.
+// The trie and the automaton then only hold the names added to this matcher,
+// so a Config with a few custom emoji does not need its own copy of the
+// default set. The matches of the two automata are merged.
+type matcher struct {
+	root *state
+	base *matcher
+
+	// exact is set if the names in the trie are not folded.
+	exact bool
+
+	once      sync.Once
+	automaton acNodes
+}
+
+// acNode is a node of the automaton. Node 0 is the root.
+type acNode struct {
+	edges []acEdge
+
+	// fail is the node for the longest proper suffix of this node's string
+	// that is also in the automaton.
+	fail int32
+
+	// out is the longest name that is a suffix of this node's string
+	// (possibly the whole string), or -1 if there is none.
+	out int32
+
+	// dict is the next shorter name after out that is a suffix of this
+	// node's string, or -1. It is only meaningful for nodes that are names.
+	dict int32
+
+	depth int32
+}
+
+type acEdge struct {
+	b    byte
+	next int32
+}
+
+var (
+	startMatcherOnce sync.Once
+	startMatcherData *matcher
+
+	exactStartMatcherOnce sync.Once
+	exactStartMatcherData *matcher
+)
+
+// startMatcher returns the matcher for the folded names in the default set.
+func startMatcher() *matcher {
+	startMatcherOnce.Do(func() {
+		startMatcherData = &matcher{root: startState()}
+	})
+	return startMatcherData
+}
+
+// exactStartMatcher returns the matcher for the names in the default set, as
+// used by case-sensitive Configs.
+func exactStartMatcher() *matcher {
+	exactStartMatcherOnce.Do(func() {
+		var root state
+		for name := range defaults().byName {
+			root.addInPlace(name)
+		}
+		exactStartMatcherData = &matcher{root: &root, exact: true}
+	})
+	return exactStartMatcherData
+}
+
+// extend returns an empty matcher that extends m. m must not extend another
+// matcher.
+func (m *matcher) extend() *matcher {
+	if m.base != nil {
+		panic("emoji: internal error: matcher already extends another matcher")
+	}
+	return &matcher{root: &state{}, base: m, exact: m.exact}
+}
+
+// add returns a matcher that also matches name. m is not modified.
+func (m *matcher) add(name string) *matcher {
+	return &matcher{root: m.root.add(name), base: m.base, exact: m.exact}
+}
+
+// matchPrefix returns the length in bytes of the longest name at the start of
+// str, or 0 if there is none.
+func (m *matcher) matchPrefix(str string) int {
+	var n int
+	if m.exact {
+		n = m.root.matchPrefixExact(str)
+	} else {
+		n = m.root.matchPrefix(str)
+	}
+	if m.base != nil {
+		if b := m.base.matchPrefix(str); b > n {
+			n = b
+		}
+	}
+	return n
+}
+
+// match returns the start and end of each name in str. Overlapping names are
+// resolved by taking the name that starts first, and the longest name that
+// starts there.
+func (m *matcher) match(str string) [][2]int {
+	return m.matchFunc(str, nil)
+}
+
+// matchFunc is like match, but it only reports matches for which accept
+// returns true. If the longest match at an offset is not accepted, shorter
+// matches at the same offset are tried. A nil accept function accepts every
+// match.
+func (m *matcher) matchFunc(str string, accept func(start, end int) bool) [][2]int {
+	starts := m.starts(str)
+	var baseStarts []acStart
+	var base acNodes
+	if m.base != nil {
+		baseStarts = m.base.starts(str)
+		base = m.base.automaton
+	}
+	nodes := m.automaton
+
+	var matches [][2]int
+	pos := 0
+	for len(starts) != 0 || len(baseStarts) != 0 {
+		// Take the next offset at which a name starts in either
+		// automaton, along with the longest name there in each.
+		i := -1
+		n, b := int32(-1), int32(-1)
+		if k := len(starts) - 1; k >= 0 {
+			i, n = starts[k].offset, starts[k].node
+		}
+		if k := len(baseStarts) - 1; k >= 0 {
+			switch {
+			case i == -1 || baseStarts[k].offset < i:
+				i, n, b = baseStarts[k].offset, -1, baseStarts[k].node
+			case baseStarts[k].offset == i:
+				b = baseStarts[k].node
+			}
+		}
+		if n != -1 {
+			starts = starts[:len(starts)-1]
+		}
+		if b != -1 {
+			baseStarts = baseStarts[:len(baseStarts)-1]
+		}
+		if i < pos {
+			continue
+		}
+
+		// Try the names at i from longest to shortest, following the
+		// dictionary links of both automata.
+		for n != -1 || b != -1 {
+			var depth int32
+			if n != -1 && (b == -1 || nodes[n].depth >= base[b].depth) {
+				depth = nodes[n].depth
+				n = nodes[n].dict
+				if b != -1 && base[b].depth == depth {
+					b = base[b].dict
+				}
+			} else {
+				depth = base[b].depth
+				b = base[b].dict
+			}
+
+			end := i + int(depth)
+			if accept == nil || accept(i, end) {
+				matches = append(matches, [2]int{i, end})
+				pos = end
+				break
+			}
+		}
+	}
+
+	return matches
+}
+
+// acStart is an offset at which a name starts, and the automaton node for the
+// longest name that starts there.
+type acStart struct {
+	offset int
+	node   int32
+}
+
+// starts returns the offsets in str at which the names in m, not including
+// its base, start, from the end of str backwards.
+func (m *matcher) starts(str string) []acStart {
+	m.once.Do(m.build)
+	nodes := m.automaton
+
+	var starts []acStart
+	var s int32
+	for i := len(str) - 1; i >= 0; i-- {
+		s = nodes.next(s, str[i])
+		if out := nodes[s].out; out != -1 {
+			starts = append(starts, acStart{i, out})
+		}
+	}
+	return starts
+}
+
+// build builds the automaton from the reversed names in the trie, not
+// including those in the base matcher.
+func (m *matcher) build() {
+	var names []string
+	size := 1
+
+	var name []byte
+	var walk func(s *state)
+	walk = func(s *state) {
+		if s.term {
+			names = append(names, string(name))
+			size += len(name)
+		}
+		for _, e := range s.edges {
+			name = append(name, e.b)
+			walk(e.next)
+			name = name[:len(name)-1]
+		}
+	}
+	walk(m.root)
+
+	// The automaton has at most one node per byte of the names, plus the
+	// root, so allocating that many up front avoids copying it as it grows.
+	nodes := make(acNodes, 1, size)
+	nodes[0] = acNode{fail: 0, out: -1, dict: -1}
+	for _, name := range names {
+		nodes = nodes.insertReversed(name)
+	}
+
+	// Compute failure and output links in breadth-first order, so the
+	// links of shallower nodes are known first.
+	queue := []int32{0}
+	for len(queue) != 0 {
+		n := queue[0]
+		queue = queue[1:]
+
+		for _, e := range nodes[n].edges {
+			child := e.next
+			queue = append(queue, child)
+
+			if n != 0 {
+				nodes[child].fail = nodes.next(nodes[n].fail, e.b)
+			}
+
+			fallback := nodes[nodes[child].fail].out
+			if nodes[child].out == child {
+				nodes[child].dict = fallback
+			} else {
+				nodes[child].out = fallback
+			}
+		}
+	}
+
+	m.automaton = nodes
+}
+
+type acNodes []acNode
+
+// insertReversed adds the reverse of name to the automaton and marks its
+// node as a name.
+func (nodes acNodes) insertReversed(name string) acNodes {
+	var n int32
+	for i := len(name) - 1; i >= 0; i-- {
+		b := name[i]
+		next := nodes.edge(n, b)
+		if next == -1 {
+			next = int32(len(nodes))
+			nodes = append(nodes, acNode{
+				out:   -1,
+				dict:  -1,
+				depth: nodes[n].depth + 1,
+			})
+			nodes[n].edges = insertEdge(nodes[n].edges, acEdge{b: b, next: next})
+		}
+		n = next
+	}
+	nodes[n].out = n
+	return nodes
+}
+
+// edge returns the child of n for b, or -1 if there is none.
+func (nodes acNodes) edge(n int32, b byte) int32 {
+	edges := nodes[n].edges
+	lo, hi := 0, len(edges)
+	for lo < hi {
+		mid := int(uint(lo+hi) >> 1)
+		if edges[mid].b < b {
+			lo = mid + 1
+		} else {
+			hi = mid
+		}
+	}
+	if lo < len(edges) && edges[lo].b == b {
+		return edges[lo].next
+	}
+	return -1
+}
+
+// next returns the node after reading b in node n, following failure links.
+func (nodes acNodes) next(n int32, b byte) int32 {
+	for {
+		if next := nodes.edge(n, b); next != -1 {
+			return next
+		}
+		if n == 0 {
+			return 0
+		}
+		n = nodes[n].fail
+	}
+}
+
+func insertEdge(edges []acEdge, e acEdge) []acEdge {
+	i := len(edges)
+	for i > 0 && edges[i-1].b > e.b {
+		i--
+	}
+	edges = append(edges, acEdge{})
+	copy(edges[i+1:], edges[i:])
+	edges[i] = e
+	return edges
+}
@@ -0,0 +1,105 @@
+package emoji_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"golang.org/x/net/html"
+
+	"github.com/BenLubar/hellstew/emoji"
+)
+
+// matcherConfig has names that overlap each other and the default set.
+var matcherConfig = func() *emoji.Config {
+	var conf emoji.Config
+
+	conf.AddImage("/emoji/a.png", "a", []string{"a"}, "", nil)
+	conf.AddImage("/emoji/aa_b.png", "aa b", []string{"aa_b"}, "", nil)
+	conf.AddImage("/emoji/smile_cat_smile_cat.png", "smile cat smile cat", []string{"smile_cat_smile_cat"}, "", nil)
+	conf.AddEmoticon(":-)", ":smile:")
+	conf.AddEmoticon(":-))", ":joy:")
+
+	return &conf
+}()
+
+var matcherSeeds = [...]string{
+	"",
+	"To the :popcorn: thread!",
+	":smile_cat: :smile_cat_smile_cat: :smile_cat_smile_cat",
+	"::::::::::::::a:::aa_b:a:aa:aa_b::",
+	"🇺🇸🇺🇸🇺🇸🇺",
+	"👨‍👩‍👧‍👦👨‍👩‍👧",
+	":-)) :-) :-)))",
+}
+
+// rejectOdd rejects matches that start at an odd offset, so that shorter
+// matches and later offsets are tried.
+func rejectOdd(start, end int) bool {
+	return start%2 == 0
+}
+
+func checkMatchNames(t *testing.T, conf *emoji.Config, s string) {
+	for _, accept := range []func(int, int) bool{nil, rejectOdd} {
+		matches, naive := conf.MatchNames(s, accept), conf.MatchNamesNaive(s, accept)
+		if !reflect.DeepEqual(matches, naive) {
+			t.Errorf("%q (accept %v):\nexpected %v\nactual   %v", s, accept != nil, naive, matches)
+		}
+	}
+}
+
+func TestMatchNames(t *testing.T) {
+	for _, s := range matcherSeeds {
+		checkMatchNames(t, &emoji.Config{}, s)
+		checkMatchNames(t, matcherConfig, s)
+	}
+}
+
+func FuzzMatchNames(f *testing.F) {
+	for _, s := range matcherSeeds {
+		f.Add(s)
+	}
+
+	f.Fuzz(func(t *testing.T, s string) {
+		checkMatchNames(t, &emoji.Config{}, s)
+		checkMatchNames(t, matcherConfig, s)
+
+		// Replace must not panic on any input.
+		matcherConfig.Replace(&html.Node{
+			Type: html.TextNode,
+			Data: s,
+		})
+	})
+}
+
+var pathologicalTests = [...]struct {
+	name string
+	text string
+}{
+	{"Colons", strings.Repeat(":", 100000)},
+	{"RepeatedPrefix", ":" + strings.Repeat("smile_cat_", 10000)},
+	{"RepeatedEmoji", strings.Repeat("🐱", 25000)},
+	{"Flags", strings.Repeat("🇺", 25000)},
+}
+
+func BenchmarkPathological(b *testing.B) {
+	conf := &emoji.Config{}
+	conf.AddImage("/emoji/long.png", "long", []string{strings.Repeat("smile_cat_", 100)}, "", nil)
+	conf.AddEmoji(strings.Repeat("🐱", 250)+"🐶", "cats and a dog", nil, "", nil)
+
+	for _, tt := range pathologicalTests {
+		tt := tt
+		b.Run(tt.name, func(b *testing.B) {
+			b.SetBytes(int64(len(tt.text)))
+			for i := 0; i < b.N; i++ {
+				conf.MatchNames(tt.text, nil)
+			}
+		})
+		b.Run(tt.name+"Naive", func(b *testing.B) {
+			b.SetBytes(int64(len(tt.text)))
+			for i := 0; i < b.N; i++ {
+				conf.MatchNamesNaive(tt.text, nil)
+			}
+		})
+	}
+}
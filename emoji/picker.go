@@ -0,0 +1,195 @@
+package emoji
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strconv"
+	"strings"
+)
+
+// PickerVersion is the version of the document returned by PickerJSON. It is
+// increased whenever the document changes in a way that is not backwards
+// compatible.
+const PickerVersion = 1
+
+// pickerData is the document returned by PickerJSON. Its layout follows the
+// emoji-mart data schema, so it can be passed to emoji-mart directly.
+type pickerData struct {
+	Version    int                    `json:"version"`
+	Categories []pickerCategory       `json:"categories"`
+	Emojis     map[string]pickerEmoji `json:"emojis"`
+	Aliases    map[string]string      `json:"aliases"`
+}
+
+type pickerCategory struct {
+	ID     string   `json:"id"`
+	Name   string   `json:"name"`
+	Emojis []string `json:"emojis"`
+}
+
+type pickerEmoji struct {
+	ID       string       `json:"id"`
+	Name     string       `json:"name"`
+	Keywords []string     `json:"keywords"`
+	Skins    []pickerSkin `json:"skins"`
+}
+
+type pickerSkin struct {
+	Unified string `json:"unified,omitempty"`
+	Native  string `json:"native,omitempty"`
+	Src     string `json:"src,omitempty"`
+}
+
+// PickerJSON returns the default emoji set as JSON for front-end emoji
+// pickers. See Config.PickerJSON.
+func PickerJSON() (data []byte, etag string) {
+	return defaultConfig.PickerJSON()
+}
+
+// PickerJSON returns every emoji in the Config and the default set as a JSON
+// document for front-end emoji pickers, grouped by category. The document
+// follows the emoji-mart data schema. Each emoji is identified by its first
+// shortcode; the remaining shortcodes are listed in the aliases object. Tags
+// and localised keywords are listed as keywords, and names are localised
+// using the Config's Locale. Emoji without a category are listed in the custom
+// category.
+//
+// The returned etag is a quoted hash of the document, suitable for use as an
+// HTTP ETag header.
+func (conf *Config) PickerJSON() (data []byte, etag string) {
+	data, err := json.Marshal(conf.pickerData())
+	if err != nil {
+		panic("emoji: " + err.Error())
+	}
+
+	sum := sha256.Sum256(data)
+	return data, `"` + hex.EncodeToString(sum[:16]) + `"`
+}
+
+func (conf *Config) pickerData() *pickerData {
+	d := &pickerData{
+		Version: PickerVersion,
+		Emojis:  make(map[string]pickerEmoji),
+		Aliases: make(map[string]string),
+	}
+
+	keywords := make(map[*emoji][]string)
+	for i, es := range conf.byTag {
+		for _, e := range es {
+			keywords[e] = append(keywords[e], conf.tags[i])
+		}
+	}
+	set := defaults()
+	for i, es := range set.byTag {
+		for _, e := range es {
+			keywords[e] = append(keywords[e], set.tags[i])
+		}
+	}
+
+	ids := make(map[*emoji]string)
+	addCategory := func(name string, es []*emoji, local bool) {
+		if name == "" {
+			name = "Custom"
+		}
+		id := pickerCategoryID(name)
+
+		var c *pickerCategory
+		for i := range d.Categories {
+			if d.Categories[i].ID == id {
+				c = &d.Categories[i]
+				break
+			}
+		}
+		if c == nil {
+			d.Categories = append(d.Categories, pickerCategory{
+				ID:     id,
+				Name:   name,
+				Emojis: []string{},
+			})
+			c = &d.Categories[len(d.Categories)-1]
+		}
+
+		for _, e := range es {
+			if !local && conf.overrides(e) {
+				continue
+			}
+			if _, ok := ids[e]; ok {
+				continue
+			}
+
+			pe := conf.pickerEmoji(e, keywords[e], local, d.Aliases)
+			ids[e] = pe.ID
+			d.Emojis[pe.ID] = pe
+			c.Emojis = append(c.Emojis, pe.ID)
+		}
+	}
+
+	for i, es := range conf.byCategory {
+		addCategory(conf.categories[i], es, true)
+	}
+	for i, es := range set.byCategory {
+		addCategory(set.categories[i], es, false)
+	}
+
+	return d
+}
+
+// pickerEmoji converts e to the picker format and adds its extra shortcodes to
+// aliases. Shortcodes of default emoji that were redefined by the Config are
+// left out.
+func (conf *Config) pickerEmoji(e *emoji, keywords []string, local bool, aliases map[string]string) pickerEmoji {
+	var names []string
+	for _, a := range e.aliases {
+		if _, ok := conf.byName[":"+a+":"]; local || !ok {
+			names = append(names, a)
+		}
+	}
+
+	pe := pickerEmoji{
+		Name:     conf.description(e),
+		Keywords: keywords,
+	}
+	if a := conf.annotation(e); a != nil {
+		pe.Keywords = append(pe.Keywords[:len(pe.Keywords):len(pe.Keywords)], a.keywords...)
+	}
+	if pe.Keywords == nil {
+		pe.Keywords = []string{}
+	}
+
+	if len(names) != 0 {
+		pe.ID = names[0]
+		for _, a := range names[1:] {
+			aliases[a] = pe.ID
+		}
+	} else {
+		pe.ID = e.emoji
+	}
+
+	if e.image != nil {
+		pe.Skins = []pickerSkin{{Src: e.image.URL}}
+	} else {
+		pe.Skins = []pickerSkin{{Unified: pickerUnified(e.emoji), Native: e.emoji}}
+	}
+
+	return pe
+}
+
+// pickerCategoryID returns the emoji-mart ID of a category, such as people for
+// People.
+func pickerCategoryID(name string) string {
+	return strings.Replace(strings.ToLower(name), " ", "-", -1)
+}
+
+// pickerUnified returns the code points of s in hexadecimal separated by
+// dashes, such as 1f1fa-1f1f8 for 🇺🇸.
+func pickerUnified(s string) string {
+	var buf []byte
+	for _, r := range s {
+		if len(buf) != 0 {
+			buf = append(buf, '-')
+		}
+		buf = strconv.AppendInt(buf, int64(r), 16)
+	}
+	return string(buf)
+}
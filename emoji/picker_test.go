@@ -0,0 +1,128 @@
+package emoji_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/BenLubar/hellstew/emoji"
+)
+
+type pickerData struct {
+	Version    int
+	Categories []struct {
+		ID     string
+		Name   string
+		Emojis []string
+	}
+	Emojis map[string]struct {
+		ID       string
+		Name     string
+		Keywords []string
+		Skins    []map[string]string
+	}
+	Aliases map[string]string
+}
+
+func decodePicker(t *testing.T, data []byte) *pickerData {
+	var d pickerData
+	if err := json.Unmarshal(data, &d); err != nil {
+		t.Fatal(err)
+	}
+	if d.Version != emoji.PickerVersion {
+		t.Errorf("version: %d != %d", emoji.PickerVersion, d.Version)
+	}
+	return &d
+}
+
+func TestPickerJSON(t *testing.T) {
+	t.Run("Default", func(t *testing.T) {
+		data, etag := emoji.PickerJSON()
+		d := decodePicker(t, data)
+
+		var found bool
+		for _, c := range d.Categories {
+			if c.ID == "people" {
+				found = c.Name == "People" && len(c.Emojis) != 0 && c.Emojis[0] == "grinning"
+			}
+		}
+		if !found {
+			t.Errorf("people category is missing or does not start with grinning")
+		}
+
+		flag := d.Emojis["us"]
+		if expected, actual := []map[string]string{{"unified": "1f1fa-1f1f8", "native": "🇺🇸"}}, flag.Skins; !reflect.DeepEqual(expected, actual) {
+			t.Errorf("skins: %v != %v", expected, actual)
+		}
+		if expected, actual := "laughing", d.Aliases["satisfied"]; expected != actual {
+			t.Errorf("alias: %q != %q", expected, actual)
+		}
+
+		if data2, etag2 := emoji.PickerJSON(); string(data) != string(data2) || etag != etag2 {
+			t.Errorf("output is not stable")
+		}
+	})
+
+	t.Run("Config", func(t *testing.T) {
+		data, etag := testConfig.PickerJSON()
+		d := decodePicker(t, data)
+
+		if _, defaultEtag := emoji.PickerJSON(); etag == defaultEtag {
+			t.Errorf("etag did not change: %s", etag)
+		}
+
+		shipit := d.Emojis["shipit"]
+		if expected, actual := []map[string]string{{"src": "https://assets-cdn.github.com/images/icons/emoji/shipit.png"}}, shipit.Skins; !reflect.DeepEqual(expected, actual) {
+			t.Errorf("skins: %v != %v", expected, actual)
+		}
+		if expected, actual := "shipit", d.Aliases["squirrel"]; expected != actual {
+			t.Errorf("alias: %q != %q", expected, actual)
+		}
+
+		// :cat: is redefined as an image, so the default cat face has no
+		// shortcode left.
+		if expected, actual := "octocat", d.Emojis["cat"].Name; expected != actual {
+			t.Errorf("cat: %q != %q", expected, actual)
+		}
+		if expected, actual := "cat face", d.Emojis["🐱"].Name; expected != actual {
+			t.Errorf("cat face: %q != %q", expected, actual)
+		}
+
+		wtf := d.Emojis["wrongterrobang"]
+		if expected, actual := "backwards interrobang", wtf.Name; expected != actual {
+			t.Errorf("name: %q != %q", expected, actual)
+		}
+		if expected, actual := []string{"wrong"}, wtf.Keywords; !reflect.DeepEqual(expected, actual) {
+			t.Errorf("keywords: %q != %q", expected, actual)
+		}
+		for id, e := range d.Emojis {
+			if e.Name == "exclamation question mark" {
+				t.Errorf("overridden emoji is included as %q", id)
+			}
+		}
+
+		var categories []string
+		for _, c := range d.Categories[:2] {
+			categories = append(categories, c.Name)
+		}
+		if expected := []string{"GitHub", "The Daily WTF"}; !reflect.DeepEqual(expected, categories) {
+			t.Errorf("categories: %q != %q", expected, categories)
+		}
+	})
+
+	t.Run("Locale", func(t *testing.T) {
+		conf := &emoji.Config{Locale: "de"}
+		conf.AddAnnotation("de", "🎉", "Konfettibombe", []string{"Party"})
+
+		data, _ := conf.PickerJSON()
+		d := decodePicker(t, data)
+
+		tada := d.Emojis["tada"]
+		if expected, actual := "Konfettibombe", tada.Name; expected != actual {
+			t.Errorf("name: %q != %q", expected, actual)
+		}
+		if n := len(tada.Keywords); n == 0 || tada.Keywords[n-1] != "Party" {
+			t.Errorf("keywords: %q", tada.Keywords)
+		}
+	})
+}
@@ -0,0 +1,101 @@
+package emoji
+
+import (
+	"sort"
+	"strings"
+)
+
+// Related returns a list of emoji that share tags or a category with the emoji
+// or shortcode name, most similar first. The emoji itself is not included.
+func Related(name string, max int) []SearchResult {
+	return defaultConfig.Related(name, max)
+}
+
+// Related returns a list of emoji that share tags or a category with the emoji
+// or shortcode name, most similar first. The emoji itself is not included.
+func (conf *Config) Related(name string, max int) []SearchResult {
+	if max <= 0 {
+		return nil
+	}
+
+	e, ok := conf.lookupEmoji(name)
+	if !ok {
+		return nil
+	}
+
+	return conf.related(make(searchResults, 0, max), e)
+}
+
+// lookupEmoji is like lookup, but it also accepts Unicode emoji with or without
+// a trailing variation selector.
+func (conf *Config) lookupEmoji(name string) (*emoji, bool) {
+	if e, ok := conf.lookup(name); ok {
+		return e, true
+	}
+	if strings.HasSuffix(name, "\ufe0f") {
+		return conf.lookup(strings.TrimSuffix(name, "\ufe0f"))
+	}
+	return conf.lookup(name + "\ufe0f")
+}
+
+func (conf *Config) related(results searchResults, e *emoji) searchResults {
+	scores := make(map[*emoji]int)
+
+	d := defaults()
+	conf.relatedSet(scores, e, 1000, conf.byTag, conf.tags, d.byTag, d.tags)
+	conf.relatedSet(scores, e, 100, conf.byCategory, conf.categories, d.byCategory, d.categories)
+
+	for r, score := range scores {
+		results = addResult(results, SearchResult{emoji: r, score: score})
+	}
+
+	if len(results) < cap(results) {
+		sort.Sort(results)
+	}
+
+	return results
+}
+
+func (conf *Config) relatedSet(scores map[*emoji]int, e *emoji, bonus int, byLocal [][]*emoji, namesLocal []string, by [][]*emoji, names []string) {
+	var shared []string
+	for i, es := range byLocal {
+		if containsEmoji(es, e) {
+			shared = append(shared, namesLocal[i])
+		}
+	}
+	for i, es := range by {
+		if containsEmoji(es, e) {
+			shared = append(shared, names[i])
+		}
+	}
+
+	for _, name := range shared {
+		for i, n := range namesLocal {
+			if n == name {
+				for _, r := range byLocal[i] {
+					if r != e {
+						scores[r] += bonus
+					}
+				}
+			}
+		}
+		for i, n := range names {
+			if n == name {
+				for _, r := range by[i] {
+					if r != e && !conf.overrides(r) {
+						scores[r] += bonus
+					}
+				}
+			}
+		}
+	}
+}
+
+func containsEmoji(es []*emoji, e *emoji) bool {
+	for _, o := range es {
+		if o == e {
+			return true
+		}
+	}
+	return false
+}
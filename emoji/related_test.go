@@ -0,0 +1,60 @@
+package emoji_test
+
+import (
+	"testing"
+
+	"github.com/BenLubar/hellstew/emoji"
+)
+
+func TestRelated(t *testing.T) {
+	t.Run("Global", func(t *testing.T) {
+		testRelated(t, emoji.Related, ":cat:", []string{"dog face", "hamster face", "ant"})
+		testRelated(t, emoji.Related, "🐱", []string{"dog face", "hamster face", "ant"})
+	})
+	t.Run("Config", func(t *testing.T) {
+		testRelated(t, testConfig.Related, ":wrongterrobang:", []string{"wtf"})
+	})
+	t.Run("Unknown", func(t *testing.T) {
+		testRelated(t, emoji.Related, ":partyparrot:", []string{})
+	})
+	t.Run("NoMax", func(t *testing.T) {
+		testRelated(t, emoji.Related, ":cat:", []string{})
+	})
+}
+
+func testRelated(t *testing.T, related func(string, int) []emoji.SearchResult, name string, expected []string) {
+	results := related(name, len(expected))
+	if len(results) != len(expected) {
+		t.Errorf("%s: unexpected len(results) == %d", name, len(results))
+		return
+	}
+	for i, r := range results {
+		if r.Description() != expected[i] {
+			t.Errorf("%s: %d: %q / %q", name, i, r.Description(), expected[i])
+		}
+	}
+}
+
+func TestSearchEmoji(t *testing.T) {
+	for _, tt := range []struct {
+		conf     *emoji.Config
+		query    string
+		expected []string
+	}{
+		{&emoji.Config{}, "🐱", []string{"cat face", "dog face", "hamster face"}},
+		{&emoji.Config{}, "❗", []string{"exclamation mark"}},
+		{&emoji.Config{}, "❗️", []string{"exclamation mark"}},
+		{testConfig, "⁉️", []string{"backwards interrobang", "wtf"}},
+	} {
+		results := tt.conf.Search(tt.query, len(tt.expected))
+		if len(results) != len(tt.expected) {
+			t.Errorf("%s: unexpected len(results) == %d", tt.query, len(results))
+			continue
+		}
+		for i, r := range results {
+			if r.Description() != tt.expected[i] {
+				t.Errorf("%s: %d: %q / %q", tt.query, i, r.Description(), tt.expected[i])
+			}
+		}
+	}
+}
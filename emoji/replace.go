@@ -2,17 +2,13 @@
 package emoji
 
 import (
+	"strconv"
+	"strings"
+
 	"golang.org/x/net/html"
 	"golang.org/x/net/html/atom"
 )
 
-var skipElement = map[atom.Atom]bool{
-	atom.Script: true,
-	atom.Style:  true,
-	atom.Pre:    true,
-	atom.Code:   true,
-}
-
 // Replace finds Unicode emoji and emoji shortcodes (such as :tophat:) and
 // replaces them with Unicode emoji with tooltips. Replace is idempotent.
 func Replace(nodes ...*html.Node) []*html.Node {
@@ -22,23 +18,45 @@ func Replace(nodes ...*html.Node) []*html.Node {
 // Replace finds Unicode emoji and emoji shortcodes (such as :tophat:) and
 // replaces them with Unicode emoji with tooltips. Replace is idempotent.
 func (conf *Config) Replace(nodes ...*html.Node) []*html.Node {
-	return conf.replace(true, nodes...)
+	jumbo, blocks := conf.jumboBlocks(nodes)
+	return conf.replace(true, jumbo, blocks, nodes...)
 }
 
-func (conf *Config) replace(tooltip bool, nodes ...*html.Node) []*html.Node {
+// ReplacePart is like Replace for nodes that are only part of a block of
+// text, such as the text inside one Markdown emphasis, where the nodes alone
+// cannot tell whether the block contains only emoji. The JumboClass is added
+// to the emoji if jumbo is set, which callers can decide by passing the whole
+// block to Jumbo.
+func (conf *Config) ReplacePart(jumbo bool, nodes ...*html.Node) []*html.Node {
+	_, blocks := conf.jumboBlocks(nodes)
+	return conf.replace(true, jumbo && conf.JumboClass != "", blocks, nodes...)
+}
+
+// replace replaces the emoji in nodes. blocks holds the block elements whose
+// emoji are given the JumboClass, as found by jumboBlocks.
+func (conf *Config) replace(tooltip, jumbo bool, blocks map[*html.Node]bool, nodes ...*html.Node) []*html.Node {
 	result := make([]*html.Node, 0, len(nodes))
 
 	for _, node := range nodes {
 		switch node.Type {
 		case html.ElementNode:
-			if node.Namespace != "" || skipElement[node.DataAtom] {
+			if conf.skip(node) {
 				result = append(result, deepClone(node))
 				break
 			}
 
-			result = append(result, conf.replaceElement(tooltip, node)...)
+			result = append(result, conf.replaceElement(tooltip, jumbo, blocks, node)...)
 		case html.TextNode:
-			result = append(result, conf.replaceText(tooltip, node)...)
+			if p := node.Parent; p != nil && p.Namespace != "" {
+				if conf.ReplaceForeign && foreignText[p.Namespace][p.Data] {
+					result = append(result, conf.replaceForeignText(node)...)
+				} else {
+					result = append(result, shallowClone(node))
+				}
+				break
+			}
+
+			result = append(result, conf.replaceText(tooltip, jumbo, node)...)
 		default:
 			result = append(result, deepClone(node))
 		}
@@ -47,7 +65,7 @@ func (conf *Config) replace(tooltip bool, nodes ...*html.Node) []*html.Node {
 	return result
 }
 
-func (conf *Config) replaceElement(tooltip bool, node *html.Node) []*html.Node {
+func (conf *Config) replaceElement(tooltip, jumbo bool, blocks map[*html.Node]bool, node *html.Node) []*html.Node {
 	if tooltip {
 		for _, a := range node.Attr {
 			if a.Namespace == "" && a.Key == "title" {
@@ -56,66 +74,185 @@ func (conf *Config) replaceElement(tooltip bool, node *html.Node) []*html.Node {
 			}
 		}
 	}
-	for _, a := range node.Attr {
-		if a.Namespace == "" && a.Key == "class" && a.Val == "emoji" {
-			return []*html.Node{deepClone(node)}
+	if hasClass(node, "emoji") {
+		result := deepClone(node)
+		if jumbo {
+			addClass(result, conf.JumboClass)
 		}
+		return []*html.Node{result}
+	}
+	if conf.SkipAutolinks && isAutolink(node) {
+		return []*html.Node{deepClone(node)}
+	}
+	if blocks[node] {
+		jumbo = true
 	}
 
 	result := shallowClone(node)
 	for child := node.FirstChild; child != nil; child = child.NextSibling {
-		for _, o := range conf.replace(tooltip, child) {
+		for _, o := range conf.replace(tooltip, jumbo, blocks, child) {
 			result.AppendChild(o)
 		}
 	}
 	return []*html.Node{result}
 }
 
-func (conf *Config) replaceText(tooltip bool, node *html.Node) []*html.Node {
-	var matches [][2]int
-	if conf.state == nil {
-		matches = startState.match(node.Data)
-	} else {
-		matches = conf.state.match(node.Data)
-	}
-	if len(matches) == 0 {
-		return []*html.Node{shallowClone(node)}
+func (conf *Config) replaceText(tooltip, jumbo bool, node *html.Node) []*html.Node {
+	matches := conf.findEmoji(node.Data)
+	if conf.UnknownShortcode != nil {
+		matches = conf.findUnknown(node.Data, matches)
 	}
 
-	result := make([]*html.Node, 0, len(matches)*2+1)
-	for i, match := range matches {
-		if i == 0 {
-			if match[0] != 0 {
-				result = append(result, &html.Node{
-					Type: html.TextNode,
-					Data: node.Data[:match[0]],
-				})
-			}
+	var result []*html.Node
+	pos := 0
+	for _, match := range matches {
+		var replacement []*html.Node
+		if match.e != nil {
+			replacement = []*html.Node{conf.emojiToNode(tooltip, jumbo, match.e, node.Data[match.start:match.end])}
+		} else if replacement = conf.UnknownShortcode(node.Data[match.start:match.end]); replacement == nil {
+			continue
 		}
-		name := node.Data[match[0]:match[1]]
-		e, ok := conf.byName[name]
-		if !ok {
-			e = byName[name]
-		}
-		result = append(result, emojiToNode(tooltip, e, name))
-		if i+1 == len(matches) {
-			if match[1] != len(node.Data) {
-				result = append(result, &html.Node{
-					Type: html.TextNode,
-					Data: node.Data[match[1]:],
-				})
-			}
-		} else if next := matches[i+1]; match[1] != next[0] {
+
+		if match.start != pos {
 			result = append(result, &html.Node{
 				Type: html.TextNode,
-				Data: node.Data[match[1]:next[0]],
+				Data: node.Data[pos:match.start],
 			})
 		}
+		result = append(result, replacement...)
+		pos = match.end
+	}
+
+	if pos == 0 {
+		return []*html.Node{shallowClone(node)}
+	}
+	if pos != len(node.Data) {
+		result = append(result, &html.Node{
+			Type: html.TextNode,
+			Data: node.Data[pos:],
+		})
 	}
 
 	return result
 }
 
+// MatchPrefix returns the length in bytes of the longest Unicode emoji or
+// shortcode at the start of text, or 0 if text does not start with one.
+func MatchPrefix(text string) int {
+	return defaultConfig.MatchPrefix(text)
+}
+
+// MatchPrefix returns the length in bytes of the longest Unicode emoji or
+// shortcode at the start of text, or 0 if text does not start with one.
+// Emoticons are not matched.
+func (conf *Config) MatchPrefix(text string) int {
+	return conf.nameMatcher().matchPrefix(text)
+}
+
+// Node returns the HTML node that Replace would use for a Unicode emoji or
+// shortcode, or nil if name is not defined.
+func Node(name string) *html.Node {
+	return defaultConfig.Node(name)
+}
+
+// Node returns the HTML node that Replace would use for a Unicode emoji or
+// shortcode, or nil if name is not defined in this Config or the default set.
+func (conf *Config) Node(name string) *html.Node {
+	e, ok := conf.lookup(name)
+	if !ok {
+		return nil
+	}
+	return conf.emojiToNode(true, false, e, name)
+}
+
+// emojiMatch is the location of an emoji, shortcode, or emoticon in a string.
+type emojiMatch struct {
+	start, end int
+	e          *emoji
+}
+
+// findEmoji returns the locations of emoji, shortcodes, and emoticons in text.
+func (conf *Config) findEmoji(text string) []emojiMatch {
+	folded, offsets := conf.foldIndex(text)
+
+	var urls [][]int
+	if conf.SkipURLs {
+		urls = urlPattern.FindAllStringIndex(text, -1)
+	}
+
+	var accept func(start, end int) bool
+	if conf.WordBoundary || len(urls) != 0 {
+		accept = func(start, end int) bool {
+			if conf.WordBoundary && folded[start] == ':' && !wordBoundary(folded, start, end) {
+				return false
+			}
+			return !overlapsAny(urls, unfoldSpan(text, offsets, [2]int{start, end}))
+		}
+	}
+
+	spans := conf.nameMatcher().matchFunc(folded, accept)
+
+	matches := make([]emojiMatch, 0, len(spans))
+	for _, span := range spans {
+		span = unfoldSpan(text, offsets, span)
+		e, _ := conf.lookup(text[span[0]:span[1]])
+		matches = append(matches, emojiMatch{
+			start: span[0],
+			end:   span[1],
+			e:     e,
+		})
+	}
+
+	return conf.findEmoticons(text, matches, urls)
+}
+
+// spriteName returns the first alias of an image emoji that can be used in a
+// class name, or the empty string if the emoji is not in sprite sheets.
+func (e *emoji) spriteName() string {
+	if e.image == nil || e.image.Animated {
+		return ""
+	}
+	for _, a := range e.aliases {
+		// Class names are separated by ASCII whitespace. Any other
+		// character can be escaped in CSS.
+		if !strings.ContainsAny(a, " \t\n\f\r") {
+			return a
+		}
+	}
+	return ""
+}
+
+// spriteNode returns a span element that shows an image emoji from a sprite
+// sheet.
+func (conf *Config) spriteNode(tooltip bool, class, sprite string, e *emoji, name string) *html.Node {
+	node := &html.Node{
+		Type:     html.ElementNode,
+		Data:     "span",
+		DataAtom: atom.Span,
+		Attr: []html.Attribute{
+			{
+				Key: "class",
+				Val: class + " " + conf.SpritePrefix + sprite,
+			},
+			{
+				Key: "role",
+				Val: "img",
+			},
+			{
+				Key: "aria-label",
+				Val: name,
+			},
+		},
+	}
+	if tooltip {
+		node.Attr = append(node.Attr, html.Attribute{
+			Key: "title",
+			Val: conf.description(e),
+		})
+	}
+	return node
+}
+
 func shallowClone(node *html.Node) *html.Node {
 	result := &html.Node{
 		Namespace: node.Namespace,
@@ -136,7 +273,12 @@ func deepClone(node *html.Node) *html.Node {
 	return result
 }
 
-func emojiToNode(tooltip bool, e *emoji, name string) *html.Node {
+func (conf *Config) emojiToNode(tooltip, jumbo bool, e *emoji, name string) *html.Node {
+	class := "emoji"
+	if jumbo {
+		class += " " + conf.JumboClass
+	}
+
 	if e.emoji != "" {
 		node := &html.Node{
 			Type:     html.ElementNode,
@@ -145,7 +287,7 @@ func emojiToNode(tooltip bool, e *emoji, name string) *html.Node {
 			Attr: []html.Attribute{
 				{
 					Key: "class",
-					Val: "emoji",
+					Val: class,
 				},
 			},
 		}
@@ -154,7 +296,7 @@ func emojiToNode(tooltip bool, e *emoji, name string) *html.Node {
 			node.DataAtom = atom.Abbr
 			node.Attr = append(node.Attr, html.Attribute{
 				Key: "title",
-				Val: e.description,
+				Val: conf.description(e),
 			})
 		}
 
@@ -164,6 +306,12 @@ func emojiToNode(tooltip bool, e *emoji, name string) *html.Node {
 		})
 		return node
 	}
+	if conf.SpritePrefix != "" {
+		if sprite := e.spriteName(); sprite != "" {
+			return conf.spriteNode(tooltip, class, sprite, e, name)
+		}
+	}
+
 	img := &html.Node{
 		Type:     html.ElementNode,
 		Data:     "img",
@@ -171,7 +319,7 @@ func emojiToNode(tooltip bool, e *emoji, name string) *html.Node {
 		Attr: []html.Attribute{
 			{
 				Key: "src",
-				Val: e.imageURL,
+				Val: e.image.URL,
 			},
 			{
 				Key: "alt",
@@ -179,15 +327,71 @@ func emojiToNode(tooltip bool, e *emoji, name string) *html.Node {
 			},
 			{
 				Key: "class",
-				Val: "emoji",
+				Val: class,
 			},
 		},
 	}
 	if tooltip {
 		img.Attr = append(img.Attr, html.Attribute{
 			Key: "title",
-			Val: e.description,
+			Val: conf.description(e),
+		})
+	}
+	if len(e.image.Srcset) != 0 {
+		img.Attr = append(img.Attr, html.Attribute{
+			Key: "srcset",
+			Val: strings.Join(e.image.Srcset, ", "),
 		})
 	}
-	return img
+	if e.image.Width != 0 && e.image.Height != 0 {
+		img.Attr = append(img.Attr, html.Attribute{
+			Key: "width",
+			Val: strconv.Itoa(e.image.Width),
+		}, html.Attribute{
+			Key: "height",
+			Val: strconv.Itoa(e.image.Height),
+		})
+	}
+
+	if !e.image.Animated || e.image.StaticURL == "" {
+		return img
+	}
+
+	// The class is moved to the picture element, so that it is treated as
+	// one emoji.
+	for i, a := range img.Attr {
+		if a.Key == "class" {
+			img.Attr = append(img.Attr[:i], img.Attr[i+1:]...)
+			break
+		}
+	}
+
+	picture := &html.Node{
+		Type:     html.ElementNode,
+		Data:     "picture",
+		DataAtom: atom.Picture,
+		Attr: []html.Attribute{
+			{
+				Key: "class",
+				Val: class,
+			},
+		},
+	}
+	picture.AppendChild(&html.Node{
+		Type:     html.ElementNode,
+		Data:     "source",
+		DataAtom: atom.Source,
+		Attr: []html.Attribute{
+			{
+				Key: "srcset",
+				Val: strings.Join(append([]string{e.image.StaticURL}, e.image.StaticSrcset...), ", "),
+			},
+			{
+				Key: "media",
+				Val: "(prefers-reduced-motion: reduce)",
+			},
+		},
+	})
+	picture.AppendChild(img)
+	return picture
 }
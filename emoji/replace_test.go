@@ -75,6 +75,73 @@ var replaceTests = [...]replaceTest{
 	},
 }
 
+var boundaryTests = [...]replaceTest{
+	{
+		name:   "Garbage",
+		input:  `:po:popcor:corn:n:`,
+		output: `:po:popcor:corn:n:`,
+	},
+	{
+		name:   "Time",
+		input:  `10:30:ok: at 10:30 :ok:`,
+		output: `10:30:ok: at 10:30 <abbr class="emoji" title="OK button">🆗</abbr>`,
+	},
+	{
+		name:   "Adjacent",
+		input:  `:+1::+1: (:tada:)`,
+		output: `<abbr class="emoji" title="thumbs up">👍</abbr><abbr class="emoji" title="thumbs up">👍</abbr> (<abbr class="emoji" title="party popper">🎉</abbr>)`,
+	},
+	{
+		name:   "Rejected",
+		input:  `a:smile::smile:`,
+		output: `a:smile:<abbr class="emoji" title="smiling face with open mouth &amp; smiling eyes">😄</abbr>`,
+	},
+	{
+		name:   "Unicode",
+		input:  `abc🍿def`,
+		output: `abc<abbr class="emoji" title="popcorn">🍿</abbr>def`,
+	},
+	{
+		name:   "URL",
+		input:  `see https://example.com/#:smile: or www.example.com/🍿 :smile:`,
+		output: `see https://example.com/#:smile: or www.example.com/🍿 <abbr class="emoji" title="smiling face with open mouth &amp; smiling eyes">😄</abbr>`,
+	},
+	{
+		name:   "Autolink",
+		input:  `<a href="https://example.com/:smile:">example.com/:smile:</a> <a href="https://example.com/">:smile:</a>`,
+		output: `<a href="https://example.com/:smile:">example.com/:smile:</a> <a href="https://example.com/"><abbr class="emoji" title="smiling face with open mouth &amp; smiling eyes">😄</abbr></a>`,
+	},
+}
+
+func TestBoundary(t *testing.T) {
+	conf := &emoji.Config{
+		WordBoundary:  true,
+		SkipURLs:      true,
+		SkipAutolinks: true,
+	}
+
+	for _, tt := range boundaryTests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			testReplaceOne(t, conf.Replace, tt)
+		})
+	}
+
+	t.Run("Email", func(t *testing.T) {
+		// Without WordBoundary, only SkipURLs keeps the shortcode in
+		// the address.
+		conf := &emoji.Config{SkipURLs: true}
+		testReplaceOne(t, conf.Replace, replaceTest{
+			input:  `mail user:tada:@example.com, :tada: or mailto:team:+1:@example.com`,
+			output: `mail user:tada:@example.com, <abbr class="emoji" title="party popper">🎉</abbr> or mailto:team:+1:@example.com`,
+		})
+		testReplaceOne(t, emoji.Replace, replaceTest{
+			input:  `mail user:tada:@example.com`,
+			output: `mail user<abbr class="emoji" title="party popper">🎉</abbr>@example.com`,
+		})
+	})
+}
+
 func TestReplace(t *testing.T) {
 	t.Run("Global", func(t *testing.T) {
 		testReplace(t, emoji.Replace)
@@ -108,42 +175,250 @@ func testReplace(t *testing.T, replace func(...*html.Node) []*html.Node) {
 	for _, tt := range replaceTests {
 		tt := tt
 		t.Run(tt.name, func(t *testing.T) {
-			input, err := html.ParseFragment(strings.NewReader(tt.input), &html.Node{
-				Type:     html.ElementNode,
-				Data:     "div",
-				DataAtom: atom.Div,
-			})
-			if err != nil {
+			testReplaceOne(t, replace, tt)
+		})
+	}
+}
+
+func testReplaceOne(t *testing.T, replace func(...*html.Node) []*html.Node, tt replaceTest) {
+	input, err := html.ParseFragment(strings.NewReader(tt.input), &html.Node{
+		Type:     html.ElementNode,
+		Data:     "div",
+		DataAtom: atom.Div,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	nodes := replace(input...)
+
+	var buf bytes.Buffer
+	for _, n := range nodes {
+		err = html.Render(&buf, n)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if output := buf.String(); tt.output != output {
+		t.Errorf("input %q\nexpected %q\nactual   %q", tt.input, tt.output, output)
+	}
+
+	nodes = replace(nodes...)
+
+	buf.Reset()
+	for _, n := range nodes {
+		err = html.Render(&buf, n)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if output := buf.String(); tt.output != output {
+		t.Errorf("output 1: %q\noutput 2: %q", tt.output, output)
+	}
+}
+
+func TestMatchPrefix(t *testing.T) {
+	for _, tt := range []struct {
+		text     string
+		expected int
+	}{
+		{"", 0},
+		{"hello", 0},
+		{":tada:", len(":tada:")},
+		{":TADA: and more", len(":TADA:")},
+		{":corn:n:", len(":corn:")},
+		{"🍿🍿", len("🍿")},
+		{":wtf:", 0},
+	} {
+		if actual := emoji.MatchPrefix(tt.text); tt.expected != actual {
+			t.Errorf("%q: %d != %d", tt.text, tt.expected, actual)
+		}
+	}
+
+	if expected, actual := len(":wtf:"), testConfig.MatchPrefix(":wtf:!"); expected != actual {
+		t.Errorf("Config: %d != %d", expected, actual)
+	}
+}
+
+func TestNode(t *testing.T) {
+	for _, tt := range []struct {
+		replace func(...*html.Node) []*html.Node
+		node    func(string) *html.Node
+		name    string
+	}{
+		{emoji.Replace, emoji.Node, ":tada:"},
+		{emoji.Replace, emoji.Node, "🍿"},
+		{testConfig.Replace, testConfig.Node, ":wtf:"},
+	} {
+		var expected, actual bytes.Buffer
+		for _, n := range tt.replace(&html.Node{Type: html.TextNode, Data: tt.name}) {
+			if err := html.Render(&expected, n); err != nil {
 				t.Fatal(err)
 			}
+		}
+		if err := html.Render(&actual, tt.node(tt.name)); err != nil {
+			t.Fatal(err)
+		}
+		if expected.String() != actual.String() {
+			t.Errorf("%s: %q != %q", tt.name, expected.String(), actual.String())
+		}
+	}
 
-			nodes := replace(input...)
+	if n := emoji.Node(":wtf:"); n != nil {
+		t.Errorf("unexpected node for :wtf:")
+	}
+}
 
-			var buf bytes.Buffer
-			for _, n := range nodes {
-				err = html.Render(&buf, n)
-				if err != nil {
-					t.Fatal(err)
-				}
-			}
+func BenchmarkReplace(b *testing.B) {
+	text := strings.Repeat("To the :popcorn: thread! 🎉 It’s :smile_cat: time, :nope: ", 100)
 
-			if output := buf.String(); tt.output != output {
-				t.Errorf("input %q\nexpected %q\nactual   %q", tt.input, tt.output, output)
-			}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		emoji.Replace(&html.Node{
+			Type: html.TextNode,
+			Data: text,
+		})
+	}
+}
 
-			nodes = replace(nodes...)
+func TestJumbo(t *testing.T) {
+	conf := &emoji.Config{JumboClass: "emoji-jumbo", JumboMax: 3}
+	conf.AddImage("/images/shipit.png", "ship it!", []string{"shipit"}, "GitHub", nil)
 
-			buf.Reset()
-			for _, n := range nodes {
-				err = html.Render(&buf, n)
-				if err != nil {
-					t.Fatal(err)
-				}
-			}
+	for _, tt := range []replaceTest{
+		{
+			name:   "Single",
+			input:  `🎉`,
+			output: `<abbr class="emoji emoji-jumbo" title="party popper">🎉</abbr>`,
+		},
+		{
+			name:   "Image",
+			input:  ` :shipit: :tada: `,
+			output: ` <img src="/images/shipit.png" alt=":shipit:" class="emoji emoji-jumbo" title="ship it!"/> <abbr class="emoji emoji-jumbo" title="party popper">🎉</abbr> `,
+		},
+		{
+			name:   "TooMany",
+			input:  `🎉🎉🎉🎉`,
+			output: `<abbr class="emoji" title="party popper">🎉</abbr><abbr class="emoji" title="party popper">🎉</abbr><abbr class="emoji" title="party popper">🎉</abbr><abbr class="emoji" title="party popper">🎉</abbr>`,
+		},
+		{
+			name:   "Text",
+			input:  `yay 🎉`,
+			output: `yay <abbr class="emoji" title="party popper">🎉</abbr>`,
+		},
+		{
+			name:   "Block",
+			input:  `<p>yay</p><p><b>🎉</b></p>`,
+			output: `<p>yay</p><p><b><abbr class="emoji emoji-jumbo" title="party popper">🎉</abbr></b></p>`,
+		},
+		{
+			name:   "NestedBlocks",
+			input:  `<div><blockquote><p>🎉</p><p>yay 🎉</p></blockquote><ul><li>:tada:</li></ul> yay</div>`,
+			output: `<div><blockquote><p><abbr class="emoji emoji-jumbo" title="party popper">🎉</abbr></p><p>yay <abbr class="emoji" title="party popper">🎉</abbr></p></blockquote><ul><li><abbr class="emoji emoji-jumbo" title="party popper">🎉</abbr></li></ul> yay</div>`,
+		},
+		{
+			name:   "Inline",
+			input:  `yay <b>🎉</b>`,
+			output: `yay <b><abbr class="emoji" title="party popper">🎉</abbr></b>`,
+		},
+		{
+			name:   "AlreadyReplaced",
+			input:  `<abbr class="emoji" title="party popper">🎉</abbr> :tada:`,
+			output: `<abbr class="emoji emoji-jumbo" title="party popper">🎉</abbr> <abbr class="emoji emoji-jumbo" title="party popper">🎉</abbr>`,
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			testReplaceOne(t, conf.Replace, tt)
+		})
+	}
 
-			if output := buf.String(); tt.output != output {
-				t.Errorf("output 1: %q\noutput 2: %q", tt.output, output)
-			}
+	t.Run("Part", func(t *testing.T) {
+		testReplaceOne(t, func(nodes ...*html.Node) []*html.Node {
+			return conf.ReplacePart(false, nodes...)
+		}, replaceTest{
+			input:  `🎉`,
+			output: `<abbr class="emoji" title="party popper">🎉</abbr>`,
+		})
+		testReplaceOne(t, func(nodes ...*html.Node) []*html.Node {
+			return conf.ReplacePart(true, nodes...)
+		}, replaceTest{
+			input:  `yay 🎉`,
+			output: `yay <abbr class="emoji emoji-jumbo" title="party popper">🎉</abbr>`,
+		})
+	})
+}
+
+func TestImageInfo(t *testing.T) {
+	var conf emoji.Config
+	conf.AddImageInfo(emoji.ImageInfo{
+		URL:    "/images/shipit.png",
+		Srcset: []string{"/images/shipit@2x.png 2x", "/images/shipit@3x.png 3x"},
+		Width:  20,
+		Height: 20,
+	}, "ship it!", []string{"shipit"}, "GitHub", nil)
+	conf.AddImageInfo(emoji.ImageInfo{
+		URL:          "/images/partyparrot.gif",
+		Width:        24,
+		Height:       24,
+		Animated:     true,
+		StaticURL:    "/images/partyparrot.png",
+		StaticSrcset: []string{"/images/partyparrot@2x.png 2x"},
+	}, "party parrot", []string{"partyparrot"}, "Custom", nil)
+	conf.AddImageInfo(emoji.ImageInfo{
+		URL:      "/images/blobdance.gif",
+		Animated: true,
+	}, "dancing blob", []string{"blobdance"}, "Custom", nil)
+
+	for _, tt := range []replaceTest{
+		{
+			name:   "Srcset",
+			input:  `:shipit:`,
+			output: `<img src="/images/shipit.png" alt=":shipit:" class="emoji" title="ship it!" srcset="/images/shipit@2x.png 2x, /images/shipit@3x.png 3x" width="20" height="20"/>`,
+		},
+		{
+			name:   "Animated",
+			input:  `:partyparrot:`,
+			output: `<picture class="emoji"><source srcset="/images/partyparrot.png, /images/partyparrot@2x.png 2x" media="(prefers-reduced-motion: reduce)"/><img src="/images/partyparrot.gif" alt=":partyparrot:" title="party parrot" width="24" height="24"/></picture>`,
+		},
+		{
+			name:   "AnimatedWithoutStatic",
+			input:  `:blobdance:`,
+			output: `<img src="/images/blobdance.gif" alt=":blobdance:" class="emoji" title="dancing blob"/>`,
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			testReplaceOne(t, conf.Replace, tt)
 		})
 	}
+
+	t.Run("DescribeNodes", func(t *testing.T) {
+		nodes := conf.DescribeNodes(conf.Replace(&html.Node{
+			Type: html.TextNode,
+			Data: `:partyparrot: :shipit:`,
+		})...)
+
+		var buf bytes.Buffer
+		for _, n := range nodes {
+			if err := html.Render(&buf, n); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if actual := buf.String(); actual != "party parrot ship it!" {
+			t.Errorf("unexpected output %q", actual)
+		}
+	})
+
+	t.Run("Jumbo", func(t *testing.T) {
+		conf.JumboClass = "emoji-jumbo"
+		defer func() { conf.JumboClass = "" }()
+
+		testReplaceOne(t, conf.Replace, replaceTest{
+			input:  `:partyparrot:`,
+			output: `<picture class="emoji emoji-jumbo"><source srcset="/images/partyparrot.png, /images/partyparrot@2x.png 2x" media="(prefers-reduced-motion: reduce)"/><img src="/images/partyparrot.gif" alt=":partyparrot:" title="party parrot" width="24" height="24"/></picture>`,
+		})
+	})
 }
@@ -9,8 +9,9 @@ import (
 //
 // Either Emoji or ImageURL will return a non-empty string, but not both.
 type SearchResult struct {
-	emoji *emoji
-	score int
+	emoji      *emoji
+	score      int
+	annotation *annotation
 }
 
 // Emoji is the Unicode emoji.
@@ -20,7 +21,28 @@ func (s SearchResult) Emoji() string {
 
 // ImageURL is the URL of an image representing this emoji.
 func (s SearchResult) ImageURL() string {
-	return s.emoji.imageURL
+	if s.emoji.image == nil {
+		return ""
+	}
+	return s.emoji.image.URL
+}
+
+// Animated reports whether the emoji is an animated image.
+func (s SearchResult) Animated() bool {
+	return s.emoji.image != nil && s.emoji.image.Animated
+}
+
+// SpriteName is the alias used in the class name of the emoji in a sprite
+// sheet, or the empty string if it cannot be shown from one because it is not
+// an image, it is animated, or all of its aliases contain whitespace.
+func (s SearchResult) SpriteName() string {
+	return s.emoji.spriteName()
+}
+
+// Text is the emoji as plain text: the Unicode emoji, the text given for an
+// image in AddImageInfo, or the first shortcode.
+func (s SearchResult) Text() string {
+	return s.emoji.plainText("")
 }
 
 // Aliases is a slice of textual shortcodes that can be used between colons to
@@ -34,6 +56,25 @@ func (s SearchResult) Description() string {
 	return s.emoji.description
 }
 
+// LocalDescription is the textual description of the emoji in the locale of
+// the Config that was searched. If there is no localised description, the
+// English description is returned.
+func (s SearchResult) LocalDescription() string {
+	if s.annotation != nil && s.annotation.description != "" {
+		return s.annotation.description
+	}
+	return s.emoji.description
+}
+
+// Keywords is a slice of localised keywords for the emoji in the locale of the
+// Config that was searched.
+func (s SearchResult) Keywords() []string {
+	if s.annotation == nil {
+		return nil
+	}
+	return s.annotation.keywords
+}
+
 // Score is the likelihood of the result being correct. Higher is better.
 func (s SearchResult) Score() int {
 	return s.score
@@ -59,47 +100,92 @@ func (s searchResults) Less(i, j int) bool {
 	return s[i].score > s[j].score
 }
 
+// Lookup returns the emoji for a Unicode emoji or shortcode such as :tada:.
+// Shortcodes are matched regardless of case.
+func Lookup(name string) (SearchResult, bool) {
+	return defaultConfig.Lookup(name)
+}
+
+// Lookup returns the emoji for a Unicode emoji or shortcode such as :tada:.
+// Shortcodes are matched regardless of case unless conf.CaseSensitive is set.
+func (conf *Config) Lookup(name string) (SearchResult, bool) {
+	e, ok := conf.lookupEmoji(name)
+	if !ok {
+		return SearchResult{}, false
+	}
+
+	return SearchResult{emoji: e, annotation: conf.annotation(e)}, true
+}
+
 // Search returns a list of possible emoji for a query. The query is the text
-// between the colon (:) and the user's cursor.
+// between the colon (:) and the user's cursor. If the query is a Unicode
+// emoji, that emoji is returned first, followed by related emoji.
 func Search(query string, max int) []SearchResult {
 	return defaultConfig.Search(query, max)
 }
 
 // Search returns a list of possible emoji for a query. The query is the text
-// between the colon (:) and the user's cursor.
+// between the colon (:) and the user's cursor. If the query is a Unicode
+// emoji, that emoji is returned first, followed by related emoji.
 func (conf *Config) Search(query string, max int) []SearchResult {
 	if query == "" || max <= 0 {
 		return nil
 	}
 
-	query = strings.ToLower(query)
-
 	results := make(searchResults, 0, max)
 
-	results = conf.searchName(results, query, 3000)
+	if query[0] != ':' {
+		if e, ok := conf.lookupEmoji(query); ok {
+			results = append(results, SearchResult{emoji: e, score: 500 + 3000})
+			results = append(results, conf.related(make(searchResults, 0, max-1), e)...)
+			return conf.annotateResults(results)
+		}
+	}
+
+	nameQuery := conf.key(query)
+	query = fold(query)
+	d := defaults()
+
+	results = conf.searchName(results, nameQuery, 3000)
 	results = conf.searchDescription(results, query, 2000)
-	results = conf.searchSet(results, query, 1000, conf.byTag, conf.tags, byTag, tags)
-	results = conf.searchSet(results, query, 0, conf.byCategory, conf.categories, byCategory, categories)
+	results = conf.searchSet(results, query, 1000, conf.byTag, conf.tags, d.byTag, d.tags)
+	results = conf.searchKeywords(results, query, 1000)
+	results = conf.searchSet(results, query, 0, conf.byCategory, conf.categories, d.byCategory, d.categories)
 
 	if len(results) < cap(results) {
 		sort.Sort(results)
 	}
 
+	return conf.annotateResults(results)
+}
+
+func (conf *Config) annotateResults(results searchResults) []SearchResult {
+	if conf.Locale != "" {
+		for i := range results {
+			results[i].annotation = conf.annotation(results[i].emoji)
+		}
+	}
+
 	return results
 }
 
 func (conf *Config) searchName(results searchResults, query string, bonus int) searchResults {
+	matchName := match
+	if conf.CaseSensitive {
+		matchName = matchExact
+	}
+
 	for name, e := range conf.byName {
-		if result, ok := match(query, strings.Trim(name, ":"), e, bonus); ok {
+		if result, ok := matchName(query, strings.Trim(name, ":"), e, bonus); ok {
 			results = addResult(results, result)
 		}
 	}
-	for name, e := range byName {
+	for name, e := range defaults().byName {
 		if conf.overrides(e) {
 			continue
 		}
 
-		if result, ok := match(query, strings.Trim(name, ":"), e, bonus); ok {
+		if result, ok := matchName(query, strings.Trim(name, ":"), e, bonus); ok {
 			results = addResult(results, result)
 		}
 	}
@@ -109,11 +195,12 @@ func (conf *Config) searchName(results searchResults, query string, bonus int) s
 
 func (conf *Config) searchDescription(results searchResults, query string, bonus int) searchResults {
 	for _, e := range conf.emoji {
-		if result, ok := match(query, e.description, e, bonus); ok {
+		if result, ok := conf.matchDescription(query, e, bonus); ok {
 			results = addResult(results, result)
 		}
 	}
 
+	allEmoji := defaults().allEmoji
 	for i := range allEmoji {
 		e := &allEmoji[i]
 
@@ -121,7 +208,7 @@ func (conf *Config) searchDescription(results searchResults, query string, bonus
 			continue
 		}
 
-		if result, ok := match(query, e.description, e, bonus); ok {
+		if result, ok := conf.matchDescription(query, e, bonus); ok {
 			results = addResult(results, result)
 		}
 	}
@@ -129,12 +216,67 @@ func (conf *Config) searchDescription(results searchResults, query string, bonus
 	return results
 }
 
+func (conf *Config) matchDescription(query string, e *emoji, bonus int) (SearchResult, bool) {
+	result, ok := match(query, e.description, e, bonus)
+
+	if a := conf.annotation(e); a != nil {
+		if local, localOK := match(query, a.description, e, bonus); localOK && (!ok || local.score > result.score) {
+			result, ok = local, true
+		}
+	}
+
+	return result, ok
+}
+
+func (conf *Config) searchKeywords(results searchResults, query string, bonus int) searchResults {
+	if conf.Locale == "" {
+		return results
+	}
+
+	for _, e := range conf.emoji {
+		results = conf.matchKeywords(results, query, e, bonus)
+	}
+
+	allEmoji := defaults().allEmoji
+	for i := range allEmoji {
+		e := &allEmoji[i]
+
+		if conf.overrides(e) {
+			continue
+		}
+
+		results = conf.matchKeywords(results, query, e, bonus)
+	}
+
+	return results
+}
+
+func (conf *Config) matchKeywords(results searchResults, query string, e *emoji, bonus int) searchResults {
+	a := conf.annotation(e)
+	if a == nil {
+		return results
+	}
+
+	var best SearchResult
+	found := false
+	for _, kw := range a.keywords {
+		if result, ok := match(query, kw, e, bonus); ok && (!found || result.score > best.score) {
+			best, found = result, true
+		}
+	}
+	if found {
+		results = addResult(results, best)
+	}
+
+	return results
+}
+
 func (conf *Config) searchSet(results searchResults, query string, bonus int, byLocal [][]*emoji, namesLocal []string, by [][]*emoji, names []string) searchResults {
 	for i, es := range byLocal {
 		if result, ok := match(query, namesLocal[i], es[0], bonus); ok {
 			results = addResult(results, result)
 			for _, e := range es[1:] {
-				results = addResult(results, SearchResult{e, result.score})
+				results = addResult(results, SearchResult{emoji: e, score: result.score})
 			}
 		}
 	}
@@ -146,7 +288,7 @@ func (conf *Config) searchSet(results searchResults, query string, bonus int, by
 			}
 			for _, e := range es[1:] {
 				if !conf.overrides(e) {
-					results = addResult(results, SearchResult{e, result.score})
+					results = addResult(results, SearchResult{emoji: e, score: result.score})
 				}
 			}
 		}
@@ -184,18 +326,21 @@ func addResult(results searchResults, result SearchResult) searchResults {
 }
 
 func match(query, actual string, e *emoji, bonus int) (SearchResult, bool) {
-	actual = strings.ToLower(actual)
+	return matchExact(query, fold(actual), e, bonus)
+}
 
+// matchExact is like match, but actual is compared without folding it.
+func matchExact(query, actual string, e *emoji, bonus int) (SearchResult, bool) {
 	if query == actual {
-		return SearchResult{e, 500 + bonus}, true
+		return SearchResult{emoji: e, score: 500 + bonus}, true
 	}
 
 	if strings.HasPrefix(actual, query) {
-		return SearchResult{e, len(query)*3 - len(actual) + bonus}, true
+		return SearchResult{emoji: e, score: len(query)*3 - len(actual) + bonus}, true
 	}
 
 	if strings.Contains(actual, query) {
-		return SearchResult{e, len(query)*2 - len(actual) + bonus}, true
+		return SearchResult{emoji: e, score: len(query)*2 - len(actual) + bonus}, true
 	}
 
 	return SearchResult{}, false
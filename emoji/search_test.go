@@ -175,3 +175,26 @@ func TestSearchResult(t *testing.T) {
 		t.Errorf("Score: %d != %d", expected, actual)
 	}
 }
+
+func TestLookup(t *testing.T) {
+	for _, name := range []string{"💽", ":minidisc:", ":MiniDisc:"} {
+		result, ok := emoji.Lookup(name)
+		if !ok {
+			t.Errorf("%q: not found", name)
+		} else if expected, actual := "computer disk", result.Description(); expected != actual {
+			t.Errorf("%q: %q != %q", name, expected, actual)
+		}
+	}
+
+	if result, ok := testConfig.Lookup(":shipit:"); !ok {
+		t.Errorf(":shipit: not found")
+	} else if expected, actual := "https://assets-cdn.github.com/images/icons/emoji/shipit.png", result.ImageURL(); expected != actual {
+		t.Errorf("ImageURL: %q != %q", expected, actual)
+	}
+
+	for _, name := range []string{"", "minidisc", ":shipit:", ":minidisc"} {
+		if _, ok := emoji.Lookup(name); ok {
+			t.Errorf("%q: unexpectedly found", name)
+		}
+	}
+}
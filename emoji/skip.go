@@ -0,0 +1,131 @@
+package emoji
+
+import (
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// skipElement lists the HTML elements whose contents are left unchanged
+// unless they are in Config.AllowElements.
+var skipElement = map[atom.Atom]bool{
+	atom.Script: true,
+	atom.Style:  true,
+	atom.Pre:    true,
+	atom.Code:   true,
+}
+
+// foreignText lists the SVG and MathML elements whose text is processed when
+// Config.ReplaceForeign is set.
+var foreignText = map[string]map[string]bool{
+	"svg": {
+		"text":     true,
+		"tspan":    true,
+		"textPath": true,
+		"title":    true,
+		"desc":     true,
+	},
+	"math": {
+		"mi":    true,
+		"mo":    true,
+		"mn":    true,
+		"ms":    true,
+		"mtext": true,
+	},
+}
+
+// skip reports whether the contents of the element node should be left
+// unchanged.
+func (conf *Config) skip(node *html.Node) bool {
+	if node.Namespace != "" {
+		if !conf.ReplaceForeign {
+			return true
+		}
+		return node.Data == "script" || node.Data == "style"
+	}
+
+	if skipElement[node.DataAtom] || containsString(conf.SkipElements, node.Data) {
+		if !containsString(conf.AllowElements, node.Data) {
+			return true
+		}
+	}
+
+	for _, a := range node.Attr {
+		if a.Namespace != "" {
+			continue
+		}
+		if a.Key == "class" && len(conf.SkipClasses) != 0 {
+			for _, class := range strings.Fields(a.Val) {
+				if containsString(conf.SkipClasses, class) {
+					return true
+				}
+			}
+		}
+		if a.Key == "contenteditable" && conf.SkipEditable && !strings.EqualFold(a.Val, "false") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// replaceForeignText replaces emoji in the text of an SVG or MathML element.
+// HTML elements cannot be used there, so Unicode emoji are inserted as plain
+// text and image emoji are left alone.
+func (conf *Config) replaceForeignText(node *html.Node) []*html.Node {
+	result := shallowClone(node)
+
+	matches := conf.findEmoji(node.Data)
+	if len(matches) == 0 {
+		return []*html.Node{result}
+	}
+
+	buf := make([]byte, 0, len(node.Data))
+	last := 0
+	for _, match := range matches {
+		if match.e.emoji == "" {
+			continue
+		}
+		buf = append(buf, node.Data[last:match.start]...)
+		buf = append(buf, match.e.emoji...)
+		last = match.end
+	}
+	buf = append(buf, node.Data[last:]...)
+
+	result.Data = string(buf)
+	return []*html.Node{result}
+}
+
+func containsString(list []string, s string) bool {
+	for _, l := range list {
+		if l == s {
+			return true
+		}
+	}
+	return false
+}
+
+// hasClass reports whether class is in the class attribute of node.
+func hasClass(node *html.Node, class string) bool {
+	for _, a := range node.Attr {
+		if a.Namespace == "" && a.Key == "class" {
+			return containsString(strings.Fields(a.Val), class)
+		}
+	}
+	return false
+}
+
+// addClass adds class to the class attribute of node if it is not already
+// there.
+func addClass(node *html.Node, class string) {
+	for i, a := range node.Attr {
+		if a.Namespace == "" && a.Key == "class" {
+			if !containsString(strings.Fields(a.Val), class) {
+				node.Attr[i].Val += " " + class
+			}
+			return
+		}
+	}
+	node.Attr = append(node.Attr, html.Attribute{Key: "class", Val: class})
+}
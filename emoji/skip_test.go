@@ -0,0 +1,97 @@
+package emoji_test
+
+import (
+	"strings"
+	"testing"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+
+	"github.com/BenLubar/hellstew/emoji"
+)
+
+var skipConfig = func() *emoji.Config {
+	conf := &emoji.Config{
+		SkipElements:   []string{"kbd", "samp", "textarea"},
+		AllowElements:  []string{"pre"},
+		SkipClasses:    []string{"no-emoji"},
+		SkipEditable:   true,
+		ReplaceForeign: true,
+	}
+
+	conf.AddImage("https://assets-cdn.github.com/images/icons/emoji/trollface.png", "trollface", []string{"trollface"}, "GitHub", nil)
+
+	return conf
+}()
+
+var skipTests = [...]replaceTest{
+	{
+		name:   "Elements",
+		input:  `<kbd>:tada:</kbd><samp>:tada:</samp><textarea>:tada:</textarea><code>:tada:</code>`,
+		output: `<kbd>:tada:</kbd><samp>:tada:</samp><textarea>:tada:</textarea><code>:tada:</code>`,
+	},
+	{
+		name:   "Allow",
+		input:  `<pre>:tada:</pre>`,
+		output: `<pre><abbr class="emoji" title="party popper">🎉</abbr></pre>`,
+	},
+	{
+		name:   "Class",
+		input:  `<p class="quote no-emoji">:tada:</p><p class="no-emojis">:tada:</p>`,
+		output: `<p class="quote no-emoji">:tada:</p><p class="no-emojis"><abbr class="emoji" title="party popper">🎉</abbr></p>`,
+	},
+	{
+		name:   "Editable",
+		input:  `<div contenteditable="">:tada:</div><div contenteditable="false">:tada:</div>`,
+		output: `<div contenteditable="">:tada:</div><div contenteditable="false"><abbr class="emoji" title="party popper">🎉</abbr></div>`,
+	},
+	{
+		name:   "SVG",
+		input:  `<svg><text>:tada: :trollface:</text><style>:tada:</style>:tada:<foreignObject><p>:tada:</p></foreignObject></svg>`,
+		output: `<svg><text>🎉 :trollface:</text><style>:tada:</style>:tada:<foreignObject><p><abbr class="emoji" title="party popper">🎉</abbr></p></foreignObject></svg>`,
+	},
+	{
+		name:   "MathML",
+		input:  `<math><mi>:tada:</mi></math>`,
+		output: `<math><mi>🎉</mi></math>`,
+	},
+}
+
+func TestSkip(t *testing.T) {
+	t.Run("Config", func(t *testing.T) {
+		for _, tt := range skipTests {
+			tt := tt
+			t.Run(tt.name, func(t *testing.T) {
+				testReplaceOne(t, skipConfig.Replace, tt)
+			})
+		}
+	})
+
+	t.Run("Default", func(t *testing.T) {
+		testReplaceOne(t, emoji.Replace, replaceTest{
+			input:  `<pre>:tada:</pre><kbd>:tada:</kbd><svg><text>:tada:</text></svg>`,
+			output: `<pre>:tada:</pre><kbd><abbr class="emoji" title="party popper">🎉</abbr></kbd><svg><text>:tada:</text></svg>`,
+		})
+	})
+
+	t.Run("ForeignText", func(t *testing.T) {
+		// The text node is passed to Replace directly, so its parent
+		// element is never checked by skip.
+		nodes, err := html.ParseFragment(strings.NewReader(`<svg><text>:tada:</text></svg>`), &html.Node{
+			Type:     html.ElementNode,
+			Data:     "div",
+			DataAtom: atom.Div,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		text := nodes[0].FirstChild.FirstChild
+
+		if actual := emoji.Replace(text); len(actual) != 1 || actual[0].Data != ":tada:" {
+			t.Errorf("Replace: text in SVG replaced without ReplaceForeign")
+		}
+		if actual := skipConfig.Replace(text); len(actual) != 1 || actual[0].Data != "🎉" {
+			t.Errorf("Replace: text in SVG not replaced with ReplaceForeign")
+		}
+	})
+}
@@ -0,0 +1,276 @@
+// Package sprite packs the image emoji of an emoji.Config into a sprite
+// sheet, so that pages load one image instead of one for each emoji.
+//
+// The sheet is a PNG image with a square cell for each emoji, and a CSS file
+// with a class for each emoji that shows its cell at the size of the text.
+// Set the Config's SpritePrefix to the same prefix as Options.Prefix so that
+// Replace uses the classes.
+package sprite
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"math"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	// Register the formats that Dir can load.
+	_ "image/gif"
+	_ "image/jpeg"
+
+	"github.com/BenLubar/hellstew/emoji"
+)
+
+// Options controls the layout of a Sheet. The zero value is ready to use.
+type Options struct {
+	// Size is the width and height in pixels of each cell. Images are
+	// scaled to fit, keeping their aspect ratio. If Size is zero, 64 is
+	// used.
+	Size int
+
+	// Columns is the number of cells in each row. If Columns is zero, the
+	// sheet is made as close to square as possible.
+	Columns int
+
+	// Prefix is the start of each class name. If Prefix is empty, "emoji-"
+	// is used.
+	Prefix string
+}
+
+// Sheet is a sprite sheet.
+type Sheet struct {
+	// Image is the sprite sheet image.
+	Image *image.NRGBA
+
+	size, columns, rows int
+	prefix              string
+	names               []string
+}
+
+// Build makes a sprite sheet from the image emoji in conf. The image for each
+// emoji is read by load. Animated images are left out, as a sprite sheet
+// cannot contain animation, as are images whose aliases all contain
+// whitespace, which cannot be used in a class name. Replace does not use the
+// sprite sheet for them. Each class name is made from the emoji's SpriteName.
+func Build(conf *emoji.Config, load func(emoji.SearchResult) (image.Image, error), opts Options) (*Sheet, error) {
+	if opts.Size == 0 {
+		opts.Size = 64
+	}
+	if opts.Prefix == "" {
+		opts.Prefix = "emoji-"
+	}
+	if opts.Size < 0 || opts.Columns < 0 {
+		return nil, fmt.Errorf("sprite: invalid options: %+v", opts)
+	}
+
+	var images []emoji.SearchResult
+	for _, result := range conf.Images() {
+		if result.SpriteName() != "" {
+			images = append(images, result)
+		}
+	}
+
+	s := &Sheet{
+		size:    opts.Size,
+		columns: opts.Columns,
+		prefix:  opts.Prefix,
+		names:   make([]string, len(images)),
+	}
+	if s.columns == 0 {
+		s.columns = int(math.Ceil(math.Sqrt(float64(len(images)))))
+	}
+	if s.columns != 0 {
+		s.rows = (len(images) + s.columns - 1) / s.columns
+	}
+	s.Image = image.NewNRGBA(image.Rect(0, 0, s.columns*s.size, s.rows*s.size))
+
+	for i, result := range images {
+		img, err := load(result)
+		if err != nil {
+			return nil, fmt.Errorf("sprite: %s: %v", result.ImageURL(), err)
+		}
+
+		s.names[i] = result.SpriteName()
+		cell := image.Rect(0, 0, s.size, s.size).Add(image.Pt(i%s.columns*s.size, i/s.columns*s.size))
+		drawScaled(s.Image, fit(cell, img.Bounds()), img)
+	}
+
+	return s, nil
+}
+
+// WritePNG writes the sprite sheet image to w.
+func (s *Sheet) WritePNG(w io.Writer) error {
+	return png.Encode(w, s.Image)
+}
+
+// WriteCSS writes the CSS for the sprite sheet to w. url is the URL of the
+// image written by WritePNG, relative to the CSS file.
+func (s *Sheet) WriteCSS(w io.Writer, url string) error {
+	if len(s.names) == 0 {
+		return nil
+	}
+
+	selectors := make([]string, len(s.names))
+	for i, name := range s.names {
+		selectors[i] = "." + cssEscape(s.prefix+name)
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "%s {\n", strings.Join(selectors, ",\n"))
+	fmt.Fprintf(&buf, "\tdisplay: inline-block;\n")
+	fmt.Fprintf(&buf, "\twidth: 1em;\n")
+	fmt.Fprintf(&buf, "\theight: 1em;\n")
+	fmt.Fprintf(&buf, "\tvertical-align: -0.1em;\n")
+	fmt.Fprintf(&buf, "\tbackground: url(%s) no-repeat;\n", cssString(url))
+	fmt.Fprintf(&buf, "\tbackground-size: %d%% %d%%;\n", s.columns*100, s.rows*100)
+	fmt.Fprintf(&buf, "}\n")
+
+	for i, selector := range selectors {
+		fmt.Fprintf(&buf, "%s { background-position: %s %s; }\n", selector, percent(i%s.columns, s.columns), percent(i/s.columns, s.rows))
+	}
+
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+// Dir returns a function for Build that reads each image from the file in dir
+// with the same name as the last element of its URL. PNG, GIF, and JPEG
+// images are supported.
+func Dir(dir string) func(emoji.SearchResult) (image.Image, error) {
+	return func(result emoji.SearchResult) (image.Image, error) {
+		name := result.ImageURL()
+		if i := strings.IndexAny(name, "?#"); i != -1 {
+			name = name[:i]
+		}
+
+		f, err := os.Open(filepath.Join(dir, path.Base(name)))
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+
+		img, _, err := image.Decode(f)
+		return img, err
+	}
+}
+
+// percent returns the background-position that shows cell i of n.
+func percent(i, n int) string {
+	if n <= 1 {
+		return "0"
+	}
+	return fmt.Sprintf("%.6g%%", float64(i)*100/float64(n-1))
+}
+
+// cssEscape escapes a class name for use in a CSS selector.
+func cssEscape(s string) string {
+	var buf bytes.Buffer
+	for i, r := range s {
+		switch {
+		case r == '-' || r == '_' || r >= 0x80,
+			'a' <= r && r <= 'z', 'A' <= r && r <= 'Z':
+			buf.WriteRune(r)
+		case '0' <= r && r <= '9':
+			if i == 0 {
+				fmt.Fprintf(&buf, "\\%x ", r)
+			} else {
+				buf.WriteRune(r)
+			}
+		default:
+			buf.WriteByte('\\')
+			buf.WriteRune(r)
+		}
+	}
+	return buf.String()
+}
+
+// cssString quotes s as a CSS string.
+func cssString(s string) string {
+	var buf bytes.Buffer
+	buf.WriteByte('"')
+	for _, r := range s {
+		switch {
+		case r == '"' || r == '\\':
+			buf.WriteByte('\\')
+			buf.WriteRune(r)
+		case r < 0x20 || r == 0x7f:
+			fmt.Fprintf(&buf, "\\%x ", r)
+		default:
+			buf.WriteRune(r)
+		}
+	}
+	buf.WriteByte('"')
+	return buf.String()
+}
+
+// fit returns the largest rectangle with the aspect ratio of src that fits in
+// the middle of cell.
+func fit(cell, src image.Rectangle) image.Rectangle {
+	size := cell.Dx()
+	w, h := src.Dx(), src.Dy()
+	if w <= 0 || h <= 0 {
+		return image.Rectangle{}
+	}
+
+	if w >= h {
+		w, h = size, (h*size+w/2)/w
+	} else {
+		w, h = (w*size+h/2)/h, size
+	}
+	if w == 0 {
+		w = 1
+	}
+	if h == 0 {
+		h = 1
+	}
+
+	min := cell.Min.Add(image.Pt((size-w)/2, (size-h)/2))
+	return image.Rectangle{Min: min, Max: min.Add(image.Pt(w, h))}
+}
+
+// drawScaled draws src scaled to fill r in dst. Each pixel of dst is the
+// average of the pixels of src that it covers, or the nearest pixel if it
+// covers less than one.
+func drawScaled(dst *image.NRGBA, r image.Rectangle, src image.Image) {
+	b := src.Bounds()
+	for y := r.Min.Y; y < r.Max.Y; y++ {
+		sy0 := b.Min.Y + (y-r.Min.Y)*b.Dy()/r.Dy()
+		sy1 := b.Min.Y + (y-r.Min.Y+1)*b.Dy()/r.Dy()
+		if sy1 <= sy0 {
+			sy1 = sy0 + 1
+		}
+
+		for x := r.Min.X; x < r.Max.X; x++ {
+			sx0 := b.Min.X + (x-r.Min.X)*b.Dx()/r.Dx()
+			sx1 := b.Min.X + (x-r.Min.X+1)*b.Dx()/r.Dx()
+			if sx1 <= sx0 {
+				sx1 = sx0 + 1
+			}
+
+			var red, green, blue, alpha, n uint64
+			for sy := sy0; sy < sy1; sy++ {
+				for sx := sx0; sx < sx1; sx++ {
+					cr, cg, cb, ca := src.At(sx, sy).RGBA()
+					red += uint64(cr)
+					green += uint64(cg)
+					blue += uint64(cb)
+					alpha += uint64(ca)
+					n++
+				}
+			}
+
+			dst.Set(x, y, color.RGBA64{
+				R: uint16(red / n),
+				G: uint16(green / n),
+				B: uint16(blue / n),
+				A: uint16(alpha / n),
+			})
+		}
+	}
+}
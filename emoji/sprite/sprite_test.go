@@ -0,0 +1,230 @@
+package sprite_test
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/color"
+	"image/png"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"golang.org/x/net/html"
+
+	"github.com/BenLubar/hellstew/emoji"
+	"github.com/BenLubar/hellstew/emoji/sprite"
+)
+
+var (
+	red   = color.NRGBA{R: 255, A: 255}
+	green = color.NRGBA{G: 255, A: 255}
+	blue  = color.NRGBA{B: 255, A: 255}
+)
+
+func spriteConfig() *emoji.Config {
+	conf := &emoji.Config{SpritePrefix: "emoji-"}
+
+	conf.AddImage("/images/shipit.png", "ship it!", []string{"shipit", "squirrel"}, "GitHub", nil)
+	conf.AddImage("/images/wide.png?v=2", "wide", []string{"wide"}, "Custom", nil)
+	conf.AddImageInfo(emoji.ImageInfo{
+		URL:       "/images/partyparrot.gif",
+		Animated:  true,
+		StaticURL: "/images/partyparrot.png",
+	}, "party parrot", []string{"partyparrot"}, "Custom", nil)
+	conf.AddImage("/images/plus1.png", "plus one", []string{"+1_custom"}, "Custom", nil)
+
+	return conf
+}
+
+func solid(w, h int, c color.Color) image.Image {
+	img := image.NewNRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			img.Set(x, y, c)
+		}
+	}
+	return img
+}
+
+var testImages = map[string]image.Image{
+	"/images/shipit.png":   solid(128, 128, red),
+	"/images/wide.png?v=2": solid(32, 16, green),
+	"/images/plus1.png":    solid(10, 10, blue),
+}
+
+func loadTestImage(result emoji.SearchResult) (image.Image, error) {
+	if img, ok := testImages[result.ImageURL()]; ok {
+		return img, nil
+	}
+	return nil, errors.New("not found")
+}
+
+func TestBuild(t *testing.T) {
+	sheet, err := sprite.Build(spriteConfig(), loadTestImage, sprite.Options{Size: 16})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if b := sheet.Image.Bounds(); b != image.Rect(0, 0, 32, 32) {
+		t.Fatalf("unexpected bounds %v", b)
+	}
+
+	for _, tt := range []struct {
+		x, y  int
+		color color.NRGBA
+	}{
+		{0, 0, red},
+		{15, 15, red},
+		{24, 8, green},
+		{24, 2, color.NRGBA{}},
+		{8, 24, blue},
+		{24, 24, color.NRGBA{}},
+	} {
+		if c := sheet.Image.NRGBAAt(tt.x, tt.y); c != tt.color {
+			t.Errorf("pixel (%d, %d): expected %v, got %v", tt.x, tt.y, tt.color, c)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err = sheet.WritePNG(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if img, err := png.Decode(&buf); err != nil {
+		t.Error(err)
+	} else if img.Bounds() != sheet.Image.Bounds() {
+		t.Errorf("PNG has bounds %v", img.Bounds())
+	}
+
+	buf.Reset()
+	if err = sheet.WriteCSS(&buf, "emoji.png"); err != nil {
+		t.Fatal(err)
+	}
+	expected := `.emoji-shipit,
+.emoji-wide,
+.emoji-\+1_custom {
+	display: inline-block;
+	width: 1em;
+	height: 1em;
+	vertical-align: -0.1em;
+	background: url("emoji.png") no-repeat;
+	background-size: 200% 200%;
+}
+.emoji-shipit { background-position: 0% 0%; }
+.emoji-wide { background-position: 100% 0%; }
+.emoji-\+1_custom { background-position: 0% 100%; }
+`
+	if actual := buf.String(); actual != expected {
+		t.Errorf("CSS:\nexpected %q\nactual   %q", expected, actual)
+	}
+}
+
+func TestWriteCSSURL(t *testing.T) {
+	sheet, err := sprite.Build(spriteConfig(), loadTestImage, sprite.Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if err = sheet.WriteCSS(&buf, "a\"b\\c\nd\x01é.png"); err != nil {
+		t.Fatal(err)
+	}
+	if expected := `background: url("a\"b\\c\a d\1 é.png") no-repeat;`; !strings.Contains(buf.String(), expected) {
+		t.Errorf("expected %q in CSS:\n%s", expected, buf.String())
+	}
+}
+
+func TestBuildError(t *testing.T) {
+	conf := spriteConfig()
+	conf.AddImage("/images/missing.png", "missing", []string{"missing"}, "Custom", nil)
+
+	_, err := sprite.Build(conf, loadTestImage, sprite.Options{})
+	if err == nil || err.Error() != "sprite: /images/missing.png: not found" {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestDir(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"shipit.png", "wide.png", "plus1.png"} {
+		f, err := os.Create(filepath.Join(dir, name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = png.Encode(f, solid(8, 8, red))
+		if closeErr := f.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	sheet, err := sprite.Build(spriteConfig(), sprite.Dir(dir), sprite.Options{Size: 8, Columns: 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b := sheet.Image.Bounds(); b != image.Rect(0, 0, 24, 8) {
+		t.Errorf("unexpected bounds %v", b)
+	}
+}
+
+func TestReplace(t *testing.T) {
+	conf := spriteConfig()
+	nodes := conf.Replace(&html.Node{
+		Type: html.TextNode,
+		Data: ":squirrel: :partyparrot: :tada:",
+	})
+
+	var buf bytes.Buffer
+	for _, n := range nodes {
+		if err := html.Render(&buf, n); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	expected := `<span class="emoji emoji-shipit" role="img" aria-label=":squirrel:" title="ship it!"></span> ` +
+		`<picture class="emoji"><source srcset="/images/partyparrot.png" media="(prefers-reduced-motion: reduce)"/><img src="/images/partyparrot.gif" alt=":partyparrot:" title="party parrot"/></picture> ` +
+		`<abbr class="emoji" title="party popper">🎉</abbr>`
+	if actual := buf.String(); actual != expected {
+		t.Errorf("expected %q\nactual   %q", expected, actual)
+	}
+
+	if actual := conf.DescribeNodes(nodes...); len(actual) != 5 || actual[0].Data != "ship it!" {
+		t.Errorf("DescribeNodes did not recognise the sprite")
+	}
+}
+
+func TestWhitespaceAlias(t *testing.T) {
+	conf := &emoji.Config{SpritePrefix: "emoji-"}
+	conf.AddImage("/images/shipit.png", "ship it!", []string{"ship it", "shipit"}, "GitHub", nil)
+	conf.AddImage("/images/bigship.png", "big ship", []string{"big ship"}, "Custom", nil)
+
+	sheet, err := sprite.Build(conf, func(emoji.SearchResult) (image.Image, error) {
+		return solid(8, 8, red), nil
+	}, sprite.Options{Size: 8})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if err = sheet.WriteCSS(&buf, "emoji.png"); err != nil {
+		t.Fatal(err)
+	}
+	if expected, actual := ".emoji-shipit { background-position: 0 0; }\n", buf.String(); !strings.HasSuffix(actual, expected) || strings.Count(actual, "background-position") != 1 {
+		t.Errorf("unexpected CSS %q", actual)
+	}
+
+	buf.Reset()
+	for _, name := range []string{":ship it:", ":big ship:"} {
+		if err = html.Render(&buf, conf.Node(name)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	expected := `<span class="emoji emoji-shipit" role="img" aria-label=":ship it:" title="ship it!"></span>` +
+		`<img src="/images/bigship.png" alt=":big ship:" class="emoji" title="big ship"/>`
+	if actual := buf.String(); actual != expected {
+		t.Errorf("expected %q\nactual   %q", expected, actual)
+	}
+}
@@ -1,19 +1,44 @@
 package emoji
 
-import "strings"
+import "golang.org/x/text/cases"
 
+// state is a node in a trie of folded names. Transitions are stored sparsely,
+// sorted by byte, so a node costs a few words plus one edge per child rather
+// than a full table of 256 pointers. States are shared between tries, so they
+// must not be modified once they are reachable from a Config; add returns a
+// modified copy instead.
 type state struct {
-	next [256]*state
-	term bool
+	edges []edge
+	term  bool
 }
 
-var startState = func() *state {
-	var root state
-	for name := range byName {
-		root.addInPlace(strings.ToLower(name))
+type edge struct {
+	b    byte
+	next *state
+}
+
+// search returns the index of the edge for b, or the index at which it would
+// be inserted.
+func (s *state) search(b byte) int {
+	lo, hi := 0, len(s.edges)
+	for lo < hi {
+		mid := int(uint(lo+hi) >> 1)
+		if s.edges[mid].b < b {
+			lo = mid + 1
+		} else {
+			hi = mid
+		}
 	}
-	return &root
-}()
+	return lo
+}
+
+// next returns the state after reading b, or nil if there is none.
+func (s *state) next(b byte) *state {
+	if i := s.search(b); i < len(s.edges) && s.edges[i].b == b {
+		return s.edges[i].next
+	}
+	return nil
+}
 
 func (s *state) addInPlace(name string) {
 	for {
@@ -21,11 +46,14 @@ func (s *state) addInPlace(name string) {
 			s.term = true
 			return
 		}
-		if s.next[name[0]] == nil {
-			s.next[name[0]] = makeState(name[1:])
+		i := s.search(name[0])
+		if i == len(s.edges) || s.edges[i].b != name[0] {
+			s.edges = append(s.edges, edge{})
+			copy(s.edges[i+1:], s.edges[i:])
+			s.edges[i] = edge{b: name[0], next: makeState(name[1:])}
 			return
 		}
-		s = s.next[name[0]]
+		s = s.edges[i].next
 		name = name[1:]
 	}
 }
@@ -33,17 +61,24 @@ func (s *state) addInPlace(name string) {
 func (s *state) add(name string) *state {
 	if name == "" {
 		return &state{
-			next: s.next,
-			term: true,
+			edges: s.edges,
+			term:  true,
 		}
 	}
-	clone := *s
-	if clone.next[name[0]] != nil {
-		clone.next[name[0]] = clone.next[name[0]].add(name[1:])
+
+	clone := &state{term: s.term}
+	i := s.search(name[0])
+	if i < len(s.edges) && s.edges[i].b == name[0] {
+		clone.edges = make([]edge, len(s.edges))
+		copy(clone.edges, s.edges)
+		clone.edges[i].next = s.edges[i].next.add(name[1:])
 	} else {
-		clone.next[name[0]] = makeState(name[1:])
+		clone.edges = make([]edge, len(s.edges)+1)
+		copy(clone.edges, s.edges[:i])
+		clone.edges[i] = edge{b: name[0], next: makeState(name[1:])}
+		copy(clone.edges[i+1:], s.edges[i:])
 	}
-	return &clone
+	return clone
 }
 
 func makeState(name string) *state {
@@ -52,33 +87,52 @@ func makeState(name string) *state {
 	}
 
 	for i := len(name) - 1; i >= 0; i-- {
-		next := &state{}
-		next.next[name[i]] = s
-		s = next
+		s = &state{
+			edges: []edge{{b: name[i], next: s}},
+		}
 	}
 
 	return s
 }
 
-func (s *state) match(str string) [][2]int {
-	var matches [][2]int
+// matchPrefixExact is like matchPrefix, but str is not folded.
+func (s *state) matchPrefixExact(str string) int {
+	end := 0
 
 	for i := 0; i < len(str); i++ {
-		term := -1
-		for j, c := i, s; ; j++ {
-			if c.term {
-				term = j
-			}
-			if j == len(str) || c.next[str[j]] == nil {
-				break
+		if s = s.next(str[i]); s == nil {
+			return end
+		}
+		if s.term {
+			end = i + 1
+		}
+	}
+
+	return end
+}
+
+// matchPrefix returns the length in bytes of the longest name at the start of
+// str, or 0 if there is none. Unlike matcher.match, str is folded as it is
+// read, so only as much of str as is needed is examined.
+func (s *state) matchPrefix(str string) int {
+	var caser *cases.Caser
+	var buf []byte
+	end := 0
+
+	for i := 0; i < len(str); {
+		var size int
+		buf, size = appendFold(buf[:0], str[i:], &caser)
+		for _, b := range buf {
+			if s = s.next(b); s == nil {
+				return end
 			}
-			c = c.next[str[j]]
 		}
-		if term != -1 {
-			matches = append(matches, [2]int{i, term})
-			i = term - 1
+
+		i += size
+		if s.term && len(buf) != 0 {
+			end = i
 		}
 	}
 
-	return matches
+	return end
 }
@@ -0,0 +1,156 @@
+package emoji
+
+import (
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// Strip removes the Unicode emoji and shortcodes from text. The text around
+// them, including whitespace, is left unchanged.
+func Strip(text string) string {
+	return defaultConfig.Strip(text)
+}
+
+// Strip removes the Unicode emoji, shortcodes, and emoticons that Replace
+// would replace from text. The text around them, including whitespace, is
+// left unchanged.
+func (conf *Config) Strip(text string) string {
+	return conf.rewriteText(text, false)
+}
+
+// Describe replaces the Unicode emoji and shortcodes in text with their
+// descriptions, so "I ❤️ Go" becomes "I red heart Go".
+func Describe(text string) string {
+	return defaultConfig.Describe(text)
+}
+
+// Describe replaces the Unicode emoji, shortcodes, and emoticons that Replace
+// would replace in text with their descriptions in the Config's Locale.
+func (conf *Config) Describe(text string) string {
+	return conf.rewriteText(text, true)
+}
+
+// StripNodes is like Strip for HTML. Emoji elements output by Replace are
+// removed, and elements that Replace skips are left unchanged.
+func StripNodes(nodes ...*html.Node) []*html.Node {
+	return defaultConfig.StripNodes(nodes...)
+}
+
+// StripNodes is like Strip for HTML. Emoji elements output by Replace are
+// removed, and elements that Replace skips are left unchanged.
+func (conf *Config) StripNodes(nodes ...*html.Node) []*html.Node {
+	return conf.rewrite(nodes, false)
+}
+
+// DescribeNodes is like Describe for HTML. Emoji elements output by Replace
+// are replaced with text, and elements that Replace skips are left unchanged.
+func DescribeNodes(nodes ...*html.Node) []*html.Node {
+	return defaultConfig.DescribeNodes(nodes...)
+}
+
+// DescribeNodes is like Describe for HTML. Emoji elements output by Replace
+// are replaced with text, and elements that Replace skips are left unchanged.
+func (conf *Config) DescribeNodes(nodes ...*html.Node) []*html.Node {
+	return conf.rewrite(nodes, true)
+}
+
+// rewriteText removes each emoji in text, or replaces it with its description
+// if describe is set.
+func (conf *Config) rewriteText(text string, describe bool) string {
+	matches := conf.findEmoji(text)
+	if len(matches) == 0 {
+		return text
+	}
+
+	buf := make([]byte, 0, len(text))
+	last := 0
+	for _, match := range matches {
+		buf = append(buf, text[last:match.start]...)
+		if describe {
+			buf = append(buf, conf.description(match.e)...)
+		}
+		last = match.end
+	}
+	buf = append(buf, text[last:]...)
+
+	return string(buf)
+}
+
+// rewrite returns a copy of nodes with each emoji removed, or replaced with
+// its description if describe is set. Text nodes that become empty are
+// removed.
+func (conf *Config) rewrite(nodes []*html.Node, describe bool) []*html.Node {
+	result := make([]*html.Node, 0, len(nodes))
+
+	for _, node := range nodes {
+		switch node.Type {
+		case html.ElementNode:
+			if hasClass(node, "emoji") {
+				if !describe {
+					break
+				}
+				if text := conf.describeElement(node); text != "" {
+					result = append(result, &html.Node{
+						Type: html.TextNode,
+						Data: text,
+					})
+				}
+				break
+			}
+			if conf.skip(node) {
+				result = append(result, deepClone(node))
+				break
+			}
+
+			clone := shallowClone(node)
+			for child := node.FirstChild; child != nil; child = child.NextSibling {
+				for _, o := range conf.rewrite([]*html.Node{child}, describe) {
+					clone.AppendChild(o)
+				}
+			}
+			result = append(result, clone)
+		case html.TextNode:
+			clone := shallowClone(node)
+			clone.Data = conf.rewriteText(node.Data, describe)
+			if clone.Data != "" {
+				result = append(result, clone)
+			}
+		default:
+			result = append(result, deepClone(node))
+		}
+	}
+
+	return result
+}
+
+// describeElement returns the description of an emoji element output by
+// Replace. If the emoji is not defined, its tooltip is used.
+func (conf *Config) describeElement(node *html.Node) string {
+	if node.DataAtom == atom.Picture {
+		for child := node.FirstChild; child != nil; child = child.NextSibling {
+			if child.DataAtom == atom.Img {
+				node = child
+				break
+			}
+		}
+	}
+
+	name := textContent(node)
+	var title string
+	for _, a := range node.Attr {
+		if a.Namespace != "" {
+			continue
+		}
+		switch a.Key {
+		case "alt", "aria-label":
+			name = a.Val
+		case "title":
+			title = a.Val
+		}
+	}
+
+	if e, ok := conf.lookupEmoji(name); ok {
+		return conf.description(e)
+	}
+	return title
+}
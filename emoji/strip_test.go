@@ -0,0 +1,102 @@
+package emoji_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+
+	"github.com/BenLubar/hellstew/emoji"
+)
+
+func TestStrip(t *testing.T) {
+	for _, tt := range []struct {
+		input    string
+		strip    string
+		describe string
+	}{
+		{"", "", ""},
+		{"hello", "hello", "hello"},
+		{"I ❤️ Go", "I  Go", "I red heart Go"},
+		{":tada::popcorn:", "", "party popperpopcorn"},
+		{"Ship it :shipit:", "Ship it ", "Ship it ship it!"},
+		{"ok :)", "ok ", "ok grinning face"},
+		{":not_an_emoji:", ":not_an_emoji:", ":not_an_emoji:"},
+	} {
+		if actual := emoticonConfig.Strip(tt.input); actual != tt.strip {
+			t.Errorf("Strip(%q) = %q, expected %q", tt.input, actual, tt.strip)
+		}
+		if actual := emoticonConfig.Describe(tt.input); actual != tt.describe {
+			t.Errorf("Describe(%q) = %q, expected %q", tt.input, actual, tt.describe)
+		}
+	}
+
+	t.Run("Locale", func(t *testing.T) {
+		if actual := localeConfig("pt").Describe("🍿"); actual != "pipoca" {
+			t.Errorf("Describe: %q != %q", "pipoca", actual)
+		}
+	})
+}
+
+func TestStripNodes(t *testing.T) {
+	for _, tt := range []struct {
+		input    string
+		strip    string
+		describe string
+	}{
+		{
+			input:    `<p>To the 🍿 thread!</p>`,
+			strip:    `<p>To the  thread!</p>`,
+			describe: `<p>To the popcorn thread!</p>`,
+		},
+		{
+			input:    `<b>🎉</b><code>:tada:</code>`,
+			strip:    `<b></b><code>:tada:</code>`,
+			describe: `<b>party popper</b><code>:tada:</code>`,
+		},
+		{
+			input:    `<abbr class="emoji" title="party popper">🎉</abbr>!`,
+			strip:    `!`,
+			describe: `party popper!`,
+		},
+		{
+			input:    `<img src="/images/unknown.png" alt=":unknown:" class="emoji" title="mystery"/>`,
+			strip:    ``,
+			describe: `mystery`,
+		},
+	} {
+		input, err := html.ParseFragment(strings.NewReader(tt.input), &html.Node{
+			Type:     html.ElementNode,
+			Data:     "div",
+			DataAtom: atom.Div,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, f := range []struct {
+			name     string
+			rewrite  func(...*html.Node) []*html.Node
+			expected string
+		}{
+			{"StripNodes", emoji.StripNodes, tt.strip},
+			{"DescribeNodes", emoji.DescribeNodes, tt.describe},
+			{"StripNodes(Replace)", func(nodes ...*html.Node) []*html.Node {
+				return emoji.StripNodes(emoji.Replace(nodes...)...)
+			}, tt.strip},
+		} {
+			var buf bytes.Buffer
+			for _, n := range f.rewrite(input...) {
+				if err := html.Render(&buf, n); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			if actual := buf.String(); actual != f.expected {
+				t.Errorf("%s(%q)\nexpected %q\nactual   %q", f.name, tt.input, f.expected, actual)
+			}
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package emoji
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// FuncMap returns functions for use with html/template. See Config.FuncMap.
+func FuncMap() template.FuncMap {
+	return defaultConfig.FuncMap()
+}
+
+// FuncMap returns functions for use with html/template:
+//
+//	{{emoji .Body}}
+//
+// replaces emoji and shortcodes in its argument as Replace does. A
+// template.HTML argument is parsed as HTML; any other argument is treated as
+// plain text and escaped.
+//
+//	{{shortcode ":tada:"}}
+//
+// renders a single Unicode emoji or shortcode. If it is not defined, the
+// escaped name is returned.
+func (conf *Config) FuncMap() template.FuncMap {
+	return template.FuncMap{
+		"emoji":     conf.templateEmoji,
+		"shortcode": conf.templateShortcode,
+	}
+}
+
+func (conf *Config) templateEmoji(v interface{}) (template.HTML, error) {
+	var nodes []*html.Node
+	if h, ok := v.(template.HTML); ok {
+		var err error
+		nodes, err = html.ParseFragment(strings.NewReader(string(h)), &html.Node{
+			Type:     html.ElementNode,
+			Data:     "div",
+			DataAtom: atom.Div,
+		})
+		if err != nil {
+			return "", err
+		}
+	} else {
+		nodes = []*html.Node{
+			{
+				Type: html.TextNode,
+				Data: fmt.Sprint(v),
+			},
+		}
+	}
+
+	return renderHTML(conf.Replace(nodes...))
+}
+
+func (conf *Config) templateShortcode(name string) (template.HTML, error) {
+	n := conf.Node(name)
+	if n == nil {
+		return template.HTML(html.EscapeString(name)), nil
+	}
+	return renderHTML([]*html.Node{n})
+}
+
+func renderHTML(nodes []*html.Node) (template.HTML, error) {
+	var buf bytes.Buffer
+	for _, n := range nodes {
+		if err := html.Render(&buf, n); err != nil {
+			return "", err
+		}
+	}
+	return template.HTML(buf.String()), nil
+}
@@ -0,0 +1,86 @@
+package emoji_test
+
+import (
+	"bytes"
+	"html/template"
+	"testing"
+
+	"github.com/BenLubar/hellstew/emoji"
+)
+
+type templateTest struct {
+	name     string
+	template string
+	data     interface{}
+	output   string
+}
+
+var templateTests = [...]templateTest{
+	{
+		name:     "Text",
+		template: `<p>{{emoji .}}</p>`,
+		data:     `To the :popcorn: thread! <script>alert(1)</script>`,
+		output:   `<p>To the <abbr class="emoji" title="popcorn">🍿</abbr> thread! &lt;script&gt;alert(1)&lt;/script&gt;</p>`,
+	},
+	{
+		name:     "HTML",
+		template: `<p>{{emoji .}}</p>`,
+		data:     template.HTML(`<em>:popcorn:</em> <code>:popcorn:</code>`),
+		output:   `<p><em><abbr class="emoji" title="popcorn">🍿</abbr></em> <code>:popcorn:</code></p>`,
+	},
+	{
+		name:     "NotString",
+		template: `{{emoji .}}`,
+		data:     42,
+		output:   `42`,
+	},
+	{
+		name:     "Shortcode",
+		template: `{{shortcode ":tada:"}} {{shortcode "🍿"}}`,
+		output:   `<abbr class="emoji" title="party popper">🎉</abbr> <abbr class="emoji" title="popcorn">🍿</abbr>`,
+	},
+	{
+		name:     "ConfigShortcode",
+		template: `{{shortcode ":wtf:"}}`,
+		output:   `<img src="http://thedailywtf.com/favicon.ico" alt=":wtf:" class="emoji" title="wtf"/>`,
+	},
+	{
+		name:     "UnknownShortcode",
+		template: `{{shortcode ":<b>:"}}`,
+		output:   `:&lt;b&gt;:`,
+	},
+}
+
+func TestFuncMap(t *testing.T) {
+	for _, tt := range templateTests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			tmpl, err := template.New(tt.name).Funcs(testConfig.FuncMap()).Parse(tt.template)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var buf bytes.Buffer
+			if err = tmpl.Execute(&buf, tt.data); err != nil {
+				t.Fatal(err)
+			}
+
+			if output := buf.String(); tt.output != output {
+				t.Errorf("expected %q\nactual   %q", tt.output, output)
+			}
+		})
+	}
+
+	t.Run("Global", func(t *testing.T) {
+		tmpl := template.Must(template.New("Global").Funcs(emoji.FuncMap()).Parse(`{{emoji .}}`))
+
+		var buf bytes.Buffer
+		if err := tmpl.Execute(&buf, ":wtf: :tada:"); err != nil {
+			t.Fatal(err)
+		}
+
+		if expected, output := `:wtf: <abbr class="emoji" title="party popper">🎉</abbr>`, buf.String(); expected != output {
+			t.Errorf("expected %q\nactual   %q", expected, output)
+		}
+	})
+}
@@ -0,0 +1,47 @@
+package emoji
+
+import "strings"
+
+// ReplaceText replaces the shortcodes in plain text with Unicode emoji.
+// Shortcodes for images are left unchanged.
+func ReplaceText(text string) string {
+	return defaultConfig.ReplaceText(text)
+}
+
+// ReplaceText replaces the shortcodes and emoticons in plain text with Unicode
+// emoji, for output that cannot contain HTML, such as terminals, the plain
+// text part of an email, or chat bridges. Images are replaced with the text
+// given in AddImageInfo, or left as shortcodes if there is none.
+func (conf *Config) ReplaceText(text string) string {
+	matches := conf.findEmoji(text)
+	if len(matches) == 0 {
+		return text
+	}
+
+	buf := make([]byte, 0, len(text))
+	last := 0
+	for _, match := range matches {
+		buf = append(buf, text[last:match.start]...)
+		buf = append(buf, match.e.plainText(text[match.start:match.end])...)
+		last = match.end
+	}
+	buf = append(buf, text[last:]...)
+
+	return string(buf)
+}
+
+// plainText returns the plain text form of the emoji. If the emoji has no
+// plain text form, name is used if it is a shortcode, or else the first
+// alias.
+func (e *emoji) plainText(name string) string {
+	if e.emoji != "" {
+		return e.emoji
+	}
+	if e.image.Text != "" {
+		return e.image.Text
+	}
+	if len(name) > 2 && strings.HasPrefix(name, ":") && strings.HasSuffix(name, ":") {
+		return name
+	}
+	return ":" + e.aliases[0] + ":"
+}
@@ -0,0 +1,69 @@
+package emoji_test
+
+import (
+	"testing"
+
+	"github.com/BenLubar/hellstew/emoji"
+)
+
+var textConfig = func() *emoji.Config {
+	var conf emoji.Config
+
+	conf.AddImage("https://assets-cdn.github.com/images/icons/emoji/shipit.png", "ship it!", []string{"shipit", "squirrel"}, "GitHub", nil)
+	conf.AddImageInfo(emoji.ImageInfo{
+		URL:  "/images/partyparrot.gif",
+		Text: "🦜",
+	}, "party parrot", []string{"partyparrot"}, "Custom", nil)
+	conf.AddImageInfo(emoji.ImageInfo{
+		URL:  "/images/tableflip.png",
+		Text: "(╯°□°)╯︵ ┻━┻",
+	}, "table flip", []string{"tableflip"}, "Custom", nil)
+	conf.AddEmoticon("(shipit)", ":shipit:")
+	conf.AddDefaultEmoticons()
+
+	return &conf
+}()
+
+func TestReplaceText(t *testing.T) {
+	for _, tt := range []struct {
+		input  string
+		output string
+	}{
+		{"", ""},
+		{"hello", "hello"},
+		{"To the :popcorn: thread! 🎉", "To the 🍿 thread! 🎉"},
+		{":partyparrot: :tableflip:", "🦜 (╯°□°)╯︵ ┻━┻"},
+		{":shipit: :squirrel:", ":shipit: :squirrel:"},
+		{"ok (shipit)", "ok :shipit:"},
+		{"thanks :)", "thanks 🙂"},
+		{"<b>:tada:</b>", "<b>🎉</b>"},
+	} {
+		if actual := textConfig.ReplaceText(tt.input); actual != tt.output {
+			t.Errorf("ReplaceText(%q)\nexpected %q\nactual   %q", tt.input, tt.output, actual)
+		}
+	}
+
+	t.Run("Default", func(t *testing.T) {
+		if actual := emoji.ReplaceText(":tada: :partyparrot:"); actual != "🎉 :partyparrot:" {
+			t.Errorf("unexpected output %q", actual)
+		}
+	})
+
+	t.Run("Result", func(t *testing.T) {
+		for _, tt := range []struct {
+			name string
+			text string
+		}{
+			{":tada:", "🎉"},
+			{":squirrel:", ":shipit:"},
+			{":partyparrot:", "🦜"},
+		} {
+			result, ok := textConfig.Lookup(tt.name)
+			if !ok {
+				t.Errorf("%q not found", tt.name)
+			} else if actual := result.Text(); actual != tt.text {
+				t.Errorf("%q: Text: %q != %q", tt.name, tt.text, actual)
+			}
+		}
+	})
+}